@@ -24,6 +24,109 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 审核状态
+type Comment_ModerationStatus int32
+
+const (
+	Comment_APPROVED Comment_ModerationStatus = 0 // 已通过，无需审核的评论默认通过
+	Comment_PENDING  Comment_ModerationStatus = 1 // 待审核
+	Comment_REJECTED Comment_ModerationStatus = 2 // 审核拒绝
+)
+
+// Enum value maps for Comment_ModerationStatus.
+var (
+	Comment_ModerationStatus_name = map[int32]string{
+		0: "APPROVED",
+		1: "PENDING",
+		2: "REJECTED",
+	}
+	Comment_ModerationStatus_value = map[string]int32{
+		"APPROVED": 0,
+		"PENDING":  1,
+		"REJECTED": 2,
+	}
+)
+
+func (x Comment_ModerationStatus) Enum() *Comment_ModerationStatus {
+	p := new(Comment_ModerationStatus)
+	*p = x
+	return p
+}
+
+func (x Comment_ModerationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Comment_ModerationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[0].Descriptor()
+}
+
+func (Comment_ModerationStatus) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[0]
+}
+
+func (x Comment_ModerationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Comment_ModerationStatus.Descriptor instead.
+func (Comment_ModerationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// 附件类型
+type Attachment_Type int32
+
+const (
+	Attachment_TYPE_UNSPECIFIED Attachment_Type = 0 // 未指定
+	Attachment_IMAGE            Attachment_Type = 1 // 图片
+	Attachment_LINK             Attachment_Type = 2 // 链接预览
+	Attachment_STICKER          Attachment_Type = 3 // 表情贴纸
+)
+
+// Enum value maps for Attachment_Type.
+var (
+	Attachment_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "IMAGE",
+		2: "LINK",
+		3: "STICKER",
+	}
+	Attachment_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"IMAGE":            1,
+		"LINK":             2,
+		"STICKER":          3,
+	}
+)
+
+func (x Attachment_Type) Enum() *Attachment_Type {
+	p := new(Attachment_Type)
+	*p = x
+	return p
+}
+
+func (x Attachment_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Attachment_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[1].Descriptor()
+}
+
+func (Attachment_Type) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[1]
+}
+
+func (x Attachment_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Attachment_Type.Descriptor instead.
+func (Attachment_Type) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6, 0}
+}
+
 // 排序规则
 type GetCommentRequest_SortType int32
 
@@ -42,61 +145,3448 @@ var (
 		"LIKE_COUNT_DESC":  0,
 		"CREATE_TIME_DESC": 1,
 	}
-)
+)
+
+func (x GetCommentRequest_SortType) Enum() *GetCommentRequest_SortType {
+	p := new(GetCommentRequest_SortType)
+	*p = x
+	return p
+}
+
+func (x GetCommentRequest_SortType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetCommentRequest_SortType) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[2].Descriptor()
+}
+
+func (GetCommentRequest_SortType) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[2]
+}
+
+func (x GetCommentRequest_SortType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetCommentRequest_SortType.Descriptor instead.
+func (GetCommentRequest_SortType) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{8, 0}
+}
+
+// 投递状态
+type WebhookDelivery_Status int32
+
+const (
+	WebhookDelivery_PENDING   WebhookDelivery_Status = 0 // 待投递或等待重试
+	WebhookDelivery_SUCCEEDED WebhookDelivery_Status = 1 // 投递成功
+	WebhookDelivery_DEAD      WebhookDelivery_Status = 2 // 超过最大重试次数，进入死信
+)
+
+// Enum value maps for WebhookDelivery_Status.
+var (
+	WebhookDelivery_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "SUCCEEDED",
+		2: "DEAD",
+	}
+	WebhookDelivery_Status_value = map[string]int32{
+		"PENDING":   0,
+		"SUCCEEDED": 1,
+		"DEAD":      2,
+	}
+)
+
+func (x WebhookDelivery_Status) Enum() *WebhookDelivery_Status {
+	p := new(WebhookDelivery_Status)
+	*p = x
+	return p
+}
+
+func (x WebhookDelivery_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDelivery_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[3].Descriptor()
+}
+
+func (WebhookDelivery_Status) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[3]
+}
+
+func (x WebhookDelivery_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDelivery_Status.Descriptor instead.
+func (WebhookDelivery_Status) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{19, 0}
+}
+
+// 变更类型
+type CommentChange_Type int32
+
+const (
+	CommentChange_TYPE_UNSPECIFIED   CommentChange_Type = 0
+	CommentChange_CREATED            CommentChange_Type = 1 // 新评论，需要审核的评论在审核通过时推送
+	CommentChange_DELETED            CommentChange_Type = 2 // 评论及其回复被删除
+	CommentChange_LIKE_COUNT_CHANGED CommentChange_Type = 3 // 点赞数变化
+)
+
+// Enum value maps for CommentChange_Type.
+var (
+	CommentChange_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "DELETED",
+		3: "LIKE_COUNT_CHANGED",
+	}
+	CommentChange_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":   0,
+		"CREATED":            1,
+		"DELETED":            2,
+		"LIKE_COUNT_CHANGED": 3,
+	}
+)
+
+func (x CommentChange_Type) Enum() *CommentChange_Type {
+	p := new(CommentChange_Type)
+	*p = x
+	return p
+}
+
+func (x CommentChange_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentChange_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[4].Descriptor()
+}
+
+func (CommentChange_Type) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[4]
+}
+
+func (x CommentChange_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentChange_Type.Descriptor instead.
+func (CommentChange_Type) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{24, 0}
+}
+
+// 举报原因
+type Report_Reason int32
+
+const (
+	Report_REASON_UNSPECIFIED Report_Reason = 0 // 未指定
+	Report_SPAM               Report_Reason = 1 // 垃圾广告
+	Report_ABUSE              Report_Reason = 2 // 辱骂攻击
+	Report_HARASSMENT         Report_Reason = 3 // 骚扰
+	Report_HATE_SPEECH        Report_Reason = 4 // 仇恨言论
+	Report_PORNOGRAPHY        Report_Reason = 5 // 色情低俗
+	Report_ILLEGAL            Report_Reason = 6 // 违法违规
+	Report_OTHER              Report_Reason = 7 // 其他
+)
+
+// Enum value maps for Report_Reason.
+var (
+	Report_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "SPAM",
+		2: "ABUSE",
+		3: "HARASSMENT",
+		4: "HATE_SPEECH",
+		5: "PORNOGRAPHY",
+		6: "ILLEGAL",
+		7: "OTHER",
+	}
+	Report_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED": 0,
+		"SPAM":               1,
+		"ABUSE":              2,
+		"HARASSMENT":         3,
+		"HATE_SPEECH":        4,
+		"PORNOGRAPHY":        5,
+		"ILLEGAL":            6,
+		"OTHER":              7,
+	}
+)
+
+func (x Report_Reason) Enum() *Report_Reason {
+	p := new(Report_Reason)
+	*p = x
+	return p
+}
+
+func (x Report_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Report_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[5].Descriptor()
+}
+
+func (Report_Reason) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[5]
+}
+
+func (x Report_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Report_Reason.Descriptor instead.
+func (Report_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{25, 0}
+}
+
+// 处理状态
+type Report_Status int32
+
+const (
+	Report_PENDING   Report_Status = 0 // 待处理
+	Report_ACCEPTED  Report_Status = 1 // 举报成立
+	Report_DISMISSED Report_Status = 2 // 举报驳回
+)
+
+// Enum value maps for Report_Status.
+var (
+	Report_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "ACCEPTED",
+		2: "DISMISSED",
+	}
+	Report_Status_value = map[string]int32{
+		"PENDING":   0,
+		"ACCEPTED":  1,
+		"DISMISSED": 2,
+	}
+)
+
+func (x Report_Status) Enum() *Report_Status {
+	p := new(Report_Status)
+	*p = x
+	return p
+}
+
+func (x Report_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Report_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[6].Descriptor()
+}
+
+func (Report_Status) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[6]
+}
+
+func (x Report_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Report_Status.Descriptor instead.
+func (Report_Status) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{25, 1}
+}
+
+// 处理方式
+type ResolveReportsRequest_Action int32
+
+const (
+	ResolveReportsRequest_ACTION_UNSPECIFIED ResolveReportsRequest_Action = 0 // 未指定
+	ResolveReportsRequest_DISMISS            ResolveReportsRequest_Action = 1 // 驳回举报，恢复显示评论
+	ResolveReportsRequest_HIDE               ResolveReportsRequest_Action = 2 // 举报成立，隐藏评论
+	ResolveReportsRequest_DELETE             ResolveReportsRequest_Action = 3 // 举报成立，删除评论及其回复
+)
+
+// Enum value maps for ResolveReportsRequest_Action.
+var (
+	ResolveReportsRequest_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "DISMISS",
+		2: "HIDE",
+		3: "DELETE",
+	}
+	ResolveReportsRequest_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"DISMISS":            1,
+		"HIDE":               2,
+		"DELETE":             3,
+	}
+)
+
+func (x ResolveReportsRequest_Action) Enum() *ResolveReportsRequest_Action {
+	p := new(ResolveReportsRequest_Action)
+	*p = x
+	return p
+}
+
+func (x ResolveReportsRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResolveReportsRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[7].Descriptor()
+}
+
+func (ResolveReportsRequest_Action) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[7]
+}
+
+func (x ResolveReportsRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResolveReportsRequest_Action.Descriptor instead.
+func (ResolveReportsRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{31, 0}
+}
+
+// 评论状态
+type ResourceCommentSettings_Status int32
+
+const (
+	ResourceCommentSettings_OPEN      ResourceCommentSettings_Status = 0 // 正常开放评论
+	ResourceCommentSettings_CLOSED    ResourceCommentSettings_Status = 1 // 关闭评论，不展示评论，也不能发表评论和点赞
+	ResourceCommentSettings_READ_ONLY ResourceCommentSettings_Status = 2 // 只读，展示已有评论，但不能发表评论和点赞
+)
+
+// Enum value maps for ResourceCommentSettings_Status.
+var (
+	ResourceCommentSettings_Status_name = map[int32]string{
+		0: "OPEN",
+		1: "CLOSED",
+		2: "READ_ONLY",
+	}
+	ResourceCommentSettings_Status_value = map[string]int32{
+		"OPEN":      0,
+		"CLOSED":    1,
+		"READ_ONLY": 2,
+	}
+)
+
+func (x ResourceCommentSettings_Status) Enum() *ResourceCommentSettings_Status {
+	p := new(ResourceCommentSettings_Status)
+	*p = x
+	return p
+}
+
+func (x ResourceCommentSettings_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceCommentSettings_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[8].Descriptor()
+}
+
+func (ResourceCommentSettings_Status) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[8]
+}
+
+func (x ResourceCommentSettings_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceCommentSettings_Status.Descriptor instead.
+func (ResourceCommentSettings_Status) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{37, 0}
+}
+
+// 时间排序
+type ListUserCommentsRequest_Order int32
+
+const (
+	ListUserCommentsRequest_NEWEST_FIRST ListUserCommentsRequest_Order = 0 // 按创建时间降序（默认）
+	ListUserCommentsRequest_OLDEST_FIRST ListUserCommentsRequest_Order = 1 // 按创建时间升序
+)
+
+// Enum value maps for ListUserCommentsRequest_Order.
+var (
+	ListUserCommentsRequest_Order_name = map[int32]string{
+		0: "NEWEST_FIRST",
+		1: "OLDEST_FIRST",
+	}
+	ListUserCommentsRequest_Order_value = map[string]int32{
+		"NEWEST_FIRST": 0,
+		"OLDEST_FIRST": 1,
+	}
+)
+
+func (x ListUserCommentsRequest_Order) Enum() *ListUserCommentsRequest_Order {
+	p := new(ListUserCommentsRequest_Order)
+	*p = x
+	return p
+}
+
+func (x ListUserCommentsRequest_Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUserCommentsRequest_Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[9].Descriptor()
+}
+
+func (ListUserCommentsRequest_Order) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[9]
+}
+
+func (x ListUserCommentsRequest_Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUserCommentsRequest_Order.Descriptor instead.
+func (ListUserCommentsRequest_Order) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{42, 0}
+}
+
+// 删除方式
+type BulkDeleteJob_Mode int32
+
+const (
+	BulkDeleteJob_HARD BulkDeleteJob_Mode = 0 // 物理删除评论及其回复、点赞、提及和附件
+	BulkDeleteJob_SOFT BulkDeleteJob_Mode = 1 // 软删除，评论标记为已删除并隐藏，点赞等记录保留
+)
+
+// Enum value maps for BulkDeleteJob_Mode.
+var (
+	BulkDeleteJob_Mode_name = map[int32]string{
+		0: "HARD",
+		1: "SOFT",
+	}
+	BulkDeleteJob_Mode_value = map[string]int32{
+		"HARD": 0,
+		"SOFT": 1,
+	}
+)
+
+func (x BulkDeleteJob_Mode) Enum() *BulkDeleteJob_Mode {
+	p := new(BulkDeleteJob_Mode)
+	*p = x
+	return p
+}
+
+func (x BulkDeleteJob_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkDeleteJob_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[10].Descriptor()
+}
+
+func (BulkDeleteJob_Mode) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[10]
+}
+
+func (x BulkDeleteJob_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkDeleteJob_Mode.Descriptor instead.
+func (BulkDeleteJob_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{46, 0}
+}
+
+// 任务状态
+type BulkDeleteJob_Status int32
+
+const (
+	BulkDeleteJob_PENDING   BulkDeleteJob_Status = 0 // 等待执行
+	BulkDeleteJob_RUNNING   BulkDeleteJob_Status = 1 // 执行中
+	BulkDeleteJob_SUCCEEDED BulkDeleteJob_Status = 2 // 执行完成
+	BulkDeleteJob_FAILED    BulkDeleteJob_Status = 3 // 执行失败
+)
+
+// Enum value maps for BulkDeleteJob_Status.
+var (
+	BulkDeleteJob_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	BulkDeleteJob_Status_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x BulkDeleteJob_Status) Enum() *BulkDeleteJob_Status {
+	p := new(BulkDeleteJob_Status)
+	*p = x
+	return p
+}
+
+func (x BulkDeleteJob_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkDeleteJob_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[11].Descriptor()
+}
+
+func (BulkDeleteJob_Status) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[11]
+}
+
+func (x BulkDeleteJob_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkDeleteJob_Status.Descriptor instead.
+func (BulkDeleteJob_Status) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{46, 1}
+}
+
+// 导出格式
+type ExportCommentsRequest_Format int32
+
+const (
+	ExportCommentsRequest_JSONL ExportCommentsRequest_Format = 0 // 每行一条评论的 JSON（默认）
+	ExportCommentsRequest_CSV   ExportCommentsRequest_Format = 1 // 第一行为表头
+)
+
+// Enum value maps for ExportCommentsRequest_Format.
+var (
+	ExportCommentsRequest_Format_name = map[int32]string{
+		0: "JSONL",
+		1: "CSV",
+	}
+	ExportCommentsRequest_Format_value = map[string]int32{
+		"JSONL": 0,
+		"CSV":   1,
+	}
+)
+
+func (x ExportCommentsRequest_Format) Enum() *ExportCommentsRequest_Format {
+	p := new(ExportCommentsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportCommentsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportCommentsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_v1_comment_proto_enumTypes[12].Descriptor()
+}
+
+func (ExportCommentsRequest_Format) Type() protoreflect.EnumType {
+	return &file_comment_v1_comment_proto_enumTypes[12]
+}
+
+func (x ExportCommentsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportCommentsRequest_Format.Descriptor instead.
+func (ExportCommentsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{49, 0}
+}
+
+// 点赞评论请求
+type LikeCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评论唯一标识
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保指定了要点赞的具体评论
+	// 用户唯一标识
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了点赞用户身份
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentRequest) Reset() {
+	*x = LikeCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentRequest) ProtoMessage() {}
+
+func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LikeCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *LikeCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 点赞评论响应
+type LikeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 点赞结果
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 点赞后的点赞数
+	LikeCount     int64 `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 校验规则: 点赞数必须大于等于0，确保数量为非负数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeResponse) Reset() {
+	*x = LikeResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeResponse) ProtoMessage() {}
+
+func (x *LikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
+func (*LikeResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LikeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LikeResponse) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+// 取消点赞评论请求
+type UnlikeCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评论唯一标识
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保指定了要取消点赞的具体评论
+	// 用户唯一标识
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了取消点赞的用户身份
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeCommentRequest) Reset() {
+	*x = UnlikeCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeCommentRequest) ProtoMessage() {}
+
+func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnlikeCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *UnlikeCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 取消点赞评论响应
+type UnlikeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 取消点赞结果
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 取消点赞后的点赞数
+	LikeCount     int64 `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 校验规则: 点赞数必须大于等于0，确保数量为非负数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeResponse) Reset() {
+	*x = UnlikeResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeResponse) ProtoMessage() {}
+
+func (x *UnlikeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnlikeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnlikeResponse) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type CreateCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模块标识，用于区分不同业务模块，必须大于零
+	// 取值由服务端的业务模块注册表定义（配置 data.modules），未注册的模块会被拒绝
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
+	// 资源ID，关联的业务资源唯一标识，长度必须大于零
+	// 例如: 文章ID、视频ID等
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
+	// 用户信息
+	UserId   string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了评论用户身份
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`           // 校验规则: 用户名字符串长度必须大于等于1，确保显示名称不为空
+	Avatar   string `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`               // 校验规则: 用户头像URL字符串长度必须大于等于1，确保头像信息不为空
+	// 评论内容，支持文本和表情，长度必须大于零并且小于两千字符
+	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"` // 校验规则: 评论内容必须介于1-2000字符之间，确保内容不为空且不会过长
+	// 评论层级关系
+	ParentCommentId int64 `protobuf:"varint,7,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"` // 校验规则: 父评论ID必须大于等于0，0表示顶级评论
+	Level           int32 `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"`                                              // 校验规则: 评论层级必须大于等于0，0为顶级评论
+	RootCommentId   int64 `protobuf:"varint,9,opt,name=root_comment_id,json=rootCommentId,proto3" json:"root_comment_id,omitempty"`       // 校验规则: 根评论ID必须大于等于0，用于快速查找整个评论链
+	// 幂等键，客户端重试时携带相同的值，也可通过 Idempotency-Key 请求头或 gRPC metadata 传递
+	// 相同幂等键和相同内容的重试返回首次创建的评论，内容不同则返回冲突错误
+	IdempotencyKey string `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 校验规则: 幂等键长度不超过128字符
+	// 评论附件：图片、链接预览、表情贴纸，地址的域名需在服务端允许列表中
+	Attachments   []*Attachment `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"` // 校验规则: 附件不超过9个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCommentRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentCommentId() int64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetRootCommentId() int64 {
+	if x != nil {
+		return x.RootCommentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Comment 评论消息
+// 包含评论的基本信息和回复列表
+type Comment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
+	// 资源唯一标识
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
+	// 评论唯一标识
+	CommentId int64 `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保评论有有效的唯一标识
+	// 用户相关字段
+	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了评论用户身份
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`           // 校验规则: 用户名字符串长度必须大于等于1，确保显示名称不为空
+	Avatar   string `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`               // 校验规则: 用户头像URL字符串长度必须大于等于1，确保头像信息不为空
+	// 评论内容
+	Content string `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"` // 校验规则: 评论内容必须介于1-2000字符之间，确保内容不为空且不会过长
+	// 评论层级信息
+	Level int32 `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"` // 校验规则: 评论层级必须大于等于0，0为顶级评论
+	// 评论点赞数
+	LikeCount int64 `protobuf:"varint,11,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 校验规则: 点赞数必须大于等于0，确保数量为非负数
+	// 评论回复数
+	ReplyCount int64 `protobuf:"varint,12,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"` // 校验规则: 回复数必须大于等于0，确保数量为非负数
+	// 回复评论列表
+	ReplyComments []*Comment `protobuf:"bytes,9,rep,name=reply_comments,json=replyComments,proto3" json:"reply_comments,omitempty"`
+	// 评论时间
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 校验规则: 创建时间必须存在且有效
+	// 评论内容中的 @ 提及
+	Mentions []*Mention `protobuf:"bytes,13,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// 是否被标记为疑似重复内容（模块的重复检测策略为 flag 时设置）
+	Flagged bool `protobuf:"varint,14,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	// 是否因举报成立或批量软删除被隐藏，隐藏的评论不出现在评论列表中；与审核状态相互独立
+	Hidden bool `protobuf:"varint,15,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// 评论附件
+	Attachments []*Attachment `protobuf:"bytes,16,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// 渲染后的安全 HTML，服务端开启 Markdown 渲染时在发表评论时生成
+	// 支持粗体、斜体、行内代码、代码块、链接和引用，其余内容按纯文本转义
+	ContentHtml string `protobuf:"bytes,17,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"`
+	// 去除 Markdown 标记后的纯文本，用于摘要、通知和搜索
+	ContentText string `protobuf:"bytes,18,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	// 审核状态，未通过审核的评论不出现在评论列表和实时推送中
+	ModerationStatus Comment_ModerationStatus `protobuf:"varint,19,opt,name=moderation_status,json=moderationStatus,proto3,enum=comment.v1.Comment_ModerationStatus" json:"moderation_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_comment_v1_comment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Comment) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *Comment) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *Comment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Comment) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Comment) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *Comment) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Comment) GetReplyComments() []*Comment {
+	if x != nil {
+		return x.ReplyComments
+	}
+	return nil
+}
+
+func (x *Comment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Comment) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Comment) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *Comment) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Comment) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Comment) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Comment) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *Comment) GetModerationStatus() Comment_ModerationStatus {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return Comment_APPROVED
+}
+
+// 评论附件
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 附件类型
+	Type Attachment_Type `protobuf:"varint,1,opt,name=type,proto3,enum=comment.v1.Attachment_Type" json:"type,omitempty"` // 校验规则: 必须是已定义的附件类型
+	// 图片、链接或贴纸地址
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"` // 校验规则: 地址长度介于1-1024字符
+	// 图片宽度（像素）
+	Width int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"` // 校验规则: 宽度介于0-10000
+	// 图片高度（像素）
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"` // 校验规则: 高度介于0-10000
+	// 链接预览标题
+	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"` // 校验规则: 标题不超过200字符
+	// 链接预览描述
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"` // 校验规则: 描述不超过500字符
+	// 链接预览缩略图地址
+	ThumbnailUrl string `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // 校验规则: 地址不超过1024字符
+	// 表情贴纸ID
+	StickerId     string `protobuf:"bytes,8,opt,name=sticker_id,json=stickerId,proto3" json:"sticker_id,omitempty"` // 校验规则: 贴纸ID不超过64字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_comment_v1_comment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Attachment) GetType() Attachment_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Attachment_TYPE_UNSPECIFIED
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Attachment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Attachment) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Attachment) GetStickerId() string {
+	if x != nil {
+		return x.StickerId
+	}
+	return ""
+}
+
+// Mention 评论内容中的一个 @ 提及片段
+type Mention struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被提及用户的唯一标识，无法解析为用户时为空
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// @ 之后的原始文本，可能是用户名或用户ID
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 提及片段（包含 @）在 content 中的起始位置，按字符计
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 提及片段（包含 @）的长度，按字符计
+	Length        int32 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_comment_v1_comment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Mention) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Mention) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Mention) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Mention) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type GetCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
+	// 资源唯一标识
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
+	// 最大层级深度
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // 校验规则: 最大层级深度必须介于1-10之间，防止查询过深导致性能问题
+	// 分页参数
+	Page     int32                       `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                                                          // 页码，从1开始
+	PageSize int32                       `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                  // 每页数量，最大100
+	SortType *GetCommentRequest_SortType `protobuf:"varint,6,opt,name=sort_type,json=sortType,proto3,enum=comment.v1.GetCommentRequest_SortType,oneof" json:"sort_type,omitempty"` // 根评论排序类型，未指定时使用模块的默认排序
+	// 查看者用户ID，非空时不返回查看者拉黑的用户发表的评论和回复
+	ViewerId      string `protobuf:"bytes,7,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // 校验规则: 用户ID不超过32字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentRequest) Reset() {
+	*x = GetCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRequest) ProtoMessage() {}
+
+func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCommentRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *GetCommentRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCommentRequest) GetSortType() GetCommentRequest_SortType {
+	if x != nil && x.SortType != nil {
+		return *x.SortType
+	}
+	return GetCommentRequest_LIKE_COUNT_DESC
+}
+
+func (x *GetCommentRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type CommentTree struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评论列表
+	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentTree) Reset() {
+	*x = CommentTree{}
+	mi := &file_comment_v1_comment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentTree) ProtoMessage() {}
+
+func (x *CommentTree) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentTree.ProtoReflect.Descriptor instead.
+func (*CommentTree) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CommentTree) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// 删除评论
+type DeleteCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
+	// 资源唯一标识
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
+	// 评论唯一标识
+	CommentId int64 `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保指定了要删除的具体评论
+	// 用户相关字段
+	UserId        string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保验证删除操作的用户身份
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteCommentRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 删除结果
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 获取提及某用户的评论
+type ListMentionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被提及用户的唯一标识
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了被提及的用户
+	// 分页参数
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsRequest) Reset() {
+	*x = ListMentionsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsRequest) ProtoMessage() {}
+
+func (x *ListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMentionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMentionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMentionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMentionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 提及该用户的评论列表，按提及时间降序
+	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsResponse) Reset() {
+	*x = ListMentionsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsResponse) ProtoMessage() {}
+
+func (x *ListMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListMentionsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// Webhook 订阅
+type WebhookSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 订阅唯一标识
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 订阅名称，全局唯一
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 订阅的业务模块，0 表示所有模块
+	Module int32 `protobuf:"varint,3,opt,name=module,proto3" json:"module,omitempty"`
+	// 订阅的事件类型，为空表示所有类型
+	EventTypes []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// 回调地址
+	Url string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// 是否启用
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// 订阅来源: config（配置文件）、admin（管理接口）
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	// 创建时间
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_comment_v1_comment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WebhookSubscription) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebhookSubscription) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 订阅名称，全局唯一
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 校验规则: 名称长度必须介于1-64之间
+	// 订阅的业务模块，0 表示所有模块
+	Module int32 `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	// 订阅的事件类型，为空表示所有类型
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // 校验规则: 事件类型必须是已定义的类型且不能重复
+	// 回调地址
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"` // 校验规则: 回调地址必须是合法的 URI
+	// 签名密钥，用于计算 X-Comment-Signature
+	Secret        string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"` // 校验规则: 密钥长度必须大于等于16，保证签名强度
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 订阅唯一标识
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 校验规则: 订阅ID必须大于0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按业务模块过滤，0 表示不过滤
+	Module        int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListWebhookSubscriptionsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 订阅列表
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// Webhook 投递记录
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 投递记录唯一标识
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 订阅唯一标识
+	SubscriptionId int64 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// 事件唯一标识
+	EventId int64 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 事件类型
+	EventType string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// 业务模块
+	Module int32 `protobuf:"varint,5,opt,name=module,proto3" json:"module,omitempty"`
+	// 投递状态
+	Status WebhookDelivery_Status `protobuf:"varint,6,opt,name=status,proto3,enum=comment.v1.WebhookDelivery_Status" json:"status,omitempty"`
+	// 已投递次数
+	Attempts int32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// 最近一次响应的 HTTP 状态码，未收到响应时为 0
+	LastStatusCode int32 `protobuf:"varint,8,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	// 最近一次失败原因
+	LastError string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// 下次重试时间
+	NextRetryTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_retry_time,json=nextRetryTime,proto3" json:"next_retry_time,omitempty"`
+	// 创建时间
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_comment_v1_comment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDelivery_Status {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDelivery_PENDING
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextRetryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRetryTime
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按订阅过滤，0 表示不过滤
+	SubscriptionId int64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // 校验规则: 订阅ID必须大于等于0
+	// 按投递状态过滤
+	Status *WebhookDelivery_Status `protobuf:"varint,2,opt,name=status,proto3,enum=comment.v1.WebhookDelivery_Status,oneof" json:"status,omitempty"`
+	// 分页参数
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDelivery_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return WebhookDelivery_PENDING
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 投递记录列表，按创建时间降序
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RetryWebhookDeliveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 投递记录唯一标识
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 校验规则: 投递记录ID必须大于0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WatchCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
+	// 资源唯一标识
+	ResourceId    string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCommentsRequest) Reset() {
+	*x = WatchCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCommentsRequest) ProtoMessage() {}
+
+func (x *WatchCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCommentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchCommentsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *WatchCommentsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+// CommentChange 资源下的一次评论变更
+type CommentChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  CommentChange_Type     `protobuf:"varint,1,opt,name=type,proto3,enum=comment.v1.CommentChange_Type" json:"type,omitempty"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"`
+	// 资源唯一标识
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// 发生变更的评论ID
+	CommentId int64 `protobuf:"varint,4,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// 新创建的评论，仅 CREATED 时有值
+	Comment *Comment `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	// 被删除的评论ID（包含 comment_id 本身），仅 DELETED 时有值
+	DeletedCommentIds []int64 `protobuf:"varint,6,rep,packed,name=deleted_comment_ids,json=deletedCommentIds,proto3" json:"deleted_comment_ids,omitempty"`
+	// 变更后的点赞数，仅 LIKE_COUNT_CHANGED 时有值
+	LikeCount int64 `protobuf:"varint,7,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	// 变更发生时间
+	OccurTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occur_time,json=occurTime,proto3" json:"occur_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentChange) Reset() {
+	*x = CommentChange{}
+	mi := &file_comment_v1_comment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentChange) ProtoMessage() {}
+
+func (x *CommentChange) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentChange.ProtoReflect.Descriptor instead.
+func (*CommentChange) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CommentChange) GetType() CommentChange_Type {
+	if x != nil {
+		return x.Type
+	}
+	return CommentChange_TYPE_UNSPECIFIED
+}
+
+func (x *CommentChange) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *CommentChange) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *CommentChange) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *CommentChange) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CommentChange) GetDeletedCommentIds() []int64 {
+	if x != nil {
+		return x.DeletedCommentIds
+	}
+	return nil
+}
+
+func (x *CommentChange) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *CommentChange) GetOccurTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurTime
+	}
+	return nil
+}
+
+// Report 一条评论举报
+type Report struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 举报唯一标识
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 被举报评论ID
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// 举报用户
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 举报原因
+	Reason Report_Reason `protobuf:"varint,4,opt,name=reason,proto3,enum=comment.v1.Report_Reason" json:"reason,omitempty"`
+	// 补充说明
+	Detail string `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	// 处理状态
+	Status Report_Status `protobuf:"varint,6,opt,name=status,proto3,enum=comment.v1.Report_Status" json:"status,omitempty"`
+	// 举报时间
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_comment_v1_comment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Report) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Report) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Report) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Report) GetReason() Report_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Report_REASON_UNSPECIFIED
+}
+
+func (x *Report) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() Report_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Report_PENDING
+}
+
+func (x *Report) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// 举报评论请求
+type ReportCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被举报评论ID
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0
+	// 举报用户
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1
+	// 举报原因
+	Reason Report_Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=comment.v1.Report_Reason" json:"reason,omitempty"` // 校验规则: 必须是已定义的举报原因
+	// 补充说明
+	Detail        string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"` // 校验规则: 补充说明不超过500字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCommentRequest) Reset() {
+	*x = ReportCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCommentRequest) ProtoMessage() {}
+
+func (x *ReportCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCommentRequest.ProtoReflect.Descriptor instead.
+func (*ReportCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReportCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ReportCommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportCommentRequest) GetReason() Report_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Report_REASON_UNSPECIFIED
+}
+
+func (x *ReportCommentRequest) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ReportCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 举报结果
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 该评论当前待处理的举报数
+	ReportCount int64 `protobuf:"varint,2,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// 该评论是否已被隐藏
+	Hidden        bool `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCommentResponse) Reset() {
+	*x = ReportCommentResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCommentResponse) ProtoMessage() {}
+
+func (x *ReportCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCommentResponse.ProtoReflect.Descriptor instead.
+func (*ReportCommentResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReportCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportCommentResponse) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ReportCommentResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+// 按评论聚合查询举报请求
+type ListReportedCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按业务模块过滤，0 表示不过滤
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	// 按举报状态过滤，默认待处理
+	Status Report_Status `protobuf:"varint,2,opt,name=status,proto3,enum=comment.v1.Report_Status" json:"status,omitempty"`
+	// 分页参数
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportedCommentsRequest) Reset() {
+	*x = ListReportedCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportedCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportedCommentsRequest) ProtoMessage() {}
+
+func (x *ListReportedCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportedCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportedCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListReportedCommentsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *ListReportedCommentsRequest) GetStatus() Report_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Report_PENDING
+}
+
+func (x *ListReportedCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReportedCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ReportedComment 被举报的评论及其举报汇总
+type ReportedComment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被举报的评论
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	// 举报数
+	ReportCount int64 `protobuf:"varint,2,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// 按原因统计的举报数
+	Reasons []*ReportedComment_ReasonCount `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// 最近一次举报时间
+	LastReportTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_report_time,json=lastReportTime,proto3" json:"last_report_time,omitempty"`
+	// 最近的若干条举报
+	RecentReports []*Report `protobuf:"bytes,5,rep,name=recent_reports,json=recentReports,proto3" json:"recent_reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportedComment) Reset() {
+	*x = ReportedComment{}
+	mi := &file_comment_v1_comment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportedComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportedComment) ProtoMessage() {}
+
+func (x *ReportedComment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportedComment.ProtoReflect.Descriptor instead.
+func (*ReportedComment) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReportedComment) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *ReportedComment) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ReportedComment) GetReasons() []*ReportedComment_ReasonCount {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ReportedComment) GetLastReportTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReportTime
+	}
+	return nil
+}
+
+func (x *ReportedComment) GetRecentReports() []*Report {
+	if x != nil {
+		return x.RecentReports
+	}
+	return nil
+}
+
+type ListReportedCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被举报的评论，按举报数降序
+	ReportedComments []*ReportedComment `protobuf:"bytes,1,rep,name=reported_comments,json=reportedComments,proto3" json:"reported_comments,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListReportedCommentsResponse) Reset() {
+	*x = ListReportedCommentsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportedCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportedCommentsResponse) ProtoMessage() {}
+
+func (x *ListReportedCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportedCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportedCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListReportedCommentsResponse) GetReportedComments() []*ReportedComment {
+	if x != nil {
+		return x.ReportedComments
+	}
+	return nil
+}
+
+// 处理举报请求
+type ResolveReportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被举报评论ID
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0
+	// 处理方式
+	Action        ResolveReportsRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=comment.v1.ResolveReportsRequest_Action" json:"action,omitempty"` // 校验规则: 必须是已定义的处理方式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsRequest) Reset() {
+	*x = ResolveReportsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsRequest) ProtoMessage() {}
+
+func (x *ResolveReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResolveReportsRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ResolveReportsRequest) GetAction() ResolveReportsRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ResolveReportsRequest_ACTION_UNSPECIFIED
+}
+
+type ResolveReportsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 本次处理的举报数
+	ResolvedCount int64 `protobuf:"varint,1,opt,name=resolved_count,json=resolvedCount,proto3" json:"resolved_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsResponse) Reset() {
+	*x = ResolveReportsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsResponse) ProtoMessage() {}
+
+func (x *ResolveReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResolveReportsResponse) GetResolvedCount() int64 {
+	if x != nil {
+		return x.ResolvedCount
+	}
+	return 0
+}
+
+// 审核评论请求
+type ModerateCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 评论ID
+	CommentId     int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ModerateCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+// 拉黑用户请求
+type BlockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发起拉黑的用户
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID长度介于1-32字符
+	// 被拉黑的用户
+	BlockedUserId string `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"` // 校验规则: 用户ID长度介于1-32字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+// 取消拉黑用户请求
+type UnblockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发起拉黑的用户
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID长度介于1-32字符
+	// 被拉黑的用户
+	BlockedUserId string `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"` // 校验规则: 用户ID长度介于1-32字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnblockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 操作结果
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 资源评论设置
+type ResourceCommentSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"`
+	// 资源唯一标识
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// 评论状态
+	Status ResourceCommentSettings_Status `protobuf:"varint,3,opt,name=status,proto3,enum=comment.v1.ResourceCommentSettings_Status" json:"status,omitempty"`
+	// 允许的最大回复层级，0 表示不限制
+	MaxReplyDepth int32 `protobuf:"varint,4,opt,name=max_reply_depth,json=maxReplyDepth,proto3" json:"max_reply_depth,omitempty"`
+	// 新评论是否需要审核，需要审核的评论发表后先隐藏
+	ModerationRequired bool `protobuf:"varint,5,opt,name=moderation_required,json=moderationRequired,proto3" json:"moderation_required,omitempty"`
+	// 更新时间
+	UpdateGmt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_gmt,json=updateGmt,proto3" json:"update_gmt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceCommentSettings) Reset() {
+	*x = ResourceCommentSettings{}
+	mi := &file_comment_v1_comment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceCommentSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceCommentSettings) ProtoMessage() {}
+
+func (x *ResourceCommentSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceCommentSettings.ProtoReflect.Descriptor instead.
+func (*ResourceCommentSettings) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResourceCommentSettings) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *ResourceCommentSettings) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ResourceCommentSettings) GetStatus() ResourceCommentSettings_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ResourceCommentSettings_OPEN
+}
+
+func (x *ResourceCommentSettings) GetMaxReplyDepth() int32 {
+	if x != nil {
+		return x.MaxReplyDepth
+	}
+	return 0
+}
+
+func (x *ResourceCommentSettings) GetModerationRequired() bool {
+	if x != nil {
+		return x.ModerationRequired
+	}
+	return false
+}
+
+func (x *ResourceCommentSettings) GetUpdateGmt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateGmt
+	}
+	return nil
+}
+
+// 设置资源评论请求
+type SetResourceCommentSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0
+	// 资源唯一标识
+	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID长度介于1-32字符
+	// 评论状态
+	Status ResourceCommentSettings_Status `protobuf:"varint,3,opt,name=status,proto3,enum=comment.v1.ResourceCommentSettings_Status" json:"status,omitempty"` // 校验规则: 必须是已定义的评论状态
+	// 允许的最大回复层级，0 表示不限制
+	MaxReplyDepth int32 `protobuf:"varint,4,opt,name=max_reply_depth,json=maxReplyDepth,proto3" json:"max_reply_depth,omitempty"` // 校验规则: 最大回复层级介于0-10之间
+	// 新评论是否需要审核
+	ModerationRequired bool `protobuf:"varint,5,opt,name=moderation_required,json=moderationRequired,proto3" json:"moderation_required,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetResourceCommentSettingsRequest) Reset() {
+	*x = SetResourceCommentSettingsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResourceCommentSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResourceCommentSettingsRequest) ProtoMessage() {}
+
+func (x *SetResourceCommentSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResourceCommentSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceCommentSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetResourceCommentSettingsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *SetResourceCommentSettingsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *SetResourceCommentSettingsRequest) GetStatus() ResourceCommentSettings_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ResourceCommentSettings_OPEN
+}
+
+func (x *SetResourceCommentSettingsRequest) GetMaxReplyDepth() int32 {
+	if x != nil {
+		return x.MaxReplyDepth
+	}
+	return 0
+}
+
+func (x *SetResourceCommentSettingsRequest) GetModerationRequired() bool {
+	if x != nil {
+		return x.ModerationRequired
+	}
+	return false
+}
 
-func (x GetCommentRequest_SortType) Enum() *GetCommentRequest_SortType {
-	p := new(GetCommentRequest_SortType)
-	*p = x
-	return p
+// 获取资源评论设置请求
+type GetResourceCommentSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务模块标识
+	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0
+	// 资源唯一标识
+	ResourceId    string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID长度介于1-32字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x GetCommentRequest_SortType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *GetResourceCommentSettingsRequest) Reset() {
+	*x = GetResourceCommentSettingsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (GetCommentRequest_SortType) Descriptor() protoreflect.EnumDescriptor {
-	return file_comment_v1_comment_proto_enumTypes[0].Descriptor()
+func (x *GetResourceCommentSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (GetCommentRequest_SortType) Type() protoreflect.EnumType {
-	return &file_comment_v1_comment_proto_enumTypes[0]
+func (*GetResourceCommentSettingsRequest) ProtoMessage() {}
+
+func (x *GetResourceCommentSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x GetCommentRequest_SortType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use GetResourceCommentSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceCommentSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{39}
 }
 
-// Deprecated: Use GetCommentRequest_SortType.Descriptor instead.
-func (GetCommentRequest_SortType) EnumDescriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6, 0}
+func (x *GetResourceCommentSettingsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
 }
 
-// 点赞评论请求
-type LikeCommentRequest struct {
+func (x *GetResourceCommentSettingsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+// 搜索评论请求
+type SearchCommentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 评论唯一标识
-	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保指定了要点赞的具体评论
-	// 用户唯一标识
-	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了点赞用户身份
+	// 搜索关键词，中文按 2 字分词匹配
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 校验规则: 关键词长度介于1-100字符
+	// 按业务模块过滤，0 表示不过滤
+	Module int32 `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	// 按资源过滤，为空表示不过滤
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID不超过32字符
+	// 按评论用户过滤，为空表示不过滤
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID不超过32字符
+	// 评论创建时间范围 [start_time, end_time)，为空表示不限制
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 是否包含被隐藏或未审核通过的评论
+	IncludeHidden bool `protobuf:"varint,7,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	// 分页参数，默认第1页，每页10条
+	Page          int32 `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      int32 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量，最大100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentRequest) Reset() {
-	*x = LikeCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[0]
+func (x *SearchCommentsRequest) Reset() {
+	*x = SearchCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentRequest) String() string {
+func (x *SearchCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentRequest) ProtoMessage() {}
+func (*SearchCommentsRequest) ProtoMessage() {}
 
-func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[0]
+func (x *SearchCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,51 +3597,99 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SearchCommentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LikeCommentRequest) GetCommentId() int64 {
+func (x *SearchCommentsRequest) GetKeyword() string {
 	if x != nil {
-		return x.CommentId
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchCommentsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
 	}
 	return 0
 }
 
-func (x *LikeCommentRequest) GetUserId() string {
+func (x *SearchCommentsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *SearchCommentsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-// 点赞评论响应
-type LikeResponse struct {
+func (x *SearchCommentsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SearchCommentsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SearchCommentsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+func (x *SearchCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchCommentsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 点赞结果
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// 点赞后的点赞数
-	LikeCount     int64 `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 校验规则: 点赞数必须大于等于0，确保数量为非负数
+	// 匹配的评论列表，按相关度降序
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// 匹配总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeResponse) Reset() {
-	*x = LikeResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[1]
+func (x *SearchCommentsResponse) Reset() {
+	*x = SearchCommentsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeResponse) String() string {
+func (x *SearchCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeResponse) ProtoMessage() {}
+func (*SearchCommentsResponse) ProtoMessage() {}
 
-func (x *LikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[1]
+func (x *SearchCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,51 +3700,57 @@ func (x *LikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
-func (*LikeResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SearchCommentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *LikeResponse) GetSuccess() bool {
+func (x *SearchCommentsResponse) GetComments() []*Comment {
 	if x != nil {
-		return x.Success
+		return x.Comments
 	}
-	return false
+	return nil
 }
 
-func (x *LikeResponse) GetLikeCount() int64 {
+func (x *SearchCommentsResponse) GetTotal() int64 {
 	if x != nil {
-		return x.LikeCount
+		return x.Total
 	}
 	return 0
 }
 
-// 取消点赞评论请求
-type UnlikeCommentRequest struct {
+// 获取用户评论历史请求
+type ListUserCommentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 评论唯一标识
-	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保指定了要取消点赞的具体评论
-	// 用户唯一标识
-	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了取消点赞的用户身份
+	// 评论用户
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID长度介于1-32字符
+	// 按业务模块过滤，0 表示不过滤
+	Module int32 `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	// 分页游标，取上一页返回的 next_cursor，为空表示第一页
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` // 校验规则: 游标不超过128字符
+	// 时间排序
+	Order ListUserCommentsRequest_Order `protobuf:"varint,4,opt,name=order,proto3,enum=comment.v1.ListUserCommentsRequest_Order" json:"order,omitempty"` // 校验规则: 必须是已定义的排序
+	// 每页数量，默认10
+	PageSize      int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 校验规则: 每页数量最大100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeCommentRequest) Reset() {
-	*x = UnlikeCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[2]
+func (x *ListUserCommentsRequest) Reset() {
+	*x = ListUserCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeCommentRequest) String() string {
+func (x *ListUserCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeCommentRequest) ProtoMessage() {}
+func (*ListUserCommentsRequest) ProtoMessage() {}
 
-func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[2]
+func (x *ListUserCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,51 +3761,70 @@ func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListUserCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UnlikeCommentRequest) GetCommentId() int64 {
+func (x *ListUserCommentsRequest) GetUserId() string {
 	if x != nil {
-		return x.CommentId
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserCommentsRequest) GetModule() int32 {
+	if x != nil {
+		return x.Module
 	}
 	return 0
 }
 
-func (x *UnlikeCommentRequest) GetUserId() string {
+func (x *ListUserCommentsRequest) GetCursor() string {
 	if x != nil {
-		return x.UserId
+		return x.Cursor
 	}
 	return ""
 }
 
-// 取消点赞评论响应
-type UnlikeResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 取消点赞结果
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// 取消点赞后的点赞数
-	LikeCount     int64 `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 校验规则: 点赞数必须大于等于0，确保数量为非负数
+func (x *ListUserCommentsRequest) GetOrder() ListUserCommentsRequest_Order {
+	if x != nil {
+		return x.Order
+	}
+	return ListUserCommentsRequest_NEWEST_FIRST
+}
+
+func (x *ListUserCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListUserCommentsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Comments []*UserComment         `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// 下一页游标，没有更多评论时为空
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeResponse) Reset() {
-	*x = UnlikeResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[3]
+func (x *ListUserCommentsResponse) Reset() {
+	*x = ListUserCommentsResponse{}
+	mi := &file_comment_v1_comment_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeResponse) String() string {
+func (x *ListUserCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeResponse) ProtoMessage() {}
+func (*ListUserCommentsResponse) ProtoMessage() {}
 
-func (x *UnlikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[3]
+func (x *ListUserCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,62 +3835,51 @@ func (x *UnlikeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeResponse.ProtoReflect.Descriptor instead.
-func (*UnlikeResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListUserCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UnlikeResponse) GetSuccess() bool {
+func (x *ListUserCommentsResponse) GetComments() []*UserComment {
 	if x != nil {
-		return x.Success
+		return x.Comments
 	}
-	return false
+	return nil
 }
 
-func (x *UnlikeResponse) GetLikeCount() int64 {
+func (x *ListUserCommentsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.LikeCount
+		return x.NextCursor
 	}
-	return 0
+	return ""
 }
 
-type CreateCommentRequest struct {
+// 用户评论及其上下文
+type UserComment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 模块标识，用于区分不同业务模块，必须大于零
-	// 例如: 1-文章, 2-视频, 3-商品等
-	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
-	// 资源ID，关联的业务资源唯一标识，长度必须大于零
-	// 例如: 文章ID、视频ID等
-	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
-	// 用户信息
-	UserId   string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了评论用户身份
-	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`           // 校验规则: 用户名字符串长度必须大于等于1，确保显示名称不为空
-	Avatar   string `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`               // 校验规则: 用户头像URL字符串长度必须大于等于1，确保头像信息不为空
-	// 评论内容，支持文本和表情，长度必须大于零并且小于两千字符
-	Content string `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"` // 校验规则: 评论内容必须介于1-2000字符之间，确保内容不为空且不会过长
-	// 评论层级关系
-	ParentCommentId int64 `protobuf:"varint,7,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"` // 校验规则: 父评论ID必须大于等于0，0表示顶级评论
-	Level           int32 `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"`                                              // 校验规则: 评论层级必须大于等于0，0为顶级评论
-	RootCommentId   int64 `protobuf:"varint,9,opt,name=root_comment_id,json=rootCommentId,proto3" json:"root_comment_id,omitempty"`       // 校验规则: 根评论ID必须大于等于0，用于快速查找整个评论链
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 评论，module 和 resource_id 为评论所属的资源
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	// 被回复的评论摘要，根评论没有该字段
+	Parent        *ParentSnippet `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommentRequest) Reset() {
-	*x = CreateCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[4]
+func (x *UserComment) Reset() {
+	*x = UserComment{}
+	mi := &file_comment_v1_comment_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentRequest) String() string {
+func (x *UserComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentRequest) ProtoMessage() {}
+func (*UserComment) ProtoMessage() {}
 
-func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[4]
+func (x *UserComment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,119 +3890,161 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UserComment.ProtoReflect.Descriptor instead.
+func (*UserComment) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreateCommentRequest) GetModule() int32 {
+func (x *UserComment) GetComment() *Comment {
 	if x != nil {
-		return x.Module
+		return x.Comment
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateCommentRequest) GetResourceId() string {
+func (x *UserComment) GetParent() *ParentSnippet {
 	if x != nil {
-		return x.ResourceId
+		return x.Parent
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCommentRequest) GetUserId() string {
+// 被回复的评论摘要
+type ParentSnippet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被回复评论ID
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// 被回复评论的用户
+	UserId   string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// 内容摘要，最多50个字符，被隐藏时为空
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// 被回复的评论是否已删除
+	Deleted bool `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// 被回复的评论是否被隐藏或未通过审核，此时不返回内容
+	Hidden        bool `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParentSnippet) Reset() {
+	*x = ParentSnippet{}
+	mi := &file_comment_v1_comment_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParentSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParentSnippet) ProtoMessage() {}
+
+func (x *ParentSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[45]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCommentRequest) GetUsername() string {
+// Deprecated: Use ParentSnippet.ProtoReflect.Descriptor instead.
+func (*ParentSnippet) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ParentSnippet) GetCommentId() int64 {
 	if x != nil {
-		return x.Username
+		return x.CommentId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCommentRequest) GetAvatar() string {
+func (x *ParentSnippet) GetUserId() string {
 	if x != nil {
-		return x.Avatar
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateCommentRequest) GetContent() string {
+func (x *ParentSnippet) GetUsername() string {
 	if x != nil {
-		return x.Content
+		return x.Username
 	}
 	return ""
 }
 
-func (x *CreateCommentRequest) GetParentCommentId() int64 {
+func (x *ParentSnippet) GetContent() string {
 	if x != nil {
-		return x.ParentCommentId
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCommentRequest) GetLevel() int32 {
+func (x *ParentSnippet) GetDeleted() bool {
 	if x != nil {
-		return x.Level
+		return x.Deleted
 	}
-	return 0
+	return false
 }
 
-func (x *CreateCommentRequest) GetRootCommentId() int64 {
+func (x *ParentSnippet) GetHidden() bool {
 	if x != nil {
-		return x.RootCommentId
+		return x.Hidden
 	}
-	return 0
+	return false
 }
 
-// Comment 评论消息
-// 包含评论的基本信息和回复列表
-type Comment struct {
+// 批量删除任务
+type BulkDeleteJob struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 业务模块标识
-	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
-	// 资源唯一标识
-	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
-	// 评论唯一标识
-	CommentId int64 `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保评论有有效的唯一标识
-	// 用户相关字段
-	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保指定了评论用户身份
-	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`           // 校验规则: 用户名字符串长度必须大于等于1，确保显示名称不为空
-	Avatar   string `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`               // 校验规则: 用户头像URL字符串长度必须大于等于1，确保头像信息不为空
-	// 评论内容
-	Content string `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"` // 校验规则: 评论内容必须介于1-2000字符之间，确保内容不为空且不会过长
-	// 评论层级信息
-	Level int32 `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"` // 校验规则: 评论层级必须大于等于0，0为顶级评论
-	// 评论点赞数
-	LikeCount int64 `protobuf:"varint,11,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 校验规则: 点赞数必须大于等于0，确保数量为非负数
-	// 评论回复数
-	ReplyCount int64 `protobuf:"varint,12,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"` // 校验规则: 回复数必须大于等于0，确保数量为非负数
-	// 回复评论列表
-	ReplyComments []*Comment `protobuf:"bytes,9,rep,name=reply_comments,json=replyComments,proto3" json:"reply_comments,omitempty"`
-	// 评论时间
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 校验规则: 创建时间必须存在且有效
+	// 任务唯一标识
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 按用户删除时的用户ID
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 按资源删除时的业务模块
+	Module int32 `protobuf:"varint,3,opt,name=module,proto3" json:"module,omitempty"`
+	// 按资源删除时的资源ID
+	ResourceId string `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// 删除方式
+	Mode BulkDeleteJob_Mode `protobuf:"varint,5,opt,name=mode,proto3,enum=comment.v1.BulkDeleteJob_Mode" json:"mode,omitempty"`
+	// 任务状态
+	Status BulkDeleteJob_Status `protobuf:"varint,6,opt,name=status,proto3,enum=comment.v1.BulkDeleteJob_Status" json:"status,omitempty"`
+	// 创建任务时匹配的评论数
+	Total int64 `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	// 已处理的匹配评论数
+	Processed int64 `protobuf:"varint,8,opt,name=processed,proto3" json:"processed,omitempty"`
+	// 实际删除的评论数，物理删除时包含其他用户在这些评论下的回复
+	Affected int64 `protobuf:"varint,9,opt,name=affected,proto3" json:"affected,omitempty"`
+	// 失败原因
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// 创建时间
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// 最近一次进度更新时间
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_comment_v1_comment_proto_msgTypes[5]
+func (x *BulkDeleteJob) Reset() {
+	*x = BulkDeleteJob{}
+	mi := &file_comment_v1_comment_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *BulkDeleteJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*BulkDeleteJob) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[5]
+func (x *BulkDeleteJob) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,126 +4055,124 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BulkDeleteJob.ProtoReflect.Descriptor instead.
+func (*BulkDeleteJob) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Comment) GetModule() int32 {
+func (x *BulkDeleteJob) GetId() int64 {
 	if x != nil {
-		return x.Module
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Comment) GetResourceId() string {
+func (x *BulkDeleteJob) GetUserId() string {
 	if x != nil {
-		return x.ResourceId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Comment) GetCommentId() int64 {
+func (x *BulkDeleteJob) GetModule() int32 {
 	if x != nil {
-		return x.CommentId
+		return x.Module
 	}
 	return 0
 }
 
-func (x *Comment) GetUserId() string {
+func (x *BulkDeleteJob) GetResourceId() string {
 	if x != nil {
-		return x.UserId
+		return x.ResourceId
 	}
 	return ""
 }
 
-func (x *Comment) GetUsername() string {
+func (x *BulkDeleteJob) GetMode() BulkDeleteJob_Mode {
 	if x != nil {
-		return x.Username
+		return x.Mode
 	}
-	return ""
+	return BulkDeleteJob_HARD
 }
 
-func (x *Comment) GetAvatar() string {
+func (x *BulkDeleteJob) GetStatus() BulkDeleteJob_Status {
 	if x != nil {
-		return x.Avatar
+		return x.Status
 	}
-	return ""
+	return BulkDeleteJob_PENDING
 }
 
-func (x *Comment) GetContent() string {
+func (x *BulkDeleteJob) GetTotal() int64 {
 	if x != nil {
-		return x.Content
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *Comment) GetLevel() int32 {
+func (x *BulkDeleteJob) GetProcessed() int64 {
 	if x != nil {
-		return x.Level
+		return x.Processed
 	}
 	return 0
 }
 
-func (x *Comment) GetLikeCount() int64 {
+func (x *BulkDeleteJob) GetAffected() int64 {
 	if x != nil {
-		return x.LikeCount
+		return x.Affected
 	}
 	return 0
 }
 
-func (x *Comment) GetReplyCount() int64 {
+func (x *BulkDeleteJob) GetError() string {
 	if x != nil {
-		return x.ReplyCount
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetReplyComments() []*Comment {
+func (x *BulkDeleteJob) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ReplyComments
+		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *Comment) GetCreateTime() *timestamppb.Timestamp {
+func (x *BulkDeleteJob) GetUpdateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreateTime
+		return x.UpdateTime
 	}
 	return nil
 }
 
-type GetCommentRequest struct {
+type BulkDeleteCommentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 业务模块标识
-	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
-	// 资源唯一标识
-	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
-	// 最大层级深度
-	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // 校验规则: 最大层级深度必须介于1-10之间，防止查询过深导致性能问题
-	// 分页参数
-	Page          int32                      `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                                                    // 页码，从1开始
-	PageSize      int32                      `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                            // 每页数量，最大100
-	SortType      GetCommentRequest_SortType `protobuf:"varint,6,opt,name=sort_type,json=sortType,proto3,enum=comment.v1.GetCommentRequest_SortType" json:"sort_type,omitempty"` // 根评论排序类型
+	// 删除该用户发表的全部评论，与 module、resource_id 二选一
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID长度不能超过32
+	// 删除该资源下的全部评论，需同时指定 resource_id
+	Module int32 `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	// 资源ID
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID长度不能超过32
+	// 删除方式，默认物理删除
+	Mode          BulkDeleteJob_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=comment.v1.BulkDeleteJob_Mode" json:"mode,omitempty"` // 校验规则: 删除方式必须是已定义的值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentRequest) Reset() {
-	*x = GetCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[6]
+func (x *BulkDeleteCommentsRequest) Reset() {
+	*x = BulkDeleteCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentRequest) String() string {
+func (x *BulkDeleteCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentRequest) ProtoMessage() {}
+func (*BulkDeleteCommentsRequest) ProtoMessage() {}
 
-func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[6]
+func (x *BulkDeleteCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,76 +4183,62 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetCommentRequest) GetModule() int32 {
-	if x != nil {
-		return x.Module
-	}
-	return 0
+// Deprecated: Use BulkDeleteCommentsRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetCommentRequest) GetResourceId() string {
+func (x *BulkDeleteCommentsRequest) GetUserId() string {
 	if x != nil {
-		return x.ResourceId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetCommentRequest) GetMaxDepth() int32 {
-	if x != nil {
-		return x.MaxDepth
-	}
-	return 0
-}
-
-func (x *GetCommentRequest) GetPage() int32 {
+func (x *BulkDeleteCommentsRequest) GetModule() int32 {
 	if x != nil {
-		return x.Page
+		return x.Module
 	}
 	return 0
 }
 
-func (x *GetCommentRequest) GetPageSize() int32 {
+func (x *BulkDeleteCommentsRequest) GetResourceId() string {
 	if x != nil {
-		return x.PageSize
+		return x.ResourceId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCommentRequest) GetSortType() GetCommentRequest_SortType {
+func (x *BulkDeleteCommentsRequest) GetMode() BulkDeleteJob_Mode {
 	if x != nil {
-		return x.SortType
+		return x.Mode
 	}
-	return GetCommentRequest_LIKE_COUNT_DESC
+	return BulkDeleteJob_HARD
 }
 
-type CommentTree struct {
+type GetBulkDeleteJobRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 评论列表
-	Comments      []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// 任务唯一标识
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 校验规则: 任务ID必须大于0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentTree) Reset() {
-	*x = CommentTree{}
-	mi := &file_comment_v1_comment_proto_msgTypes[7]
+func (x *GetBulkDeleteJobRequest) Reset() {
+	*x = GetBulkDeleteJobRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentTree) String() string {
+func (x *GetBulkDeleteJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentTree) ProtoMessage() {}
+func (*GetBulkDeleteJobRequest) ProtoMessage() {}
 
-func (x *CommentTree) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[7]
+func (x *GetBulkDeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,48 +4249,47 @@ func (x *CommentTree) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentTree.ProtoReflect.Descriptor instead.
-func (*CommentTree) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetBulkDeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*GetBulkDeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CommentTree) GetComments() []*Comment {
+func (x *GetBulkDeleteJobRequest) GetId() int64 {
 	if x != nil {
-		return x.Comments
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// 删除评论
-type DeleteCommentRequest struct {
+type ExportCommentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 业务模块标识
-	Module int32 `protobuf:"varint,1,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于0，确保指定了有效的业务模块
-	// 资源唯一标识
-	ResourceId string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID字符串长度必须大于等于1，确保关联到具体资源
-	// 评论唯一标识
-	CommentId int64 `protobuf:"varint,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // 校验规则: 评论ID必须大于0，确保指定了要删除的具体评论
-	// 用户相关字段
-	UserId        string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID字符串长度必须大于等于1，确保验证删除操作的用户身份
+	// 导出该用户发表的全部评论，与 module、resource_id 二选一
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 校验规则: 用户ID长度不能超过32
+	// 导出该资源下的全部评论，需同时指定 resource_id
+	Module int32 `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"` // 校验规则: 模块ID必须大于等于0
+	// 资源ID
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // 校验规则: 资源ID长度不能超过32
+	// 导出格式
+	Format        ExportCommentsRequest_Format `protobuf:"varint,4,opt,name=format,proto3,enum=comment.v1.ExportCommentsRequest_Format" json:"format,omitempty"` // 校验规则: 必须是已定义的导出格式
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
-	mi := &file_comment_v1_comment_proto_msgTypes[8]
+func (x *ExportCommentsRequest) Reset() {
+	*x = ExportCommentsRequest{}
+	mi := &file_comment_v1_comment_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *ExportCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*ExportCommentsRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[8]
+func (x *ExportCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,62 +4300,108 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExportCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DeleteCommentRequest) GetModule() int32 {
+func (x *ExportCommentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportCommentsRequest) GetModule() int32 {
 	if x != nil {
 		return x.Module
 	}
 	return 0
 }
 
-func (x *DeleteCommentRequest) GetResourceId() string {
+func (x *ExportCommentsRequest) GetResourceId() string {
 	if x != nil {
 		return x.ResourceId
 	}
 	return ""
 }
 
-func (x *DeleteCommentRequest) GetCommentId() int64 {
+func (x *ExportCommentsRequest) GetFormat() ExportCommentsRequest_Format {
 	if x != nil {
-		return x.CommentId
+		return x.Format
 	}
-	return 0
+	return ExportCommentsRequest_JSONL
 }
 
-func (x *DeleteCommentRequest) GetUserId() string {
+// 导出文件的一段，包含一批评论的完整行，CSV 的第一段以表头开始
+type ExportCommentsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCommentsChunk) Reset() {
+	*x = ExportCommentsChunk{}
+	mi := &file_comment_v1_comment_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCommentsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCommentsChunk) ProtoMessage() {}
+
+func (x *ExportCommentsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[50]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type DeleteResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 删除结果
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+// Deprecated: Use ExportCommentsChunk.ProtoReflect.Descriptor instead.
+func (*ExportCommentsChunk) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportCommentsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 各举报原因的数量
+type ReportedComment_ReasonCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        Report_Reason          `protobuf:"varint,1,opt,name=reason,proto3,enum=comment.v1.Report_Reason" json:"reason,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
-	mi := &file_comment_v1_comment_proto_msgTypes[9]
+func (x *ReportedComment_ReasonCount) Reset() {
+	*x = ReportedComment_ReasonCount{}
+	mi := &file_comment_v1_comment_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResponse) String() string {
+func (x *ReportedComment_ReasonCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResponse) ProtoMessage() {}
+func (*ReportedComment_ReasonCount) ProtoMessage() {}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_comment_v1_comment_proto_msgTypes[9]
+func (x *ReportedComment_ReasonCount) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_v1_comment_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,16 +4412,23 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_comment_v1_comment_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReportedComment_ReasonCount.ProtoReflect.Descriptor instead.
+func (*ReportedComment_ReasonCount) Descriptor() ([]byte, []int) {
+	return file_comment_v1_comment_proto_rawDescGZIP(), []int{29, 0}
 }
 
-func (x *DeleteResponse) GetSuccess() bool {
+func (x *ReportedComment_ReasonCount) GetReason() Report_Reason {
 	if x != nil {
-		return x.Success
+		return x.Reason
 	}
-	return false
+	return Report_REASON_UNSPECIFIED
+}
+
+func (x *ReportedComment_ReasonCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
 var File_comment_v1_comment_proto protoreflect.FileDescriptor
@@ -822,7 +4452,7 @@ const file_comment_v1_comment_proto_rawDesc = "" +
 	"\x0eUnlikeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
 	"\n" +
-	"like_count\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tlikeCount\"\xf4\x02\n" +
+	"like_count\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tlikeCount\"\xeb\x03\n" +
 	"\x14CreateCommentRequest\x12\x1f\n" +
 	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06module\x12(\n" +
 	"\vresource_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -834,7 +4464,10 @@ const file_comment_v1_comment_proto_rawDesc = "" +
 	"\xfaB\ar\x05\x10\x01\x18\xd0\x0fR\acontent\x123\n" +
 	"\x11parent_comment_id\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0fparentCommentId\x12\x1d\n" +
 	"\x05level\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05level\x12/\n" +
-	"\x0froot_comment_id\x18\t \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rrootCommentId\"\xfe\x03\n" +
+	"\x0froot_comment_id\x18\t \x01(\x03B\a\xfaB\x04\"\x02(\x00R\rrootCommentId\x121\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x0eidempotencyKey\x12B\n" +
+	"\vattachments\x18\v \x03(\v2\x16.comment.v1.AttachmentB\b\xfaB\x05\x92\x01\x02\x10\tR\vattachments\"\xf1\x06\n" +
 	"\aComment\x12\x1f\n" +
 	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06module\x12(\n" +
 	"\vresource_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -854,7 +4487,43 @@ const file_comment_v1_comment_proto_rawDesc = "" +
 	"\x0ereply_comments\x18\t \x03(\v2\x13.comment.v1.CommentR\rreplyComments\x12E\n" +
 	"\vcreate_time\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\n" +
-	"createTime\"\xc7\x02\n" +
+	"createTime\x12/\n" +
+	"\bmentions\x18\r \x03(\v2\x13.comment.v1.MentionR\bmentions\x12\x18\n" +
+	"\aflagged\x18\x0e \x01(\bR\aflagged\x12\x16\n" +
+	"\x06hidden\x18\x0f \x01(\bR\x06hidden\x128\n" +
+	"\vattachments\x18\x10 \x03(\v2\x16.comment.v1.AttachmentR\vattachments\x12!\n" +
+	"\fcontent_html\x18\x11 \x01(\tR\vcontentHtml\x12!\n" +
+	"\fcontent_text\x18\x12 \x01(\tR\vcontentText\x12Q\n" +
+	"\x11moderation_status\x18\x13 \x01(\x0e2$.comment.v1.Comment.ModerationStatusR\x10moderationStatus\";\n" +
+	"\x10ModerationStatus\x12\f\n" +
+	"\bAPPROVED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bREJECTED\x10\x02\"\x90\x03\n" +
+	"\n" +
+	"Attachment\x12;\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.comment.v1.Attachment.TypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x1c\n" +
+	"\x03url\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\bR\x03url\x12 \n" +
+	"\x05width\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x00R\x05width\x12\"\n" +
+	"\x06height\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x00R\x06height\x12\x1e\n" +
+	"\x05title\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05title\x12*\n" +
+	"\vdescription\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\vdescription\x12-\n" +
+	"\rthumbnail_url\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\fthumbnailUrl\x12&\n" +
+	"\n" +
+	"sticker_id\x18\b \x01(\tB\a\xfaB\x04r\x02\x18@R\tstickerId\">\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05IMAGE\x10\x01\x12\b\n" +
+	"\x04LINK\x10\x02\x12\v\n" +
+	"\aSTICKER\x10\x03\"f\n" +
+	"\aMention\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\"\x80\x03\n" +
 	"\x11GetCommentRequest\x12\x1f\n" +
 	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06module\x12(\n" +
 	"\vresource_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -862,11 +4531,14 @@ const file_comment_v1_comment_proto_rawDesc = "" +
 	"\tmax_depth\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\n" +
 	"(\x01R\bmaxDepth\x12\x1b\n" +
 	"\x04page\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
-	"\tpage_size\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12C\n" +
-	"\tsort_type\x18\x06 \x01(\x0e2&.comment.v1.GetCommentRequest.SortTypeR\bsortType\"5\n" +
+	"\tpage_size\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12H\n" +
+	"\tsort_type\x18\x06 \x01(\x0e2&.comment.v1.GetCommentRequest.SortTypeH\x00R\bsortType\x88\x01\x01\x12$\n" +
+	"\tviewer_id\x18\a \x01(\tB\a\xfaB\x04r\x02\x18 R\bviewerId\"5\n" +
 	"\bSortType\x12\x13\n" +
 	"\x0fLIKE_COUNT_DESC\x10\x00\x12\x14\n" +
-	"\x10CREATE_TIME_DESC\x10\x01\">\n" +
+	"\x10CREATE_TIME_DESC\x10\x01B\f\n" +
+	"\n" +
+	"_sort_type\">\n" +
 	"\vCommentTree\x12/\n" +
 	"\bcomments\x18\x01 \x03(\v2\x13.comment.v1.CommentR\bcomments\"\xab\x01\n" +
 	"\x14DeleteCommentRequest\x12\x1f\n" +
@@ -877,14 +4549,304 @@ const file_comment_v1_comment_proto_rawDesc = "" +
 	"comment_id\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tcommentId\x12 \n" +
 	"\auser_id\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"*\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x97\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x13ListMentionsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\"G\n" +
+	"\x14ListMentionsResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.comment.v1.CommentR\bcomments\"\xf3\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06module\x18\x03 \x01(\x05R\x06module\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x12;\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x9c\x02\n" +
+	" CreateWebhookSubscriptionRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12\x1f\n" +
+	"\x06module\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\x12{\n" +
+	"\vevent_types\x18\x03 \x03(\tBZ\xfaBW\x92\x01T\x18\x01\"PrNR\x0eCommentCreatedR\x0eCommentRepliedR\fCommentLikedR\x0eCommentUnlikedR\x0eCommentDeletedR\n" +
+	"eventTypes\x12\x1a\n" +
+	"\x03url\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x03url\x12\x1f\n" +
+	"\x06secret\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x10R\x06secret\";\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"B\n" +
+	"\x1fListWebhookSubscriptionsRequest\x12\x1f\n" +
+	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\"i\n" +
+	" ListWebhookSubscriptionsResponse\x12E\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1f.comment.v1.WebhookSubscriptionR\rsubscriptions\"\xee\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\x03R\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x03R\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06module\x18\x05 \x01(\x05R\x06module\x12:\n" +
+	"\x06status\x18\x06 \x01(\x0e2\".comment.v1.WebhookDelivery.StatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\b \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_retry_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextRetryTime\x12;\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\".\n" +
+	"\x06Status\x12\v\n" +
+	"\aPENDING\x10\x00\x12\r\n" +
+	"\tSUCCEEDED\x10\x01\x12\b\n" +
+	"\x04DEAD\x10\x02\"\xe1\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x120\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0esubscriptionId\x12?\n" +
+	"\x06status\x18\x02 \x01(\x0e2\".comment.v1.WebhookDelivery.StatusH\x00R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSizeB\t\n" +
+	"\a_status\"\\\n" +
+	"\x1dListWebhookDeliveriesResponse\x12;\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1b.comment.v1.WebhookDeliveryR\n" +
+	"deliveries\"6\n" +
+	"\x1bRetryWebhookDeliveryRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"a\n" +
+	"\x14WatchCommentsRequest\x12\x1f\n" +
+	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06module\x12(\n" +
+	"\vresource_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"resourceId\"\xa4\x03\n" +
+	"\rCommentChange\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.comment.v1.CommentChange.TypeR\x04type\x12\x16\n" +
+	"\x06module\x18\x02 \x01(\x05R\x06module\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x04 \x01(\x03R\tcommentId\x12-\n" +
+	"\acomment\x18\x05 \x01(\v2\x13.comment.v1.CommentR\acomment\x12.\n" +
+	"\x13deleted_comment_ids\x18\x06 \x03(\x03R\x11deletedCommentIds\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\a \x01(\x03R\tlikeCount\x129\n" +
+	"\n" +
+	"occur_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\toccurTime\"N\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aDELETED\x10\x02\x12\x16\n" +
+	"\x12LIKE_COUNT_CHANGED\x10\x03\"\xc0\x03\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x03R\tcommentId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x121\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x19.comment.v1.Report.ReasonR\x06reason\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\x121\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x19.comment.v1.Report.StatusR\x06status\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x7f\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04SPAM\x10\x01\x12\t\n" +
+	"\x05ABUSE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"HARASSMENT\x10\x03\x12\x0f\n" +
+	"\vHATE_SPEECH\x10\x04\x12\x0f\n" +
+	"\vPORNOGRAPHY\x10\x05\x12\v\n" +
+	"\aILLEGAL\x10\x06\x12\t\n" +
+	"\x05OTHER\x10\a\"2\n" +
+	"\x06Status\x12\v\n" +
+	"\aPENDING\x10\x00\x12\f\n" +
+	"\bACCEPTED\x10\x01\x12\r\n" +
+	"\tDISMISSED\x10\x02\"\xc1\x01\n" +
+	"\x14ReportCommentRequest\x12&\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tcommentId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12=\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x19.comment.v1.Report.ReasonB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06reason\x12 \n" +
+	"\x06detail\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x06detail\"l\n" +
+	"\x15ReportCommentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
+	"\freport_count\x18\x02 \x01(\x03R\vreportCount\x12\x16\n" +
+	"\x06hidden\x18\x03 \x01(\bR\x06hidden\"\xb6\x01\n" +
+	"\x1bListReportedCommentsRequest\x12\x1f\n" +
+	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.comment.v1.Report.StatusR\x06status\x12\x1b\n" +
+	"\x04page\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\"\xff\x02\n" +
+	"\x0fReportedComment\x12-\n" +
+	"\acomment\x18\x01 \x01(\v2\x13.comment.v1.CommentR\acomment\x12!\n" +
+	"\freport_count\x18\x02 \x01(\x03R\vreportCount\x12A\n" +
+	"\areasons\x18\x03 \x03(\v2'.comment.v1.ReportedComment.ReasonCountR\areasons\x12D\n" +
+	"\x10last_report_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReportTime\x129\n" +
+	"\x0erecent_reports\x18\x05 \x03(\v2\x12.comment.v1.ReportR\rrecentReports\x1aV\n" +
+	"\vReasonCount\x121\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x19.comment.v1.Report.ReasonR\x06reason\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"h\n" +
+	"\x1cListReportedCommentsResponse\x12H\n" +
+	"\x11reported_comments\x18\x01 \x03(\v2\x1b.comment.v1.ReportedCommentR\x10reportedComments\"\xd2\x01\n" +
+	"\x15ResolveReportsRequest\x12&\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tcommentId\x12L\n" +
+	"\x06action\x18\x02 \x01(\x0e2(.comment.v1.ResolveReportsRequest.ActionB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06action\"C\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aDISMISS\x10\x01\x12\b\n" +
+	"\x04HIDE\x10\x02\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x03\"?\n" +
+	"\x16ResolveReportsResponse\x12%\n" +
+	"\x0eresolved_count\x18\x01 \x01(\x03R\rresolvedCount\"@\n" +
+	"\x16ModerateCommentRequest\x12&\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tcommentId\"i\n" +
+	"\x10BlockUserRequest\x12\"\n" +
+	"\auser_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x06userId\x121\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\rblockedUserId\"k\n" +
+	"\x12UnblockUserRequest\x12\"\n" +
+	"\auser_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x06userId\x121\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\rblockedUserId\"-\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd9\x02\n" +
+	"\x17ResourceCommentSettings\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\x05R\x06module\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12B\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.comment.v1.ResourceCommentSettings.StatusR\x06status\x12&\n" +
+	"\x0fmax_reply_depth\x18\x04 \x01(\x05R\rmaxReplyDepth\x12/\n" +
+	"\x13moderation_required\x18\x05 \x01(\bR\x12moderationRequired\x129\n" +
+	"\n" +
+	"update_gmt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdateGmt\"-\n" +
+	"\x06Status\x12\b\n" +
+	"\x04OPEN\x10\x00\x12\n" +
+	"\n" +
+	"\x06CLOSED\x10\x01\x12\r\n" +
+	"\tREAD_ONLY\x10\x02\"\xa2\x02\n" +
+	"!SetResourceCommentSettingsRequest\x12\x1f\n" +
+	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06module\x12*\n" +
+	"\vresource_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\n" +
+	"resourceId\x12L\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.comment.v1.ResourceCommentSettings.StatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x121\n" +
+	"\x0fmax_reply_depth\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\n" +
+	"(\x00R\rmaxReplyDepth\x12/\n" +
+	"\x13moderation_required\x18\x05 \x01(\bR\x12moderationRequired\"p\n" +
+	"!GetResourceCommentSettingsRequest\x12\x1f\n" +
+	"\x06module\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x06module\x12*\n" +
+	"\vresource_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\n" +
+	"resourceId\"\x87\x03\n" +
+	"\x15SearchCommentsRequest\x12#\n" +
+	"\akeyword\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\akeyword\x12\x1f\n" +
+	"\x06module\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\x12(\n" +
+	"\vresource_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18 R\n" +
+	"resourceId\x12 \n" +
+	"\auser_id\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18 R\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12%\n" +
+	"\x0einclude_hidden\x18\a \x01(\bR\rincludeHidden\x12\x1b\n" +
+	"\x04page\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\t \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"_\n" +
+	"\x16SearchCommentsResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.comment.v1.CommentR\bcomments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa0\x02\n" +
+	"\x17ListUserCommentsRequest\x12\"\n" +
+	"\auser_id\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x06userId\x12\x1f\n" +
+	"\x06module\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\x12 \n" +
+	"\x06cursor\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\x06cursor\x12I\n" +
+	"\x05order\x18\x04 \x01(\x0e2).comment.v1.ListUserCommentsRequest.OrderB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05order\x12&\n" +
+	"\tpage_size\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\"+\n" +
+	"\x05Order\x12\x10\n" +
+	"\fNEWEST_FIRST\x10\x00\x12\x10\n" +
+	"\fOLDEST_FIRST\x10\x01\"p\n" +
+	"\x18ListUserCommentsResponse\x123\n" +
+	"\bcomments\x18\x01 \x03(\v2\x17.comment.v1.UserCommentR\bcomments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"o\n" +
+	"\vUserComment\x12-\n" +
+	"\acomment\x18\x01 \x01(\v2\x13.comment.v1.CommentR\acomment\x121\n" +
+	"\x06parent\x18\x02 \x01(\v2\x19.comment.v1.ParentSnippetR\x06parent\"\xaf\x01\n" +
+	"\rParentSnippet\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\bR\adeleted\x12\x16\n" +
+	"\x06hidden\x18\x06 \x01(\bR\x06hidden\"\x9a\x04\n" +
+	"\rBulkDeleteJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06module\x18\x03 \x01(\x05R\x06module\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x122\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x1e.comment.v1.BulkDeleteJob.ModeR\x04mode\x128\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .comment.v1.BulkDeleteJob.StatusR\x06status\x12\x14\n" +
+	"\x05total\x18\a \x01(\x03R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\b \x01(\x03R\tprocessed\x12\x1a\n" +
+	"\baffected\x18\t \x01(\x03R\baffected\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12;\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x1a\n" +
+	"\x04Mode\x12\b\n" +
+	"\x04HARD\x10\x00\x12\b\n" +
+	"\x04SOFT\x10\x01\"=\n" +
+	"\x06Status\x12\v\n" +
+	"\aPENDING\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"\xc6\x01\n" +
+	"\x19BulkDeleteCommentsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18 R\x06userId\x12\x1f\n" +
+	"\x06module\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\x12(\n" +
+	"\vresource_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18 R\n" +
+	"resourceId\x12<\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x1e.comment.v1.BulkDeleteJob.ModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04mode\"2\n" +
+	"\x17GetBulkDeleteJobRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xee\x01\n" +
+	"\x15ExportCommentsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18 R\x06userId\x12\x1f\n" +
+	"\x06module\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06module\x12(\n" +
+	"\vresource_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18 R\n" +
+	"resourceId\x12J\n" +
+	"\x06format\x18\x04 \x01(\x0e2(.comment.v1.ExportCommentsRequest.FormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\"\x1c\n" +
+	"\x06Format\x12\t\n" +
+	"\x05JSONL\x10\x00\x12\a\n" +
+	"\x03CSV\x10\x01\")\n" +
+	"\x13ExportCommentsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\x98\x19\n" +
 	"\x0eCommentService\x12b\n" +
 	"\rCreateComment\x12 .comment.v1.CreateCommentRequest\x1a\x13.comment.v1.Comment\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/comment\x12]\n" +
 	"\n" +
 	"GetComment\x12\x1d.comment.v1.GetCommentRequest\x1a\x17.comment.v1.CommentTree\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/comment\x12f\n" +
 	"\rDeleteComment\x12 .comment.v1.DeleteCommentRequest\x1a\x1a.comment.v1.DeleteResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/api/v1/comment\x12h\n" +
 	"\vLikeComment\x12\x1e.comment.v1.LikeCommentRequest\x1a\x18.comment.v1.LikeResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/comment/like\x12p\n" +
-	"\rUnlikeComment\x12 .comment.v1.UnlikeCommentRequest\x1a\x1a.comment.v1.UnlikeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/comment/unlikeBH\n" +
+	"\rUnlikeComment\x12 .comment.v1.UnlikeCommentRequest\x1a\x1a.comment.v1.UnlikeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/comment/unlike\x12r\n" +
+	"\fListMentions\x12\x1f.comment.v1.ListMentionsRequest\x1a .comment.v1.ListMentionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/comment/mention\x12{\n" +
+	"\x10ListUserComments\x12#.comment.v1.ListUserCommentsRequest\x1a$.comment.v1.ListUserCommentsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/user/comment\x12w\n" +
+	"\x0eSearchComments\x12!.comment.v1.SearchCommentsRequest\x1a\".comment.v1.SearchCommentsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/comment/search\x12N\n" +
+	"\rWatchComments\x12 .comment.v1.WatchCommentsRequest\x1a\x19.comment.v1.CommentChange0\x01\x12w\n" +
+	"\rReportComment\x12 .comment.v1.ReportCommentRequest\x1a!.comment.v1.ReportCommentResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/comment/report\x12\x95\x01\n" +
+	"\x1aSetResourceCommentSettings\x12-.comment.v1.SetResourceCommentSettingsRequest\x1a#.comment.v1.ResourceCommentSettings\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/comment/settings\x12\x92\x01\n" +
+	"\x1aGetResourceCommentSettings\x12-.comment.v1.GetResourceCommentSettingsRequest\x1a#.comment.v1.ResourceCommentSettings\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/comment/settings\x12g\n" +
+	"\tBlockUser\x12\x1c.comment.v1.BlockUserRequest\x1a\x1d.comment.v1.BlockUserResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/user/block\x12h\n" +
+	"\vUnblockUser\x12\x1e.comment.v1.UnblockUserRequest\x1a\x1d.comment.v1.BlockUserResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/user/block\x12\x87\x01\n" +
+	"\x14ListReportedComments\x12'.comment.v1.ListReportedCommentsRequest\x1a(.comment.v1.ListReportedCommentsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/admin/report\x12\x80\x01\n" +
+	"\x0eResolveReports\x12!.comment.v1.ResolveReportsRequest\x1a\".comment.v1.ResolveReportsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/admin/report/resolve\x12s\n" +
+	"\x0eApproveComment\x12\".comment.v1.ModerateCommentRequest\x1a\x13.comment.v1.Comment\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/admin/comment/approve\x12q\n" +
+	"\rRejectComment\x12\".comment.v1.ModerateCommentRequest\x1a\x13.comment.v1.Comment\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/admin/comment/reject\x12\x84\x01\n" +
+	"\x12BulkDeleteComments\x12%.comment.v1.BulkDeleteCommentsRequest\x1a\x19.comment.v1.BulkDeleteJob\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/comment/bulk_delete\x12}\n" +
+	"\x10GetBulkDeleteJob\x12#.comment.v1.GetBulkDeleteJobRequest\x1a\x19.comment.v1.BulkDeleteJob\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/admin/comment/bulk_delete\x12V\n" +
+	"\x0eExportComments\x12!.comment.v1.ExportCommentsRequest\x1a\x1f.comment.v1.ExportCommentsChunk0\x01\x12\x99\x01\n" +
+	"\x19CreateWebhookSubscription\x12,.comment.v1.CreateWebhookSubscriptionRequest\x1a\x1f.comment.v1.WebhookSubscription\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/admin/webhook/subscription\x12\x91\x01\n" +
+	"\x19DeleteWebhookSubscription\x12,.comment.v1.DeleteWebhookSubscriptionRequest\x1a\x1a.comment.v1.DeleteResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/admin/webhook/subscription\x12\xa1\x01\n" +
+	"\x18ListWebhookSubscriptions\x12+.comment.v1.ListWebhookSubscriptionsRequest\x1a,.comment.v1.ListWebhookSubscriptionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/admin/webhook/subscription\x12\x94\x01\n" +
+	"\x15ListWebhookDeliveries\x12(.comment.v1.ListWebhookDeliveriesRequest\x1a).comment.v1.ListWebhookDeliveriesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/admin/webhook/delivery\x12\x8d\x01\n" +
+	"\x14RetryWebhookDelivery\x12'.comment.v1.RetryWebhookDeliveryRequest\x1a\x1b.comment.v1.WebhookDelivery\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/webhook/delivery/retryBH\n" +
 	"\x19dev.kratos.api.comment.v1B\x0eCommentProtoV1P\x01Z\x19comment/api/comment/v1;v1b\x06proto3"
 
 var (
@@ -899,42 +4861,182 @@ func file_comment_v1_comment_proto_rawDescGZIP() []byte {
 	return file_comment_v1_comment_proto_rawDescData
 }
 
-var file_comment_v1_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_comment_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_comment_v1_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_comment_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_comment_v1_comment_proto_goTypes = []any{
-	(GetCommentRequest_SortType)(0), // 0: comment.v1.GetCommentRequest.SortType
-	(*LikeCommentRequest)(nil),      // 1: comment.v1.LikeCommentRequest
-	(*LikeResponse)(nil),            // 2: comment.v1.LikeResponse
-	(*UnlikeCommentRequest)(nil),    // 3: comment.v1.UnlikeCommentRequest
-	(*UnlikeResponse)(nil),          // 4: comment.v1.UnlikeResponse
-	(*CreateCommentRequest)(nil),    // 5: comment.v1.CreateCommentRequest
-	(*Comment)(nil),                 // 6: comment.v1.Comment
-	(*GetCommentRequest)(nil),       // 7: comment.v1.GetCommentRequest
-	(*CommentTree)(nil),             // 8: comment.v1.CommentTree
-	(*DeleteCommentRequest)(nil),    // 9: comment.v1.DeleteCommentRequest
-	(*DeleteResponse)(nil),          // 10: comment.v1.DeleteResponse
-	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
+	(Comment_ModerationStatus)(0),             // 0: comment.v1.Comment.ModerationStatus
+	(Attachment_Type)(0),                      // 1: comment.v1.Attachment.Type
+	(GetCommentRequest_SortType)(0),           // 2: comment.v1.GetCommentRequest.SortType
+	(WebhookDelivery_Status)(0),               // 3: comment.v1.WebhookDelivery.Status
+	(CommentChange_Type)(0),                   // 4: comment.v1.CommentChange.Type
+	(Report_Reason)(0),                        // 5: comment.v1.Report.Reason
+	(Report_Status)(0),                        // 6: comment.v1.Report.Status
+	(ResolveReportsRequest_Action)(0),         // 7: comment.v1.ResolveReportsRequest.Action
+	(ResourceCommentSettings_Status)(0),       // 8: comment.v1.ResourceCommentSettings.Status
+	(ListUserCommentsRequest_Order)(0),        // 9: comment.v1.ListUserCommentsRequest.Order
+	(BulkDeleteJob_Mode)(0),                   // 10: comment.v1.BulkDeleteJob.Mode
+	(BulkDeleteJob_Status)(0),                 // 11: comment.v1.BulkDeleteJob.Status
+	(ExportCommentsRequest_Format)(0),         // 12: comment.v1.ExportCommentsRequest.Format
+	(*LikeCommentRequest)(nil),                // 13: comment.v1.LikeCommentRequest
+	(*LikeResponse)(nil),                      // 14: comment.v1.LikeResponse
+	(*UnlikeCommentRequest)(nil),              // 15: comment.v1.UnlikeCommentRequest
+	(*UnlikeResponse)(nil),                    // 16: comment.v1.UnlikeResponse
+	(*CreateCommentRequest)(nil),              // 17: comment.v1.CreateCommentRequest
+	(*Comment)(nil),                           // 18: comment.v1.Comment
+	(*Attachment)(nil),                        // 19: comment.v1.Attachment
+	(*Mention)(nil),                           // 20: comment.v1.Mention
+	(*GetCommentRequest)(nil),                 // 21: comment.v1.GetCommentRequest
+	(*CommentTree)(nil),                       // 22: comment.v1.CommentTree
+	(*DeleteCommentRequest)(nil),              // 23: comment.v1.DeleteCommentRequest
+	(*DeleteResponse)(nil),                    // 24: comment.v1.DeleteResponse
+	(*ListMentionsRequest)(nil),               // 25: comment.v1.ListMentionsRequest
+	(*ListMentionsResponse)(nil),              // 26: comment.v1.ListMentionsResponse
+	(*WebhookSubscription)(nil),               // 27: comment.v1.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),  // 28: comment.v1.CreateWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 29: comment.v1.DeleteWebhookSubscriptionRequest
+	(*ListWebhookSubscriptionsRequest)(nil),   // 30: comment.v1.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),  // 31: comment.v1.ListWebhookSubscriptionsResponse
+	(*WebhookDelivery)(nil),                   // 32: comment.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),      // 33: comment.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 34: comment.v1.ListWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),       // 35: comment.v1.RetryWebhookDeliveryRequest
+	(*WatchCommentsRequest)(nil),              // 36: comment.v1.WatchCommentsRequest
+	(*CommentChange)(nil),                     // 37: comment.v1.CommentChange
+	(*Report)(nil),                            // 38: comment.v1.Report
+	(*ReportCommentRequest)(nil),              // 39: comment.v1.ReportCommentRequest
+	(*ReportCommentResponse)(nil),             // 40: comment.v1.ReportCommentResponse
+	(*ListReportedCommentsRequest)(nil),       // 41: comment.v1.ListReportedCommentsRequest
+	(*ReportedComment)(nil),                   // 42: comment.v1.ReportedComment
+	(*ListReportedCommentsResponse)(nil),      // 43: comment.v1.ListReportedCommentsResponse
+	(*ResolveReportsRequest)(nil),             // 44: comment.v1.ResolveReportsRequest
+	(*ResolveReportsResponse)(nil),            // 45: comment.v1.ResolveReportsResponse
+	(*ModerateCommentRequest)(nil),            // 46: comment.v1.ModerateCommentRequest
+	(*BlockUserRequest)(nil),                  // 47: comment.v1.BlockUserRequest
+	(*UnblockUserRequest)(nil),                // 48: comment.v1.UnblockUserRequest
+	(*BlockUserResponse)(nil),                 // 49: comment.v1.BlockUserResponse
+	(*ResourceCommentSettings)(nil),           // 50: comment.v1.ResourceCommentSettings
+	(*SetResourceCommentSettingsRequest)(nil), // 51: comment.v1.SetResourceCommentSettingsRequest
+	(*GetResourceCommentSettingsRequest)(nil), // 52: comment.v1.GetResourceCommentSettingsRequest
+	(*SearchCommentsRequest)(nil),             // 53: comment.v1.SearchCommentsRequest
+	(*SearchCommentsResponse)(nil),            // 54: comment.v1.SearchCommentsResponse
+	(*ListUserCommentsRequest)(nil),           // 55: comment.v1.ListUserCommentsRequest
+	(*ListUserCommentsResponse)(nil),          // 56: comment.v1.ListUserCommentsResponse
+	(*UserComment)(nil),                       // 57: comment.v1.UserComment
+	(*ParentSnippet)(nil),                     // 58: comment.v1.ParentSnippet
+	(*BulkDeleteJob)(nil),                     // 59: comment.v1.BulkDeleteJob
+	(*BulkDeleteCommentsRequest)(nil),         // 60: comment.v1.BulkDeleteCommentsRequest
+	(*GetBulkDeleteJobRequest)(nil),           // 61: comment.v1.GetBulkDeleteJobRequest
+	(*ExportCommentsRequest)(nil),             // 62: comment.v1.ExportCommentsRequest
+	(*ExportCommentsChunk)(nil),               // 63: comment.v1.ExportCommentsChunk
+	(*ReportedComment_ReasonCount)(nil),       // 64: comment.v1.ReportedComment.ReasonCount
+	(*timestamppb.Timestamp)(nil),             // 65: google.protobuf.Timestamp
 }
 var file_comment_v1_comment_proto_depIdxs = []int32{
-	6,  // 0: comment.v1.Comment.reply_comments:type_name -> comment.v1.Comment
-	11, // 1: comment.v1.Comment.create_time:type_name -> google.protobuf.Timestamp
-	0,  // 2: comment.v1.GetCommentRequest.sort_type:type_name -> comment.v1.GetCommentRequest.SortType
-	6,  // 3: comment.v1.CommentTree.comments:type_name -> comment.v1.Comment
-	5,  // 4: comment.v1.CommentService.CreateComment:input_type -> comment.v1.CreateCommentRequest
-	7,  // 5: comment.v1.CommentService.GetComment:input_type -> comment.v1.GetCommentRequest
-	9,  // 6: comment.v1.CommentService.DeleteComment:input_type -> comment.v1.DeleteCommentRequest
-	1,  // 7: comment.v1.CommentService.LikeComment:input_type -> comment.v1.LikeCommentRequest
-	3,  // 8: comment.v1.CommentService.UnlikeComment:input_type -> comment.v1.UnlikeCommentRequest
-	6,  // 9: comment.v1.CommentService.CreateComment:output_type -> comment.v1.Comment
-	8,  // 10: comment.v1.CommentService.GetComment:output_type -> comment.v1.CommentTree
-	10, // 11: comment.v1.CommentService.DeleteComment:output_type -> comment.v1.DeleteResponse
-	2,  // 12: comment.v1.CommentService.LikeComment:output_type -> comment.v1.LikeResponse
-	4,  // 13: comment.v1.CommentService.UnlikeComment:output_type -> comment.v1.UnlikeResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	19, // 0: comment.v1.CreateCommentRequest.attachments:type_name -> comment.v1.Attachment
+	18, // 1: comment.v1.Comment.reply_comments:type_name -> comment.v1.Comment
+	65, // 2: comment.v1.Comment.create_time:type_name -> google.protobuf.Timestamp
+	20, // 3: comment.v1.Comment.mentions:type_name -> comment.v1.Mention
+	19, // 4: comment.v1.Comment.attachments:type_name -> comment.v1.Attachment
+	0,  // 5: comment.v1.Comment.moderation_status:type_name -> comment.v1.Comment.ModerationStatus
+	1,  // 6: comment.v1.Attachment.type:type_name -> comment.v1.Attachment.Type
+	2,  // 7: comment.v1.GetCommentRequest.sort_type:type_name -> comment.v1.GetCommentRequest.SortType
+	18, // 8: comment.v1.CommentTree.comments:type_name -> comment.v1.Comment
+	18, // 9: comment.v1.ListMentionsResponse.comments:type_name -> comment.v1.Comment
+	65, // 10: comment.v1.WebhookSubscription.create_time:type_name -> google.protobuf.Timestamp
+	27, // 11: comment.v1.ListWebhookSubscriptionsResponse.subscriptions:type_name -> comment.v1.WebhookSubscription
+	3,  // 12: comment.v1.WebhookDelivery.status:type_name -> comment.v1.WebhookDelivery.Status
+	65, // 13: comment.v1.WebhookDelivery.next_retry_time:type_name -> google.protobuf.Timestamp
+	65, // 14: comment.v1.WebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 15: comment.v1.ListWebhookDeliveriesRequest.status:type_name -> comment.v1.WebhookDelivery.Status
+	32, // 16: comment.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> comment.v1.WebhookDelivery
+	4,  // 17: comment.v1.CommentChange.type:type_name -> comment.v1.CommentChange.Type
+	18, // 18: comment.v1.CommentChange.comment:type_name -> comment.v1.Comment
+	65, // 19: comment.v1.CommentChange.occur_time:type_name -> google.protobuf.Timestamp
+	5,  // 20: comment.v1.Report.reason:type_name -> comment.v1.Report.Reason
+	6,  // 21: comment.v1.Report.status:type_name -> comment.v1.Report.Status
+	65, // 22: comment.v1.Report.create_time:type_name -> google.protobuf.Timestamp
+	5,  // 23: comment.v1.ReportCommentRequest.reason:type_name -> comment.v1.Report.Reason
+	6,  // 24: comment.v1.ListReportedCommentsRequest.status:type_name -> comment.v1.Report.Status
+	18, // 25: comment.v1.ReportedComment.comment:type_name -> comment.v1.Comment
+	64, // 26: comment.v1.ReportedComment.reasons:type_name -> comment.v1.ReportedComment.ReasonCount
+	65, // 27: comment.v1.ReportedComment.last_report_time:type_name -> google.protobuf.Timestamp
+	38, // 28: comment.v1.ReportedComment.recent_reports:type_name -> comment.v1.Report
+	42, // 29: comment.v1.ListReportedCommentsResponse.reported_comments:type_name -> comment.v1.ReportedComment
+	7,  // 30: comment.v1.ResolveReportsRequest.action:type_name -> comment.v1.ResolveReportsRequest.Action
+	8,  // 31: comment.v1.ResourceCommentSettings.status:type_name -> comment.v1.ResourceCommentSettings.Status
+	65, // 32: comment.v1.ResourceCommentSettings.update_gmt:type_name -> google.protobuf.Timestamp
+	8,  // 33: comment.v1.SetResourceCommentSettingsRequest.status:type_name -> comment.v1.ResourceCommentSettings.Status
+	65, // 34: comment.v1.SearchCommentsRequest.start_time:type_name -> google.protobuf.Timestamp
+	65, // 35: comment.v1.SearchCommentsRequest.end_time:type_name -> google.protobuf.Timestamp
+	18, // 36: comment.v1.SearchCommentsResponse.comments:type_name -> comment.v1.Comment
+	9,  // 37: comment.v1.ListUserCommentsRequest.order:type_name -> comment.v1.ListUserCommentsRequest.Order
+	57, // 38: comment.v1.ListUserCommentsResponse.comments:type_name -> comment.v1.UserComment
+	18, // 39: comment.v1.UserComment.comment:type_name -> comment.v1.Comment
+	58, // 40: comment.v1.UserComment.parent:type_name -> comment.v1.ParentSnippet
+	10, // 41: comment.v1.BulkDeleteJob.mode:type_name -> comment.v1.BulkDeleteJob.Mode
+	11, // 42: comment.v1.BulkDeleteJob.status:type_name -> comment.v1.BulkDeleteJob.Status
+	65, // 43: comment.v1.BulkDeleteJob.create_time:type_name -> google.protobuf.Timestamp
+	65, // 44: comment.v1.BulkDeleteJob.update_time:type_name -> google.protobuf.Timestamp
+	10, // 45: comment.v1.BulkDeleteCommentsRequest.mode:type_name -> comment.v1.BulkDeleteJob.Mode
+	12, // 46: comment.v1.ExportCommentsRequest.format:type_name -> comment.v1.ExportCommentsRequest.Format
+	5,  // 47: comment.v1.ReportedComment.ReasonCount.reason:type_name -> comment.v1.Report.Reason
+	17, // 48: comment.v1.CommentService.CreateComment:input_type -> comment.v1.CreateCommentRequest
+	21, // 49: comment.v1.CommentService.GetComment:input_type -> comment.v1.GetCommentRequest
+	23, // 50: comment.v1.CommentService.DeleteComment:input_type -> comment.v1.DeleteCommentRequest
+	13, // 51: comment.v1.CommentService.LikeComment:input_type -> comment.v1.LikeCommentRequest
+	15, // 52: comment.v1.CommentService.UnlikeComment:input_type -> comment.v1.UnlikeCommentRequest
+	25, // 53: comment.v1.CommentService.ListMentions:input_type -> comment.v1.ListMentionsRequest
+	55, // 54: comment.v1.CommentService.ListUserComments:input_type -> comment.v1.ListUserCommentsRequest
+	53, // 55: comment.v1.CommentService.SearchComments:input_type -> comment.v1.SearchCommentsRequest
+	36, // 56: comment.v1.CommentService.WatchComments:input_type -> comment.v1.WatchCommentsRequest
+	39, // 57: comment.v1.CommentService.ReportComment:input_type -> comment.v1.ReportCommentRequest
+	51, // 58: comment.v1.CommentService.SetResourceCommentSettings:input_type -> comment.v1.SetResourceCommentSettingsRequest
+	52, // 59: comment.v1.CommentService.GetResourceCommentSettings:input_type -> comment.v1.GetResourceCommentSettingsRequest
+	47, // 60: comment.v1.CommentService.BlockUser:input_type -> comment.v1.BlockUserRequest
+	48, // 61: comment.v1.CommentService.UnblockUser:input_type -> comment.v1.UnblockUserRequest
+	41, // 62: comment.v1.CommentService.ListReportedComments:input_type -> comment.v1.ListReportedCommentsRequest
+	44, // 63: comment.v1.CommentService.ResolveReports:input_type -> comment.v1.ResolveReportsRequest
+	46, // 64: comment.v1.CommentService.ApproveComment:input_type -> comment.v1.ModerateCommentRequest
+	46, // 65: comment.v1.CommentService.RejectComment:input_type -> comment.v1.ModerateCommentRequest
+	60, // 66: comment.v1.CommentService.BulkDeleteComments:input_type -> comment.v1.BulkDeleteCommentsRequest
+	61, // 67: comment.v1.CommentService.GetBulkDeleteJob:input_type -> comment.v1.GetBulkDeleteJobRequest
+	62, // 68: comment.v1.CommentService.ExportComments:input_type -> comment.v1.ExportCommentsRequest
+	28, // 69: comment.v1.CommentService.CreateWebhookSubscription:input_type -> comment.v1.CreateWebhookSubscriptionRequest
+	29, // 70: comment.v1.CommentService.DeleteWebhookSubscription:input_type -> comment.v1.DeleteWebhookSubscriptionRequest
+	30, // 71: comment.v1.CommentService.ListWebhookSubscriptions:input_type -> comment.v1.ListWebhookSubscriptionsRequest
+	33, // 72: comment.v1.CommentService.ListWebhookDeliveries:input_type -> comment.v1.ListWebhookDeliveriesRequest
+	35, // 73: comment.v1.CommentService.RetryWebhookDelivery:input_type -> comment.v1.RetryWebhookDeliveryRequest
+	18, // 74: comment.v1.CommentService.CreateComment:output_type -> comment.v1.Comment
+	22, // 75: comment.v1.CommentService.GetComment:output_type -> comment.v1.CommentTree
+	24, // 76: comment.v1.CommentService.DeleteComment:output_type -> comment.v1.DeleteResponse
+	14, // 77: comment.v1.CommentService.LikeComment:output_type -> comment.v1.LikeResponse
+	16, // 78: comment.v1.CommentService.UnlikeComment:output_type -> comment.v1.UnlikeResponse
+	26, // 79: comment.v1.CommentService.ListMentions:output_type -> comment.v1.ListMentionsResponse
+	56, // 80: comment.v1.CommentService.ListUserComments:output_type -> comment.v1.ListUserCommentsResponse
+	54, // 81: comment.v1.CommentService.SearchComments:output_type -> comment.v1.SearchCommentsResponse
+	37, // 82: comment.v1.CommentService.WatchComments:output_type -> comment.v1.CommentChange
+	40, // 83: comment.v1.CommentService.ReportComment:output_type -> comment.v1.ReportCommentResponse
+	50, // 84: comment.v1.CommentService.SetResourceCommentSettings:output_type -> comment.v1.ResourceCommentSettings
+	50, // 85: comment.v1.CommentService.GetResourceCommentSettings:output_type -> comment.v1.ResourceCommentSettings
+	49, // 86: comment.v1.CommentService.BlockUser:output_type -> comment.v1.BlockUserResponse
+	49, // 87: comment.v1.CommentService.UnblockUser:output_type -> comment.v1.BlockUserResponse
+	43, // 88: comment.v1.CommentService.ListReportedComments:output_type -> comment.v1.ListReportedCommentsResponse
+	45, // 89: comment.v1.CommentService.ResolveReports:output_type -> comment.v1.ResolveReportsResponse
+	18, // 90: comment.v1.CommentService.ApproveComment:output_type -> comment.v1.Comment
+	18, // 91: comment.v1.CommentService.RejectComment:output_type -> comment.v1.Comment
+	59, // 92: comment.v1.CommentService.BulkDeleteComments:output_type -> comment.v1.BulkDeleteJob
+	59, // 93: comment.v1.CommentService.GetBulkDeleteJob:output_type -> comment.v1.BulkDeleteJob
+	63, // 94: comment.v1.CommentService.ExportComments:output_type -> comment.v1.ExportCommentsChunk
+	27, // 95: comment.v1.CommentService.CreateWebhookSubscription:output_type -> comment.v1.WebhookSubscription
+	24, // 96: comment.v1.CommentService.DeleteWebhookSubscription:output_type -> comment.v1.DeleteResponse
+	31, // 97: comment.v1.CommentService.ListWebhookSubscriptions:output_type -> comment.v1.ListWebhookSubscriptionsResponse
+	34, // 98: comment.v1.CommentService.ListWebhookDeliveries:output_type -> comment.v1.ListWebhookDeliveriesResponse
+	32, // 99: comment.v1.CommentService.RetryWebhookDelivery:output_type -> comment.v1.WebhookDelivery
+	74, // [74:100] is the sub-list for method output_type
+	48, // [48:74] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_comment_v1_comment_proto_init() }
@@ -942,13 +5044,15 @@ func file_comment_v1_comment_proto_init() {
 	if File_comment_v1_comment_proto != nil {
 		return
 	}
+	file_comment_v1_comment_proto_msgTypes[8].OneofWrappers = []any{}
+	file_comment_v1_comment_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comment_v1_comment_proto_rawDesc), len(file_comment_v1_comment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      13,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
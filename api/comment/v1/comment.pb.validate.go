@@ -629,6 +629,62 @@ func (m *CreateCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		err := CreateCommentRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttachments()) > 9 {
+		err := CreateCommentRequestValidationError{
+			field:  "Attachments",
+			reason: "value must contain no more than 9 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCommentRequestValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCommentRequestValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCommentRequestValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateCommentRequestMultiError(errors)
 	}
@@ -885,6 +941,84 @@ func (m *Comment) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetMentions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Mentions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Mentions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentValidationError{
+					field:  fmt.Sprintf("Mentions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Flagged
+
+	// no validation rules for Hidden
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContentHtml
+
+	// no validation rules for ContentText
+
+	// no validation rules for ModerationStatus
+
 	if len(errors) > 0 {
 		return CommentMultiError(errors)
 	}
@@ -962,32 +1096,32 @@ var _ interface {
 	ErrorName() string
 } = CommentValidationError{}
 
-// Validate checks the field values on GetCommentRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCommentRequest) Validate() error {
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCommentRequestMultiError, or nil if none found.
-func (m *GetCommentRequest) ValidateAll() error {
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommentRequest) validate(all bool) error {
+func (m *Attachment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetModule() <= 0 {
-		err := GetCommentRequestValidationError{
-			field:  "Module",
-			reason: "value must be greater than 0",
+	if _, ok := _Attachment_Type_NotInLookup[m.GetType()]; ok {
+		err := AttachmentValidationError{
+			field:  "Type",
+			reason: "value must not be in list [TYPE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -995,10 +1129,10 @@ func (m *GetCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetResourceId()) < 1 {
-		err := GetCommentRequestValidationError{
-			field:  "ResourceId",
-			reason: "value length must be at least 1 runes",
+	if _, ok := Attachment_Type_name[int32(m.GetType())]; !ok {
+		err := AttachmentValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -1006,10 +1140,10 @@ func (m *GetCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetMaxDepth(); val < 1 || val > 10 {
-		err := GetCommentRequestValidationError{
-			field:  "MaxDepth",
-			reason: "value must be inside range [1, 10]",
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 1024 {
+		err := AttachmentValidationError{
+			field:  "Url",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1017,10 +1151,10 @@ func (m *GetCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPage() < 1 {
-		err := GetCommentRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than or equal to 1",
+	if val := m.GetWidth(); val < 0 || val > 10000 {
+		err := AttachmentValidationError{
+			field:  "Width",
+			reason: "value must be inside range [0, 10000]",
 		}
 		if !all {
 			return err
@@ -1028,10 +1162,43 @@ func (m *GetCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetPageSize(); val < 1 || val > 100 {
-		err := GetCommentRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [1, 100]",
+	if val := m.GetHeight(); val < 0 || val > 10000 {
+		err := AttachmentValidationError{
+			field:  "Height",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 200 {
+		err := AttachmentValidationError{
+			field:  "Title",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := AttachmentValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetThumbnailUrl()) > 1024 {
+		err := AttachmentValidationError{
+			field:  "ThumbnailUrl",
+			reason: "value length must be at most 1024 runes",
 		}
 		if !all {
 			return err
@@ -1039,22 +1206,30 @@ func (m *GetCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for SortType
+	if utf8.RuneCountInString(m.GetStickerId()) > 64 {
+		err := AttachmentValidationError{
+			field:  "StickerId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetCommentRequestMultiError(errors)
+		return AttachmentMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommentRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCommentRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommentRequestMultiError []error
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommentRequestMultiError) Error() string {
+func (m AttachmentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1063,11 +1238,11 @@ func (m GetCommentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommentRequestMultiError) AllErrors() []error { return m }
+func (m AttachmentMultiError) AllErrors() []error { return m }
 
-// GetCommentRequestValidationError is the validation error returned by
-// GetCommentRequest.Validate if the designated constraints aren't met.
-type GetCommentRequestValidationError struct {
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1075,24 +1250,22 @@ type GetCommentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommentRequestValidationError) Field() string { return e.field }
+func (e AttachmentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommentRequestValidationError) Reason() string { return e.reason }
+func (e AttachmentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommentRequestValidationError) Cause() error { return e.cause }
+func (e AttachmentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommentRequestValidationError) Key() bool { return e.key }
+func (e AttachmentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommentRequestValidationError) ErrorName() string {
-	return "GetCommentRequestValidationError"
-}
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCommentRequestValidationError) Error() string {
+func (e AttachmentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1104,14 +1277,14 @@ func (e GetCommentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommentRequest.%s: %s%s",
+		"invalid %sAttachment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommentRequestValidationError{}
+var _ error = AttachmentValidationError{}
 
 var _ interface {
 	Field() string
@@ -1119,77 +1292,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommentRequestValidationError{}
+} = AttachmentValidationError{}
 
-// Validate checks the field values on CommentTree with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+var _Attachment_Type_NotInLookup = map[Attachment_Type]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Mention with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CommentTree) Validate() error {
+func (m *Mention) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommentTree with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CommentTreeMultiError, or
-// nil if none found.
-func (m *CommentTree) ValidateAll() error {
+// ValidateAll checks the field values on Mention with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MentionMultiError, or nil if none found.
+func (m *Mention) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommentTree) validate(all bool) error {
+func (m *Mention) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetComments() {
-		_, _ = idx, item
+	// no validation rules for UserId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CommentTreeValidationError{
-						field:  fmt.Sprintf("Comments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CommentTreeValidationError{
-						field:  fmt.Sprintf("Comments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CommentTreeValidationError{
-					field:  fmt.Sprintf("Comments[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Name
 
-	}
+	// no validation rules for Offset
+
+	// no validation rules for Length
 
 	if len(errors) > 0 {
-		return CommentTreeMultiError(errors)
+		return MentionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentTreeMultiError is an error wrapping multiple validation errors
-// returned by CommentTree.ValidateAll() if the designated constraints aren't met.
-type CommentTreeMultiError []error
+// MentionMultiError is an error wrapping multiple validation errors returned
+// by Mention.ValidateAll() if the designated constraints aren't met.
+type MentionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentTreeMultiError) Error() string {
+func (m MentionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1198,11 +1348,11 @@ func (m CommentTreeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentTreeMultiError) AllErrors() []error { return m }
+func (m MentionMultiError) AllErrors() []error { return m }
 
-// CommentTreeValidationError is the validation error returned by
-// CommentTree.Validate if the designated constraints aren't met.
-type CommentTreeValidationError struct {
+// MentionValidationError is the validation error returned by Mention.Validate
+// if the designated constraints aren't met.
+type MentionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1210,22 +1360,22 @@ type CommentTreeValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentTreeValidationError) Field() string { return e.field }
+func (e MentionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentTreeValidationError) Reason() string { return e.reason }
+func (e MentionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentTreeValidationError) Cause() error { return e.cause }
+func (e MentionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentTreeValidationError) Key() bool { return e.key }
+func (e MentionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentTreeValidationError) ErrorName() string { return "CommentTreeValidationError" }
+func (e MentionValidationError) ErrorName() string { return "MentionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentTreeValidationError) Error() string {
+func (e MentionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1237,14 +1387,14 @@ func (e CommentTreeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommentTree.%s: %s%s",
+		"invalid %sMention.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentTreeValidationError{}
+var _ error = MentionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1252,24 +1402,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentTreeValidationError{}
+} = MentionValidationError{}
 
-// Validate checks the field values on DeleteCommentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteCommentRequest) Validate() error {
+// Validate checks the field values on GetCommentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCommentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteCommentRequest with the rules
+// ValidateAll checks the field values on GetCommentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteCommentRequestMultiError, or nil if none found.
-func (m *DeleteCommentRequest) ValidateAll() error {
+// GetCommentRequestMultiError, or nil if none found.
+func (m *GetCommentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteCommentRequest) validate(all bool) error {
+func (m *GetCommentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1277,7 +1427,7 @@ func (m *DeleteCommentRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetModule() <= 0 {
-		err := DeleteCommentRequestValidationError{
+		err := GetCommentRequestValidationError{
 			field:  "Module",
 			reason: "value must be greater than 0",
 		}
@@ -1288,7 +1438,7 @@ func (m *DeleteCommentRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetResourceId()) < 1 {
-		err := DeleteCommentRequestValidationError{
+		err := GetCommentRequestValidationError{
 			field:  "ResourceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1298,10 +1448,10 @@ func (m *DeleteCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetCommentId() <= 0 {
-		err := DeleteCommentRequestValidationError{
-			field:  "CommentId",
-			reason: "value must be greater than 0",
+	if val := m.GetMaxDepth(); val < 1 || val > 10 {
+		err := GetCommentRequestValidationError{
+			field:  "MaxDepth",
+			reason: "value must be inside range [1, 10]",
 		}
 		if !all {
 			return err
@@ -1309,10 +1459,32 @@ func (m *DeleteCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := DeleteCommentRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
+	if m.GetPage() < 1 {
+		err := GetCommentRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := GetCommentRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetViewerId()) > 32 {
+		err := GetCommentRequestValidationError{
+			field:  "ViewerId",
+			reason: "value length must be at most 32 runes",
 		}
 		if !all {
 			return err
@@ -1320,20 +1492,24 @@ func (m *DeleteCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.SortType != nil {
+		// no validation rules for SortType
+	}
+
 	if len(errors) > 0 {
-		return DeleteCommentRequestMultiError(errors)
+		return GetCommentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteCommentRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteCommentRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteCommentRequestMultiError []error
+// GetCommentRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCommentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteCommentRequestMultiError) Error() string {
+func (m GetCommentRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1342,11 +1518,11 @@ func (m DeleteCommentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteCommentRequestMultiError) AllErrors() []error { return m }
+func (m GetCommentRequestMultiError) AllErrors() []error { return m }
 
-// DeleteCommentRequestValidationError is the validation error returned by
-// DeleteCommentRequest.Validate if the designated constraints aren't met.
-type DeleteCommentRequestValidationError struct {
+// GetCommentRequestValidationError is the validation error returned by
+// GetCommentRequest.Validate if the designated constraints aren't met.
+type GetCommentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1354,24 +1530,24 @@ type DeleteCommentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteCommentRequestValidationError) Field() string { return e.field }
+func (e GetCommentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteCommentRequestValidationError) Reason() string { return e.reason }
+func (e GetCommentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteCommentRequestValidationError) Cause() error { return e.cause }
+func (e GetCommentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteCommentRequestValidationError) Key() bool { return e.key }
+func (e GetCommentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteCommentRequestValidationError) ErrorName() string {
-	return "DeleteCommentRequestValidationError"
+func (e GetCommentRequestValidationError) ErrorName() string {
+	return "GetCommentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteCommentRequestValidationError) Error() string {
+func (e GetCommentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1383,14 +1559,14 @@ func (e DeleteCommentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteCommentRequest.%s: %s%s",
+		"invalid %sGetCommentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteCommentRequestValidationError{}
+var _ error = GetCommentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1398,46 +1574,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteCommentRequestValidationError{}
+} = GetCommentRequestValidationError{}
 
-// Validate checks the field values on DeleteResponse with the rules defined in
+// Validate checks the field values on CommentTree with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DeleteResponse) Validate() error {
+func (m *CommentTree) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteResponseMultiError,
-// or nil if none found.
-func (m *DeleteResponse) ValidateAll() error {
+// ValidateAll checks the field values on CommentTree with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommentTreeMultiError, or
+// nil if none found.
+func (m *CommentTree) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteResponse) validate(all bool) error {
+func (m *CommentTree) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentTreeValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentTreeValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentTreeValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteResponseMultiError(errors)
+		return CommentTreeMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteResponseMultiError []error
+// CommentTreeMultiError is an error wrapping multiple validation errors
+// returned by CommentTree.ValidateAll() if the designated constraints aren't met.
+type CommentTreeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteResponseMultiError) Error() string {
+func (m CommentTreeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1446,11 +1653,11 @@ func (m DeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteResponseMultiError) AllErrors() []error { return m }
+func (m CommentTreeMultiError) AllErrors() []error { return m }
 
-// DeleteResponseValidationError is the validation error returned by
-// DeleteResponse.Validate if the designated constraints aren't met.
-type DeleteResponseValidationError struct {
+// CommentTreeValidationError is the validation error returned by
+// CommentTree.Validate if the designated constraints aren't met.
+type CommentTreeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1458,22 +1665,22 @@ type DeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteResponseValidationError) Field() string { return e.field }
+func (e CommentTreeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteResponseValidationError) Reason() string { return e.reason }
+func (e CommentTreeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteResponseValidationError) Cause() error { return e.cause }
+func (e CommentTreeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteResponseValidationError) Key() bool { return e.key }
+func (e CommentTreeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteResponseValidationError) ErrorName() string { return "DeleteResponseValidationError" }
+func (e CommentTreeValidationError) ErrorName() string { return "CommentTreeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteResponseValidationError) Error() string {
+func (e CommentTreeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1485,14 +1692,14 @@ func (e DeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteResponse.%s: %s%s",
+		"invalid %sCommentTree.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteResponseValidationError{}
+var _ error = CommentTreeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1500,4 +1707,5852 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteResponseValidationError{}
+} = CommentTreeValidationError{}
+
+// Validate checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCommentRequestMultiError, or nil if none found.
+func (m *DeleteCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetModule() <= 0 {
+		err := DeleteCommentRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceId()) < 1 {
+		err := DeleteCommentRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCommentId() <= 0 {
+		err := DeleteCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := DeleteCommentRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCommentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCommentRequestValidationError is the validation error returned by
+// DeleteCommentRequest.Validate if the designated constraints aren't met.
+type DeleteCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCommentRequestValidationError) ErrorName() string {
+	return "DeleteCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCommentRequestValidationError{}
+
+// Validate checks the field values on DeleteResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteResponseMultiError,
+// or nil if none found.
+func (m *DeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResponseMultiError) AllErrors() []error { return m }
+
+// DeleteResponseValidationError is the validation error returned by
+// DeleteResponse.Validate if the designated constraints aren't met.
+type DeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResponseValidationError) ErrorName() string { return "DeleteResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResponseValidationError{}
+
+// Validate checks the field values on ListMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMentionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMentionsRequestMultiError, or nil if none found.
+func (m *ListMentionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMentionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ListMentionsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 1 {
+		err := ListMentionsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListMentionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMentionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMentionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMentionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMentionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMentionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMentionsRequestMultiError) AllErrors() []error { return m }
+
+// ListMentionsRequestValidationError is the validation error returned by
+// ListMentionsRequest.Validate if the designated constraints aren't met.
+type ListMentionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMentionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMentionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMentionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMentionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMentionsRequestValidationError) ErrorName() string {
+	return "ListMentionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMentionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMentionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMentionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMentionsRequestValidationError{}
+
+// Validate checks the field values on ListMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMentionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMentionsResponseMultiError, or nil if none found.
+func (m *ListMentionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMentionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMentionsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMentionsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMentionsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMentionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMentionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMentionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMentionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMentionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMentionsResponseMultiError) AllErrors() []error { return m }
+
+// ListMentionsResponseValidationError is the validation error returned by
+// ListMentionsResponse.Validate if the designated constraints aren't met.
+type ListMentionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMentionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMentionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMentionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMentionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMentionsResponseValidationError) ErrorName() string {
+	return "ListMentionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMentionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMentionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMentionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMentionsResponseValidationError{}
+
+// Validate checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WebhookSubscription) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookSubscriptionMultiError, or nil if none found.
+func (m *WebhookSubscription) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookSubscription) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Module
+
+	// no validation rules for Url
+
+	// no validation rules for Enabled
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookSubscriptionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookSubscriptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookSubscriptionMultiError is an error wrapping multiple validation
+// errors returned by WebhookSubscription.ValidateAll() if the designated
+// constraints aren't met.
+type WebhookSubscriptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookSubscriptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookSubscriptionMultiError) AllErrors() []error { return m }
+
+// WebhookSubscriptionValidationError is the validation error returned by
+// WebhookSubscription.Validate if the designated constraints aren't met.
+type WebhookSubscriptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookSubscriptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookSubscriptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookSubscriptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookSubscriptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookSubscriptionValidationError) ErrorName() string {
+	return "WebhookSubscriptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookSubscriptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookSubscription.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookSubscriptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookSubscriptionValidationError{}
+
+// Validate checks the field values on CreateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *CreateWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetModule() < 0 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateWebhookSubscriptionRequest_EventTypes_Unique := make(map[string]struct{}, len(m.GetEventTypes()))
+
+	for idx, item := range m.GetEventTypes() {
+		_, _ = idx, item
+
+		if _, exists := _CreateWebhookSubscriptionRequest_EventTypes_Unique[item]; exists {
+			err := CreateWebhookSubscriptionRequestValidationError{
+				field:  fmt.Sprintf("EventTypes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateWebhookSubscriptionRequest_EventTypes_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _CreateWebhookSubscriptionRequest_EventTypes_InLookup[item]; !ok {
+			err := CreateWebhookSubscriptionRequestValidationError{
+				field:  fmt.Sprintf("EventTypes[%v]", idx),
+				reason: "value must be in list [CommentCreated CommentReplied CommentLiked CommentUnliked CommentDeleted]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateWebhookSubscriptionRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSecret()) < 16 {
+		err := CreateWebhookSubscriptionRequestValidationError{
+			field:  "Secret",
+			reason: "value length must be at least 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookSubscriptionRequestValidationError is the validation error
+// returned by CreateWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "CreateWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookSubscriptionRequestValidationError{}
+
+var _CreateWebhookSubscriptionRequest_EventTypes_InLookup = map[string]struct{}{
+	"CommentCreated": {},
+	"CommentReplied": {},
+	"CommentLiked":   {},
+	"CommentUnliked": {},
+	"CommentDeleted": {},
+}
+
+// Validate checks the field values on DeleteWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteWebhookSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteWebhookSubscriptionRequestMultiError, or nil if none found.
+func (m *DeleteWebhookSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteWebhookSubscriptionRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteWebhookSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteWebhookSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookSubscriptionRequestValidationError is the validation error
+// returned by DeleteWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookSubscriptionRequestValidationError) ErrorName() string {
+	return "DeleteWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookSubscriptionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookSubscriptionsRequestMultiError, or nil if none found.
+func (m *ListWebhookSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetModule() < 0 {
+		err := ListWebhookSubscriptionsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookSubscriptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookSubscriptionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookSubscriptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookSubscriptionsRequestValidationError is the validation error
+// returned by ListWebhookSubscriptionsRequest.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookSubscriptionsRequestValidationError) ErrorName() string {
+	return "ListWebhookSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookSubscriptionsRequestValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListWebhookSubscriptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookSubscriptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookSubscriptionsResponseMultiError, or nil if none found.
+func (m *ListWebhookSubscriptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookSubscriptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubscriptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookSubscriptionsResponseValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookSubscriptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookSubscriptionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListWebhookSubscriptionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookSubscriptionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookSubscriptionsResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookSubscriptionsResponseValidationError is the validation error
+// returned by ListWebhookSubscriptionsResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookSubscriptionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookSubscriptionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookSubscriptionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookSubscriptionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookSubscriptionsResponseValidationError) ErrorName() string {
+	return "ListWebhookSubscriptionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookSubscriptionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookSubscriptionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookSubscriptionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookSubscriptionsResponseValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SubscriptionId
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for Module
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastStatusCode
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetNextRetryTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextRetryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextRetryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRetryTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "NextRetryTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSubscriptionId() < 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "SubscriptionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 1 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesRequestValidationError is the validation error returned
+// by ListWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesRequestValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesResponseMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookDeliveriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesResponseValidationError{}
+
+// Validate checks the field values on RetryWebhookDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryWebhookDeliveryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryWebhookDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryWebhookDeliveryRequestMultiError, or nil if none found.
+func (m *RetryWebhookDeliveryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryWebhookDeliveryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RetryWebhookDeliveryRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetryWebhookDeliveryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryWebhookDeliveryRequestMultiError is an error wrapping multiple
+// validation errors returned by RetryWebhookDeliveryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RetryWebhookDeliveryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryWebhookDeliveryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryWebhookDeliveryRequestMultiError) AllErrors() []error { return m }
+
+// RetryWebhookDeliveryRequestValidationError is the validation error returned
+// by RetryWebhookDeliveryRequest.Validate if the designated constraints
+// aren't met.
+type RetryWebhookDeliveryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryWebhookDeliveryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryWebhookDeliveryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryWebhookDeliveryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryWebhookDeliveryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryWebhookDeliveryRequestValidationError) ErrorName() string {
+	return "RetryWebhookDeliveryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryWebhookDeliveryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryWebhookDeliveryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryWebhookDeliveryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryWebhookDeliveryRequestValidationError{}
+
+// Validate checks the field values on WatchCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchCommentsRequestMultiError, or nil if none found.
+func (m *WatchCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetModule() <= 0 {
+		err := WatchCommentsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceId()) < 1 {
+		err := WatchCommentsRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchCommentsRequestMultiError) AllErrors() []error { return m }
+
+// WatchCommentsRequestValidationError is the validation error returned by
+// WatchCommentsRequest.Validate if the designated constraints aren't met.
+type WatchCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchCommentsRequestValidationError) ErrorName() string {
+	return "WatchCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchCommentsRequestValidationError{}
+
+// Validate checks the field values on CommentChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommentChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommentChangeMultiError, or
+// nil if none found.
+func (m *CommentChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Module
+
+	// no validation rules for ResourceId
+
+	// no validation rules for CommentId
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentChangeValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentChangeValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentChangeValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LikeCount
+
+	if all {
+		switch v := interface{}(m.GetOccurTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentChangeValidationError{
+					field:  "OccurTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentChangeValidationError{
+					field:  "OccurTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentChangeValidationError{
+				field:  "OccurTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommentChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentChangeMultiError is an error wrapping multiple validation errors
+// returned by CommentChange.ValidateAll() if the designated constraints
+// aren't met.
+type CommentChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentChangeMultiError) AllErrors() []error { return m }
+
+// CommentChangeValidationError is the validation error returned by
+// CommentChange.Validate if the designated constraints aren't met.
+type CommentChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentChangeValidationError) ErrorName() string { return "CommentChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentChangeValidationError{}
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CommentId
+
+	// no validation rules for UserId
+
+	// no validation rules for Reason
+
+	// no validation rules for Detail
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMultiError) AllErrors() []error { return m }
+
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportValidationError{}
+
+// Validate checks the field values on ReportCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportCommentRequestMultiError, or nil if none found.
+func (m *ReportCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCommentId() <= 0 {
+		err := ReportCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ReportCommentRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReportCommentRequest_Reason_NotInLookup[m.GetReason()]; ok {
+		err := ReportCommentRequestValidationError{
+			field:  "Reason",
+			reason: "value must not be in list [REASON_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Report_Reason_name[int32(m.GetReason())]; !ok {
+		err := ReportCommentRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDetail()) > 500 {
+		err := ReportCommentRequestValidationError{
+			field:  "Detail",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportCommentRequestMultiError) AllErrors() []error { return m }
+
+// ReportCommentRequestValidationError is the validation error returned by
+// ReportCommentRequest.Validate if the designated constraints aren't met.
+type ReportCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportCommentRequestValidationError) ErrorName() string {
+	return "ReportCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportCommentRequestValidationError{}
+
+var _ReportCommentRequest_Reason_NotInLookup = map[Report_Reason]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ReportCommentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportCommentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportCommentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportCommentResponseMultiError, or nil if none found.
+func (m *ReportCommentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportCommentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for ReportCount
+
+	// no validation rules for Hidden
+
+	if len(errors) > 0 {
+		return ReportCommentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportCommentResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportCommentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportCommentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportCommentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportCommentResponseMultiError) AllErrors() []error { return m }
+
+// ReportCommentResponseValidationError is the validation error returned by
+// ReportCommentResponse.Validate if the designated constraints aren't met.
+type ReportCommentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportCommentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportCommentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportCommentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportCommentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportCommentResponseValidationError) ErrorName() string {
+	return "ReportCommentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportCommentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportCommentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportCommentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportCommentResponseValidationError{}
+
+// Validate checks the field values on ListReportedCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportedCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportedCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportedCommentsRequestMultiError, or nil if none found.
+func (m *ListReportedCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportedCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetModule() < 0 {
+		err := ListReportedCommentsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if m.GetPage() < 1 {
+		err := ListReportedCommentsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListReportedCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReportedCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportedCommentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReportedCommentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReportedCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportedCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportedCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportedCommentsRequestValidationError is the validation error returned
+// by ListReportedCommentsRequest.Validate if the designated constraints
+// aren't met.
+type ListReportedCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportedCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportedCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportedCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportedCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportedCommentsRequestValidationError) ErrorName() string {
+	return "ListReportedCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportedCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportedCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportedCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportedCommentsRequestValidationError{}
+
+// Validate checks the field values on ReportedComment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportedComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportedComment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportedCommentMultiError, or nil if none found.
+func (m *ReportedComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportedComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportedCommentValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportedCommentValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportedCommentValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReportCount
+
+	for idx, item := range m.GetReasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportedCommentValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportedCommentValidationError{
+						field:  fmt.Sprintf("Reasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportedCommentValidationError{
+					field:  fmt.Sprintf("Reasons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastReportTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportedCommentValidationError{
+					field:  "LastReportTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportedCommentValidationError{
+					field:  "LastReportTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastReportTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportedCommentValidationError{
+				field:  "LastReportTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRecentReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportedCommentValidationError{
+						field:  fmt.Sprintf("RecentReports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportedCommentValidationError{
+						field:  fmt.Sprintf("RecentReports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportedCommentValidationError{
+					field:  fmt.Sprintf("RecentReports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReportedCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportedCommentMultiError is an error wrapping multiple validation errors
+// returned by ReportedComment.ValidateAll() if the designated constraints
+// aren't met.
+type ReportedCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportedCommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportedCommentMultiError) AllErrors() []error { return m }
+
+// ReportedCommentValidationError is the validation error returned by
+// ReportedComment.Validate if the designated constraints aren't met.
+type ReportedCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportedCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportedCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportedCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportedCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportedCommentValidationError) ErrorName() string { return "ReportedCommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportedCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportedComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportedCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportedCommentValidationError{}
+
+// Validate checks the field values on ListReportedCommentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportedCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportedCommentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportedCommentsResponseMultiError, or nil if none found.
+func (m *ListReportedCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportedCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReportedComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportedCommentsResponseValidationError{
+						field:  fmt.Sprintf("ReportedComments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportedCommentsResponseValidationError{
+						field:  fmt.Sprintf("ReportedComments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportedCommentsResponseValidationError{
+					field:  fmt.Sprintf("ReportedComments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportedCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportedCommentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListReportedCommentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListReportedCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportedCommentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportedCommentsResponseMultiError) AllErrors() []error { return m }
+
+// ListReportedCommentsResponseValidationError is the validation error returned
+// by ListReportedCommentsResponse.Validate if the designated constraints
+// aren't met.
+type ListReportedCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportedCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportedCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportedCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportedCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportedCommentsResponseValidationError) ErrorName() string {
+	return "ListReportedCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportedCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportedCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportedCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportedCommentsResponseValidationError{}
+
+// Validate checks the field values on ResolveReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveReportsRequestMultiError, or nil if none found.
+func (m *ResolveReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCommentId() <= 0 {
+		err := ResolveReportsRequestValidationError{
+			field:  "CommentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ResolveReportsRequest_Action_NotInLookup[m.GetAction()]; ok {
+		err := ResolveReportsRequestValidationError{
+			field:  "Action",
+			reason: "value must not be in list [ACTION_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ResolveReportsRequest_Action_name[int32(m.GetAction())]; !ok {
+		err := ResolveReportsRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResolveReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveReportsRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveReportsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveReportsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveReportsRequestMultiError) AllErrors() []error { return m }
+
+// ResolveReportsRequestValidationError is the validation error returned by
+// ResolveReportsRequest.Validate if the designated constraints aren't met.
+type ResolveReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveReportsRequestValidationError) ErrorName() string {
+	return "ResolveReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveReportsRequestValidationError{}
+
+var _ResolveReportsRequest_Action_NotInLookup = map[ResolveReportsRequest_Action]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ResolveReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveReportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveReportsResponseMultiError, or nil if none found.
+func (m *ResolveReportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveReportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResolvedCount
+
+	if len(errors) > 0 {
+		return ResolveReportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveReportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveReportsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveReportsResponseMultiError) AllErrors() []error { return m }
+
+// ResolveReportsResponseValidationError is the validation error returned by
+// ResolveReportsResponse.Validate if the designated constraints aren't met.
+type ResolveReportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveReportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveReportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveReportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveReportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveReportsResponseValidationError) ErrorName() string {
+	return "ResolveReportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveReportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveReportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveReportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveReportsResponseValidationError{}
+
+// Validate checks the field values on ModerateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModerateCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerateCommentRequestMultiError, or nil if none found.
+func (m *ModerateCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerateCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCommentId() <= 0 {
+		err := ModerateCommentRequestValidationError{
+			field:  "CommentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ModerateCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerateCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by ModerateCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModerateCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerateCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerateCommentRequestMultiError) AllErrors() []error { return m }
+
+// ModerateCommentRequestValidationError is the validation error returned by
+// ModerateCommentRequest.Validate if the designated constraints aren't met.
+type ModerateCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerateCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerateCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerateCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerateCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerateCommentRequestValidationError) ErrorName() string {
+	return "ModerateCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerateCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerateCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerateCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerateCommentRequestValidationError{}
+
+// Validate checks the field values on BlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserRequestMultiError, or nil if none found.
+func (m *BlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 32 {
+		err := BlockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetBlockedUserId()); l < 1 || l > 32 {
+		err := BlockUserRequestValidationError{
+			field:  "BlockedUserId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by BlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserRequestMultiError) AllErrors() []error { return m }
+
+// BlockUserRequestValidationError is the validation error returned by
+// BlockUserRequest.Validate if the designated constraints aren't met.
+type BlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserRequestValidationError) ErrorName() string { return "BlockUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserRequestValidationError{}
+
+// Validate checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnblockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnblockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnblockUserRequestMultiError, or nil if none found.
+func (m *UnblockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnblockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 32 {
+		err := UnblockUserRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetBlockedUserId()); l < 1 || l > 32 {
+		err := UnblockUserRequestValidationError{
+			field:  "BlockedUserId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnblockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnblockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnblockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnblockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnblockUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnblockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnblockUserRequestValidationError is the validation error returned by
+// UnblockUserRequest.Validate if the designated constraints aren't met.
+type UnblockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnblockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnblockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnblockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnblockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnblockUserRequestValidationError) ErrorName() string {
+	return "UnblockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnblockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnblockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnblockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnblockUserRequestValidationError{}
+
+// Validate checks the field values on BlockUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockUserResponseMultiError, or nil if none found.
+func (m *BlockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return BlockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockUserResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockUserResponseMultiError) AllErrors() []error { return m }
+
+// BlockUserResponseValidationError is the validation error returned by
+// BlockUserResponse.Validate if the designated constraints aren't met.
+type BlockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockUserResponseValidationError) ErrorName() string {
+	return "BlockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockUserResponseValidationError{}
+
+// Validate checks the field values on ResourceCommentSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourceCommentSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceCommentSettings with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceCommentSettingsMultiError, or nil if none found.
+func (m *ResourceCommentSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceCommentSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Module
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Status
+
+	// no validation rules for MaxReplyDepth
+
+	// no validation rules for ModerationRequired
+
+	if all {
+		switch v := interface{}(m.GetUpdateGmt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceCommentSettingsValidationError{
+					field:  "UpdateGmt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceCommentSettingsValidationError{
+					field:  "UpdateGmt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateGmt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceCommentSettingsValidationError{
+				field:  "UpdateGmt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResourceCommentSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceCommentSettingsMultiError is an error wrapping multiple validation
+// errors returned by ResourceCommentSettings.ValidateAll() if the designated
+// constraints aren't met.
+type ResourceCommentSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceCommentSettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceCommentSettingsMultiError) AllErrors() []error { return m }
+
+// ResourceCommentSettingsValidationError is the validation error returned by
+// ResourceCommentSettings.Validate if the designated constraints aren't met.
+type ResourceCommentSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceCommentSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceCommentSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceCommentSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceCommentSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceCommentSettingsValidationError) ErrorName() string {
+	return "ResourceCommentSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceCommentSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceCommentSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceCommentSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceCommentSettingsValidationError{}
+
+// Validate checks the field values on SetResourceCommentSettingsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetResourceCommentSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetResourceCommentSettingsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetResourceCommentSettingsRequestMultiError, or nil if none found.
+func (m *SetResourceCommentSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetResourceCommentSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetModule() <= 0 {
+		err := SetResourceCommentSettingsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetResourceId()); l < 1 || l > 32 {
+		err := SetResourceCommentSettingsRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ResourceCommentSettings_Status_name[int32(m.GetStatus())]; !ok {
+		err := SetResourceCommentSettingsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxReplyDepth(); val < 0 || val > 10 {
+		err := SetResourceCommentSettingsRequestValidationError{
+			field:  "MaxReplyDepth",
+			reason: "value must be inside range [0, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ModerationRequired
+
+	if len(errors) > 0 {
+		return SetResourceCommentSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetResourceCommentSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetResourceCommentSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetResourceCommentSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetResourceCommentSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetResourceCommentSettingsRequestMultiError) AllErrors() []error { return m }
+
+// SetResourceCommentSettingsRequestValidationError is the validation error
+// returned by SetResourceCommentSettingsRequest.Validate if the designated
+// constraints aren't met.
+type SetResourceCommentSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetResourceCommentSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetResourceCommentSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetResourceCommentSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetResourceCommentSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetResourceCommentSettingsRequestValidationError) ErrorName() string {
+	return "SetResourceCommentSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetResourceCommentSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetResourceCommentSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetResourceCommentSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetResourceCommentSettingsRequestValidationError{}
+
+// Validate checks the field values on GetResourceCommentSettingsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetResourceCommentSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceCommentSettingsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetResourceCommentSettingsRequestMultiError, or nil if none found.
+func (m *GetResourceCommentSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceCommentSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetModule() <= 0 {
+		err := GetResourceCommentSettingsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetResourceId()); l < 1 || l > 32 {
+		err := GetResourceCommentSettingsRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetResourceCommentSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceCommentSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetResourceCommentSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetResourceCommentSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceCommentSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceCommentSettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetResourceCommentSettingsRequestValidationError is the validation error
+// returned by GetResourceCommentSettingsRequest.Validate if the designated
+// constraints aren't met.
+type GetResourceCommentSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceCommentSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceCommentSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceCommentSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceCommentSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceCommentSettingsRequestValidationError) ErrorName() string {
+	return "GetResourceCommentSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceCommentSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceCommentSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceCommentSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceCommentSettingsRequestValidationError{}
+
+// Validate checks the field values on SearchCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCommentsRequestMultiError, or nil if none found.
+func (m *SearchCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKeyword()); l < 1 || l > 100 {
+		err := SearchCommentsRequestValidationError{
+			field:  "Keyword",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetModule() < 0 {
+		err := SearchCommentsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceId()) > 32 {
+		err := SearchCommentsRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) > 32 {
+		err := SearchCommentsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchCommentsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchCommentsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchCommentsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchCommentsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchCommentsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchCommentsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeHidden
+
+	if m.GetPage() < 0 {
+		err := SearchCommentsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchCommentsRequestMultiError) AllErrors() []error { return m }
+
+// SearchCommentsRequestValidationError is the validation error returned by
+// SearchCommentsRequest.Validate if the designated constraints aren't met.
+type SearchCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchCommentsRequestValidationError) ErrorName() string {
+	return "SearchCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchCommentsRequestValidationError{}
+
+// Validate checks the field values on SearchCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchCommentsResponseMultiError, or nil if none found.
+func (m *SearchCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchCommentsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return SearchCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchCommentsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchCommentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchCommentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchCommentsResponseMultiError) AllErrors() []error { return m }
+
+// SearchCommentsResponseValidationError is the validation error returned by
+// SearchCommentsResponse.Validate if the designated constraints aren't met.
+type SearchCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchCommentsResponseValidationError) ErrorName() string {
+	return "SearchCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchCommentsResponseValidationError{}
+
+// Validate checks the field values on ListUserCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserCommentsRequestMultiError, or nil if none found.
+func (m *ListUserCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 32 {
+		err := ListUserCommentsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetModule() < 0 {
+		err := ListUserCommentsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 128 {
+		err := ListUserCommentsRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ListUserCommentsRequest_Order_name[int32(m.GetOrder())]; !ok {
+		err := ListUserCommentsRequestValidationError{
+			field:  "Order",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListUserCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListUserCommentsRequestValidationError is the validation error returned by
+// ListUserCommentsRequest.Validate if the designated constraints aren't met.
+type ListUserCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserCommentsRequestValidationError) ErrorName() string {
+	return "ListUserCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserCommentsRequestValidationError{}
+
+// Validate checks the field values on ListUserCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserCommentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserCommentsResponseMultiError, or nil if none found.
+func (m *ListUserCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserCommentsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListUserCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserCommentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserCommentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserCommentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserCommentsResponseMultiError) AllErrors() []error { return m }
+
+// ListUserCommentsResponseValidationError is the validation error returned by
+// ListUserCommentsResponse.Validate if the designated constraints aren't met.
+type ListUserCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserCommentsResponseValidationError) ErrorName() string {
+	return "ListUserCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserCommentsResponseValidationError{}
+
+// Validate checks the field values on UserComment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserComment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserCommentMultiError, or
+// nil if none found.
+func (m *UserComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCommentValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCommentValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCommentValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetParent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCommentValidationError{
+					field:  "Parent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCommentValidationError{
+					field:  "Parent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCommentValidationError{
+				field:  "Parent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserCommentMultiError is an error wrapping multiple validation errors
+// returned by UserComment.ValidateAll() if the designated constraints aren't met.
+type UserCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserCommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserCommentMultiError) AllErrors() []error { return m }
+
+// UserCommentValidationError is the validation error returned by
+// UserComment.Validate if the designated constraints aren't met.
+type UserCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserCommentValidationError) ErrorName() string { return "UserCommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserCommentValidationError{}
+
+// Validate checks the field values on ParentSnippet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ParentSnippet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ParentSnippet with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ParentSnippetMultiError, or
+// nil if none found.
+func (m *ParentSnippet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ParentSnippet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommentId
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Content
+
+	// no validation rules for Deleted
+
+	// no validation rules for Hidden
+
+	if len(errors) > 0 {
+		return ParentSnippetMultiError(errors)
+	}
+
+	return nil
+}
+
+// ParentSnippetMultiError is an error wrapping multiple validation errors
+// returned by ParentSnippet.ValidateAll() if the designated constraints
+// aren't met.
+type ParentSnippetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ParentSnippetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ParentSnippetMultiError) AllErrors() []error { return m }
+
+// ParentSnippetValidationError is the validation error returned by
+// ParentSnippet.Validate if the designated constraints aren't met.
+type ParentSnippetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ParentSnippetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ParentSnippetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ParentSnippetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ParentSnippetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ParentSnippetValidationError) ErrorName() string { return "ParentSnippetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ParentSnippetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sParentSnippet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ParentSnippetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ParentSnippetValidationError{}
+
+// Validate checks the field values on BulkDeleteJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BulkDeleteJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkDeleteJob with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BulkDeleteJobMultiError, or
+// nil if none found.
+func (m *BulkDeleteJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkDeleteJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Module
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Mode
+
+	// no validation rules for Status
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Affected
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkDeleteJobValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkDeleteJobValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkDeleteJobValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkDeleteJobValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkDeleteJobValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkDeleteJobValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkDeleteJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkDeleteJobMultiError is an error wrapping multiple validation errors
+// returned by BulkDeleteJob.ValidateAll() if the designated constraints
+// aren't met.
+type BulkDeleteJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkDeleteJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkDeleteJobMultiError) AllErrors() []error { return m }
+
+// BulkDeleteJobValidationError is the validation error returned by
+// BulkDeleteJob.Validate if the designated constraints aren't met.
+type BulkDeleteJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDeleteJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDeleteJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDeleteJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDeleteJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDeleteJobValidationError) ErrorName() string { return "BulkDeleteJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BulkDeleteJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDeleteJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDeleteJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDeleteJobValidationError{}
+
+// Validate checks the field values on BulkDeleteCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkDeleteCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkDeleteCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkDeleteCommentsRequestMultiError, or nil if none found.
+func (m *BulkDeleteCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkDeleteCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) > 32 {
+		err := BulkDeleteCommentsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetModule() < 0 {
+		err := BulkDeleteCommentsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceId()) > 32 {
+		err := BulkDeleteCommentsRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := BulkDeleteJob_Mode_name[int32(m.GetMode())]; !ok {
+		err := BulkDeleteCommentsRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BulkDeleteCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkDeleteCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkDeleteCommentsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BulkDeleteCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkDeleteCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkDeleteCommentsRequestMultiError) AllErrors() []error { return m }
+
+// BulkDeleteCommentsRequestValidationError is the validation error returned by
+// BulkDeleteCommentsRequest.Validate if the designated constraints aren't met.
+type BulkDeleteCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDeleteCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDeleteCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDeleteCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDeleteCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDeleteCommentsRequestValidationError) ErrorName() string {
+	return "BulkDeleteCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkDeleteCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDeleteCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDeleteCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDeleteCommentsRequestValidationError{}
+
+// Validate checks the field values on GetBulkDeleteJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBulkDeleteJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBulkDeleteJobRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBulkDeleteJobRequestMultiError, or nil if none found.
+func (m *GetBulkDeleteJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBulkDeleteJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetBulkDeleteJobRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBulkDeleteJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBulkDeleteJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBulkDeleteJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBulkDeleteJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBulkDeleteJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBulkDeleteJobRequestMultiError) AllErrors() []error { return m }
+
+// GetBulkDeleteJobRequestValidationError is the validation error returned by
+// GetBulkDeleteJobRequest.Validate if the designated constraints aren't met.
+type GetBulkDeleteJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBulkDeleteJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBulkDeleteJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBulkDeleteJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBulkDeleteJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBulkDeleteJobRequestValidationError) ErrorName() string {
+	return "GetBulkDeleteJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBulkDeleteJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBulkDeleteJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBulkDeleteJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBulkDeleteJobRequestValidationError{}
+
+// Validate checks the field values on ExportCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCommentsRequestMultiError, or nil if none found.
+func (m *ExportCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) > 32 {
+		err := ExportCommentsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetModule() < 0 {
+		err := ExportCommentsRequestValidationError{
+			field:  "Module",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceId()) > 32 {
+		err := ExportCommentsRequestValidationError{
+			field:  "ResourceId",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportCommentsRequest_Format_name[int32(m.GetFormat())]; !ok {
+		err := ExportCommentsRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ExportCommentsRequestValidationError is the validation error returned by
+// ExportCommentsRequest.Validate if the designated constraints aren't met.
+type ExportCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCommentsRequestValidationError) ErrorName() string {
+	return "ExportCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCommentsRequestValidationError{}
+
+// Validate checks the field values on ExportCommentsChunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCommentsChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCommentsChunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCommentsChunkMultiError, or nil if none found.
+func (m *ExportCommentsChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCommentsChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ExportCommentsChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportCommentsChunkMultiError is an error wrapping multiple validation
+// errors returned by ExportCommentsChunk.ValidateAll() if the designated
+// constraints aren't met.
+type ExportCommentsChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportCommentsChunkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportCommentsChunkMultiError) AllErrors() []error { return m }
+
+// ExportCommentsChunkValidationError is the validation error returned by
+// ExportCommentsChunk.Validate if the designated constraints aren't met.
+type ExportCommentsChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCommentsChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCommentsChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCommentsChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCommentsChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCommentsChunkValidationError) ErrorName() string {
+	return "ExportCommentsChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCommentsChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCommentsChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCommentsChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCommentsChunkValidationError{}
+
+// Validate checks the field values on ReportedComment_ReasonCount with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportedComment_ReasonCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportedComment_ReasonCount with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportedComment_ReasonCountMultiError, or nil if none found.
+func (m *ReportedComment_ReasonCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportedComment_ReasonCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReportedComment_ReasonCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportedComment_ReasonCountMultiError is an error wrapping multiple
+// validation errors returned by ReportedComment_ReasonCount.ValidateAll() if
+// the designated constraints aren't met.
+type ReportedComment_ReasonCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportedComment_ReasonCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportedComment_ReasonCountMultiError) AllErrors() []error { return m }
+
+// ReportedComment_ReasonCountValidationError is the validation error returned
+// by ReportedComment_ReasonCount.Validate if the designated constraints
+// aren't met.
+type ReportedComment_ReasonCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportedComment_ReasonCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportedComment_ReasonCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportedComment_ReasonCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportedComment_ReasonCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportedComment_ReasonCountValidationError) ErrorName() string {
+	return "ReportedComment_ReasonCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportedComment_ReasonCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportedComment_ReasonCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportedComment_ReasonCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportedComment_ReasonCountValidationError{}
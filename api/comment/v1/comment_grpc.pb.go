@@ -19,11 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CommentService_CreateComment_FullMethodName = "/comment.v1.CommentService/CreateComment"
-	CommentService_GetComment_FullMethodName    = "/comment.v1.CommentService/GetComment"
-	CommentService_DeleteComment_FullMethodName = "/comment.v1.CommentService/DeleteComment"
-	CommentService_LikeComment_FullMethodName   = "/comment.v1.CommentService/LikeComment"
-	CommentService_UnlikeComment_FullMethodName = "/comment.v1.CommentService/UnlikeComment"
+	CommentService_CreateComment_FullMethodName              = "/comment.v1.CommentService/CreateComment"
+	CommentService_GetComment_FullMethodName                 = "/comment.v1.CommentService/GetComment"
+	CommentService_DeleteComment_FullMethodName              = "/comment.v1.CommentService/DeleteComment"
+	CommentService_LikeComment_FullMethodName                = "/comment.v1.CommentService/LikeComment"
+	CommentService_UnlikeComment_FullMethodName              = "/comment.v1.CommentService/UnlikeComment"
+	CommentService_ListMentions_FullMethodName               = "/comment.v1.CommentService/ListMentions"
+	CommentService_ListUserComments_FullMethodName           = "/comment.v1.CommentService/ListUserComments"
+	CommentService_SearchComments_FullMethodName             = "/comment.v1.CommentService/SearchComments"
+	CommentService_WatchComments_FullMethodName              = "/comment.v1.CommentService/WatchComments"
+	CommentService_ReportComment_FullMethodName              = "/comment.v1.CommentService/ReportComment"
+	CommentService_SetResourceCommentSettings_FullMethodName = "/comment.v1.CommentService/SetResourceCommentSettings"
+	CommentService_GetResourceCommentSettings_FullMethodName = "/comment.v1.CommentService/GetResourceCommentSettings"
+	CommentService_BlockUser_FullMethodName                  = "/comment.v1.CommentService/BlockUser"
+	CommentService_UnblockUser_FullMethodName                = "/comment.v1.CommentService/UnblockUser"
+	CommentService_ListReportedComments_FullMethodName       = "/comment.v1.CommentService/ListReportedComments"
+	CommentService_ResolveReports_FullMethodName             = "/comment.v1.CommentService/ResolveReports"
+	CommentService_ApproveComment_FullMethodName             = "/comment.v1.CommentService/ApproveComment"
+	CommentService_RejectComment_FullMethodName              = "/comment.v1.CommentService/RejectComment"
+	CommentService_BulkDeleteComments_FullMethodName         = "/comment.v1.CommentService/BulkDeleteComments"
+	CommentService_GetBulkDeleteJob_FullMethodName           = "/comment.v1.CommentService/GetBulkDeleteJob"
+	CommentService_ExportComments_FullMethodName             = "/comment.v1.CommentService/ExportComments"
+	CommentService_CreateWebhookSubscription_FullMethodName  = "/comment.v1.CommentService/CreateWebhookSubscription"
+	CommentService_DeleteWebhookSubscription_FullMethodName  = "/comment.v1.CommentService/DeleteWebhookSubscription"
+	CommentService_ListWebhookSubscriptions_FullMethodName   = "/comment.v1.CommentService/ListWebhookSubscriptions"
+	CommentService_ListWebhookDeliveries_FullMethodName      = "/comment.v1.CommentService/ListWebhookDeliveries"
+	CommentService_RetryWebhookDelivery_FullMethodName       = "/comment.v1.CommentService/RetryWebhookDelivery"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -42,6 +63,50 @@ type CommentServiceClient interface {
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeResponse, error)
 	// 取消点赞评论
 	UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*UnlikeResponse, error)
+	// 获取提及某用户的评论
+	ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...grpc.CallOption) (*ListMentionsResponse, error)
+	// 按创建时间游标分页获取用户发表的评论，用于个人主页
+	ListUserComments(ctx context.Context, in *ListUserCommentsRequest, opts ...grpc.CallOption) (*ListUserCommentsResponse, error)
+	// 按关键词全文搜索评论，可按模块、资源、用户和时间范围过滤
+	SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (*SearchCommentsResponse, error)
+	// 订阅资源下的评论变更（服务端流），用于直播等实时评论场景
+	// HTTP 客户端可通过 SSE 接口 GET /api/v1/comment/watch?module=&resource_id= 订阅
+	WatchComments(ctx context.Context, in *WatchCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommentChange], error)
+	// 举报评论，同一用户对同一评论只能举报一次，待处理举报数达到阈值后评论自动隐藏
+	ReportComment(ctx context.Context, in *ReportCommentRequest, opts ...grpc.CallOption) (*ReportCommentResponse, error)
+	// 设置资源评论：开放、关闭或只读，最大回复层级以及新评论是否需要审核
+	SetResourceCommentSettings(ctx context.Context, in *SetResourceCommentSettingsRequest, opts ...grpc.CallOption) (*ResourceCommentSettings, error)
+	// 获取资源评论设置，资源没有设置时返回默认的开放设置
+	GetResourceCommentSettings(ctx context.Context, in *GetResourceCommentSettingsRequest, opts ...grpc.CallOption) (*ResourceCommentSettings, error)
+	// 拉黑用户，拉黑后对方的评论不再出现在该用户看到的评论列表中，对方也不能回复该用户的评论
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	// 取消拉黑用户
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	// 管理接口：按评论聚合查询举报
+	ListReportedComments(ctx context.Context, in *ListReportedCommentsRequest, opts ...grpc.CallOption) (*ListReportedCommentsResponse, error)
+	// 管理接口：处理某条评论的全部待处理举报
+	ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error)
+	// 管理接口：审核通过评论，评论随后出现在评论列表中
+	ApproveComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	// 管理接口：审核拒绝评论，评论保留但不再展示
+	RejectComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	// 管理接口：按用户或资源批量删除评论，创建后台任务分批执行
+	BulkDeleteComments(ctx context.Context, in *BulkDeleteCommentsRequest, opts ...grpc.CallOption) (*BulkDeleteJob, error)
+	// 管理接口：查询批量删除任务进度
+	GetBulkDeleteJob(ctx context.Context, in *GetBulkDeleteJobRequest, opts ...grpc.CallOption) (*BulkDeleteJob, error)
+	// 管理接口：导出用户或资源的全部评论（服务端流），按评论ID分批读取并编码为 JSONL 或 CSV，
+	// 依次拼接收到的 data 即为完整的导出文件；也可通过 comment export 命令导出
+	ExportComments(ctx context.Context, in *ExportCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCommentsChunk], error)
+	// 管理接口：创建 Webhook 订阅
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	// 管理接口：删除 Webhook 订阅
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// 管理接口：获取 Webhook 订阅列表
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	// 管理接口：查询 Webhook 投递记录
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// 管理接口：重新投递一条失败（死信）的 Webhook
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
 }
 
 type commentServiceClient struct {
@@ -102,6 +167,234 @@ func (c *commentServiceClient) UnlikeComment(ctx context.Context, in *UnlikeComm
 	return out, nil
 }
 
+func (c *commentServiceClient) ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...grpc.CallOption) (*ListMentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMentionsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListUserComments(ctx context.Context, in *ListUserCommentsRequest, opts ...grpc.CallOption) (*ListUserCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListUserComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...grpc.CallOption) (*SearchCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_SearchComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) WatchComments(ctx context.Context, in *WatchCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommentChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CommentService_ServiceDesc.Streams[0], CommentService_WatchComments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCommentsRequest, CommentChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommentService_WatchCommentsClient = grpc.ServerStreamingClient[CommentChange]
+
+func (c *commentServiceClient) ReportComment(ctx context.Context, in *ReportCommentRequest, opts ...grpc.CallOption) (*ReportCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_ReportComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) SetResourceCommentSettings(ctx context.Context, in *SetResourceCommentSettingsRequest, opts ...grpc.CallOption) (*ResourceCommentSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceCommentSettings)
+	err := c.cc.Invoke(ctx, CommentService_SetResourceCommentSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetResourceCommentSettings(ctx context.Context, in *GetResourceCommentSettingsRequest, opts ...grpc.CallOption) (*ResourceCommentSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceCommentSettings)
+	err := c.cc.Invoke(ctx, CommentService_GetResourceCommentSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, CommentService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, CommentService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListReportedComments(ctx context.Context, in *ListReportedCommentsRequest, opts ...grpc.CallOption) (*ListReportedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportedCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListReportedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ResolveReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ApproveComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_ApproveComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) RejectComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_RejectComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) BulkDeleteComments(ctx context.Context, in *BulkDeleteCommentsRequest, opts ...grpc.CallOption) (*BulkDeleteJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteJob)
+	err := c.cc.Invoke(ctx, CommentService_BulkDeleteComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetBulkDeleteJob(ctx context.Context, in *GetBulkDeleteJobRequest, opts ...grpc.CallOption) (*BulkDeleteJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteJob)
+	err := c.cc.Invoke(ctx, CommentService_GetBulkDeleteJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ExportComments(ctx context.Context, in *ExportCommentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCommentsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CommentService_ServiceDesc.Streams[1], CommentService_ExportComments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportCommentsRequest, ExportCommentsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommentService_ExportCommentsClient = grpc.ServerStreamingClient[ExportCommentsChunk]
+
+func (c *commentServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, CommentService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, CommentService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, CommentService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, CommentService_RetryWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility.
@@ -118,6 +411,50 @@ type CommentServiceServer interface {
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeResponse, error)
 	// 取消点赞评论
 	UnlikeComment(context.Context, *UnlikeCommentRequest) (*UnlikeResponse, error)
+	// 获取提及某用户的评论
+	ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error)
+	// 按创建时间游标分页获取用户发表的评论，用于个人主页
+	ListUserComments(context.Context, *ListUserCommentsRequest) (*ListUserCommentsResponse, error)
+	// 按关键词全文搜索评论，可按模块、资源、用户和时间范围过滤
+	SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error)
+	// 订阅资源下的评论变更（服务端流），用于直播等实时评论场景
+	// HTTP 客户端可通过 SSE 接口 GET /api/v1/comment/watch?module=&resource_id= 订阅
+	WatchComments(*WatchCommentsRequest, grpc.ServerStreamingServer[CommentChange]) error
+	// 举报评论，同一用户对同一评论只能举报一次，待处理举报数达到阈值后评论自动隐藏
+	ReportComment(context.Context, *ReportCommentRequest) (*ReportCommentResponse, error)
+	// 设置资源评论：开放、关闭或只读，最大回复层级以及新评论是否需要审核
+	SetResourceCommentSettings(context.Context, *SetResourceCommentSettingsRequest) (*ResourceCommentSettings, error)
+	// 获取资源评论设置，资源没有设置时返回默认的开放设置
+	GetResourceCommentSettings(context.Context, *GetResourceCommentSettingsRequest) (*ResourceCommentSettings, error)
+	// 拉黑用户，拉黑后对方的评论不再出现在该用户看到的评论列表中，对方也不能回复该用户的评论
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	// 取消拉黑用户
+	UnblockUser(context.Context, *UnblockUserRequest) (*BlockUserResponse, error)
+	// 管理接口：按评论聚合查询举报
+	ListReportedComments(context.Context, *ListReportedCommentsRequest) (*ListReportedCommentsResponse, error)
+	// 管理接口：处理某条评论的全部待处理举报
+	ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error)
+	// 管理接口：审核通过评论，评论随后出现在评论列表中
+	ApproveComment(context.Context, *ModerateCommentRequest) (*Comment, error)
+	// 管理接口：审核拒绝评论，评论保留但不再展示
+	RejectComment(context.Context, *ModerateCommentRequest) (*Comment, error)
+	// 管理接口：按用户或资源批量删除评论，创建后台任务分批执行
+	BulkDeleteComments(context.Context, *BulkDeleteCommentsRequest) (*BulkDeleteJob, error)
+	// 管理接口：查询批量删除任务进度
+	GetBulkDeleteJob(context.Context, *GetBulkDeleteJobRequest) (*BulkDeleteJob, error)
+	// 管理接口：导出用户或资源的全部评论（服务端流），按评论ID分批读取并编码为 JSONL 或 CSV，
+	// 依次拼接收到的 data 即为完整的导出文件；也可通过 comment export 命令导出
+	ExportComments(*ExportCommentsRequest, grpc.ServerStreamingServer[ExportCommentsChunk]) error
+	// 管理接口：创建 Webhook 订阅
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	// 管理接口：删除 Webhook 订阅
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteResponse, error)
+	// 管理接口：获取 Webhook 订阅列表
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	// 管理接口：查询 Webhook 投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// 管理接口：重新投递一条失败（死信）的 Webhook
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*WebhookDelivery, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -143,6 +480,69 @@ func (UnimplementedCommentServiceServer) LikeComment(context.Context, *LikeComme
 func (UnimplementedCommentServiceServer) UnlikeComment(context.Context, *UnlikeCommentRequest) (*UnlikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlikeComment not implemented")
 }
+func (UnimplementedCommentServiceServer) ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentions not implemented")
+}
+func (UnimplementedCommentServiceServer) ListUserComments(context.Context, *ListUserCommentsRequest) (*ListUserCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserComments not implemented")
+}
+func (UnimplementedCommentServiceServer) SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchComments not implemented")
+}
+func (UnimplementedCommentServiceServer) WatchComments(*WatchCommentsRequest, grpc.ServerStreamingServer[CommentChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchComments not implemented")
+}
+func (UnimplementedCommentServiceServer) ReportComment(context.Context, *ReportCommentRequest) (*ReportCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportComment not implemented")
+}
+func (UnimplementedCommentServiceServer) SetResourceCommentSettings(context.Context, *SetResourceCommentSettingsRequest) (*ResourceCommentSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResourceCommentSettings not implemented")
+}
+func (UnimplementedCommentServiceServer) GetResourceCommentSettings(context.Context, *GetResourceCommentSettingsRequest) (*ResourceCommentSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceCommentSettings not implemented")
+}
+func (UnimplementedCommentServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedCommentServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedCommentServiceServer) ListReportedComments(context.Context, *ListReportedCommentsRequest) (*ListReportedCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportedComments not implemented")
+}
+func (UnimplementedCommentServiceServer) ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
+func (UnimplementedCommentServiceServer) ApproveComment(context.Context, *ModerateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveComment not implemented")
+}
+func (UnimplementedCommentServiceServer) RejectComment(context.Context, *ModerateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectComment not implemented")
+}
+func (UnimplementedCommentServiceServer) BulkDeleteComments(context.Context, *BulkDeleteCommentsRequest) (*BulkDeleteJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteComments not implemented")
+}
+func (UnimplementedCommentServiceServer) GetBulkDeleteJob(context.Context, *GetBulkDeleteJobRequest) (*BulkDeleteJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkDeleteJob not implemented")
+}
+func (UnimplementedCommentServiceServer) ExportComments(*ExportCommentsRequest, grpc.ServerStreamingServer[ExportCommentsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportComments not implemented")
+}
+func (UnimplementedCommentServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedCommentServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedCommentServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedCommentServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedCommentServiceServer) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
 
@@ -254,6 +654,370 @@ func _CommentService_UnlikeComment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_ListMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListMentions(ctx, req.(*ListMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ListUserComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListUserComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListUserComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListUserComments(ctx, req.(*ListUserCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_SearchComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).SearchComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_SearchComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).SearchComments(ctx, req.(*SearchCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_WatchComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommentServiceServer).WatchComments(m, &grpc.GenericServerStream[WatchCommentsRequest, CommentChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommentService_WatchCommentsServer = grpc.ServerStreamingServer[CommentChange]
+
+func _CommentService_ReportComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ReportComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ReportComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ReportComment(ctx, req.(*ReportCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_SetResourceCommentSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResourceCommentSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).SetResourceCommentSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_SetResourceCommentSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).SetResourceCommentSettings(ctx, req.(*SetResourceCommentSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetResourceCommentSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceCommentSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetResourceCommentSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetResourceCommentSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetResourceCommentSettings(ctx, req.(*GetResourceCommentSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ListReportedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportedCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListReportedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListReportedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListReportedComments(ctx, req.(*ListReportedCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ResolveReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ResolveReports(ctx, req.(*ResolveReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ApproveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ApproveComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ApproveComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ApproveComment(ctx, req.(*ModerateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_RejectComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).RejectComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_RejectComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).RejectComment(ctx, req.(*ModerateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_BulkDeleteComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).BulkDeleteComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_BulkDeleteComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).BulkDeleteComments(ctx, req.(*BulkDeleteCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetBulkDeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkDeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetBulkDeleteJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetBulkDeleteJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetBulkDeleteJob(ctx, req.(*GetBulkDeleteJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ExportComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCommentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommentServiceServer).ExportComments(m, &grpc.GenericServerStream[ExportCommentsRequest, ExportCommentsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CommentService_ExportCommentsServer = grpc.ServerStreamingServer[ExportCommentsChunk]
+
+func _CommentService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).RetryWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_RetryWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,7 +1045,94 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlikeComment",
 			Handler:    _CommentService_UnlikeComment_Handler,
 		},
+		{
+			MethodName: "ListMentions",
+			Handler:    _CommentService_ListMentions_Handler,
+		},
+		{
+			MethodName: "ListUserComments",
+			Handler:    _CommentService_ListUserComments_Handler,
+		},
+		{
+			MethodName: "SearchComments",
+			Handler:    _CommentService_SearchComments_Handler,
+		},
+		{
+			MethodName: "ReportComment",
+			Handler:    _CommentService_ReportComment_Handler,
+		},
+		{
+			MethodName: "SetResourceCommentSettings",
+			Handler:    _CommentService_SetResourceCommentSettings_Handler,
+		},
+		{
+			MethodName: "GetResourceCommentSettings",
+			Handler:    _CommentService_GetResourceCommentSettings_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _CommentService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _CommentService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListReportedComments",
+			Handler:    _CommentService_ListReportedComments_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _CommentService_ResolveReports_Handler,
+		},
+		{
+			MethodName: "ApproveComment",
+			Handler:    _CommentService_ApproveComment_Handler,
+		},
+		{
+			MethodName: "RejectComment",
+			Handler:    _CommentService_RejectComment_Handler,
+		},
+		{
+			MethodName: "BulkDeleteComments",
+			Handler:    _CommentService_BulkDeleteComments_Handler,
+		},
+		{
+			MethodName: "GetBulkDeleteJob",
+			Handler:    _CommentService_GetBulkDeleteJob_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _CommentService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _CommentService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _CommentService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _CommentService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _CommentService_RetryWebhookDelivery_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchComments",
+			Handler:       _CommentService_WatchComments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportComments",
+			Handler:       _CommentService_ExportComments_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment/v1/comment.proto",
 }
@@ -19,21 +19,78 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationCommentServiceApproveComment = "/comment.v1.CommentService/ApproveComment"
+const OperationCommentServiceBlockUser = "/comment.v1.CommentService/BlockUser"
+const OperationCommentServiceBulkDeleteComments = "/comment.v1.CommentService/BulkDeleteComments"
 const OperationCommentServiceCreateComment = "/comment.v1.CommentService/CreateComment"
+const OperationCommentServiceCreateWebhookSubscription = "/comment.v1.CommentService/CreateWebhookSubscription"
 const OperationCommentServiceDeleteComment = "/comment.v1.CommentService/DeleteComment"
+const OperationCommentServiceDeleteWebhookSubscription = "/comment.v1.CommentService/DeleteWebhookSubscription"
+const OperationCommentServiceGetBulkDeleteJob = "/comment.v1.CommentService/GetBulkDeleteJob"
 const OperationCommentServiceGetComment = "/comment.v1.CommentService/GetComment"
+const OperationCommentServiceGetResourceCommentSettings = "/comment.v1.CommentService/GetResourceCommentSettings"
 const OperationCommentServiceLikeComment = "/comment.v1.CommentService/LikeComment"
+const OperationCommentServiceListMentions = "/comment.v1.CommentService/ListMentions"
+const OperationCommentServiceListReportedComments = "/comment.v1.CommentService/ListReportedComments"
+const OperationCommentServiceListUserComments = "/comment.v1.CommentService/ListUserComments"
+const OperationCommentServiceListWebhookDeliveries = "/comment.v1.CommentService/ListWebhookDeliveries"
+const OperationCommentServiceListWebhookSubscriptions = "/comment.v1.CommentService/ListWebhookSubscriptions"
+const OperationCommentServiceRejectComment = "/comment.v1.CommentService/RejectComment"
+const OperationCommentServiceReportComment = "/comment.v1.CommentService/ReportComment"
+const OperationCommentServiceResolveReports = "/comment.v1.CommentService/ResolveReports"
+const OperationCommentServiceRetryWebhookDelivery = "/comment.v1.CommentService/RetryWebhookDelivery"
+const OperationCommentServiceSearchComments = "/comment.v1.CommentService/SearchComments"
+const OperationCommentServiceSetResourceCommentSettings = "/comment.v1.CommentService/SetResourceCommentSettings"
+const OperationCommentServiceUnblockUser = "/comment.v1.CommentService/UnblockUser"
 const OperationCommentServiceUnlikeComment = "/comment.v1.CommentService/UnlikeComment"
 
 type CommentServiceHTTPServer interface {
+	// ApproveComment 管理接口：审核通过评论，评论随后出现在评论列表中
+	ApproveComment(context.Context, *ModerateCommentRequest) (*Comment, error)
+	// BlockUser 拉黑用户，拉黑后对方的评论不再出现在该用户看到的评论列表中，对方也不能回复该用户的评论
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	// BulkDeleteComments 管理接口：按用户或资源批量删除评论，创建后台任务分批执行
+	BulkDeleteComments(context.Context, *BulkDeleteCommentsRequest) (*BulkDeleteJob, error)
 	// CreateComment 创建评论
 	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
+	// CreateWebhookSubscription 管理接口：创建 Webhook 订阅
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
 	// DeleteComment 删除评论
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteResponse, error)
+	// DeleteWebhookSubscription 管理接口：删除 Webhook 订阅
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteResponse, error)
+	// GetBulkDeleteJob 管理接口：查询批量删除任务进度
+	GetBulkDeleteJob(context.Context, *GetBulkDeleteJobRequest) (*BulkDeleteJob, error)
 	// GetComment 获取评论
 	GetComment(context.Context, *GetCommentRequest) (*CommentTree, error)
+	// GetResourceCommentSettings 获取资源评论设置，资源没有设置时返回默认的开放设置
+	GetResourceCommentSettings(context.Context, *GetResourceCommentSettingsRequest) (*ResourceCommentSettings, error)
 	// LikeComment 点赞评论
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeResponse, error)
+	// ListMentions 获取提及某用户的评论
+	ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error)
+	// ListReportedComments 管理接口：按评论聚合查询举报
+	ListReportedComments(context.Context, *ListReportedCommentsRequest) (*ListReportedCommentsResponse, error)
+	// ListUserComments 按创建时间游标分页获取用户发表的评论，用于个人主页
+	ListUserComments(context.Context, *ListUserCommentsRequest) (*ListUserCommentsResponse, error)
+	// ListWebhookDeliveries 管理接口：查询 Webhook 投递记录
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// ListWebhookSubscriptions 管理接口：获取 Webhook 订阅列表
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	// RejectComment 管理接口：审核拒绝评论，评论保留但不再展示
+	RejectComment(context.Context, *ModerateCommentRequest) (*Comment, error)
+	// ReportComment 举报评论，同一用户对同一评论只能举报一次，待处理举报数达到阈值后评论自动隐藏
+	ReportComment(context.Context, *ReportCommentRequest) (*ReportCommentResponse, error)
+	// ResolveReports 管理接口：处理某条评论的全部待处理举报
+	ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error)
+	// RetryWebhookDelivery 管理接口：重新投递一条失败（死信）的 Webhook
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*WebhookDelivery, error)
+	// SearchComments 按关键词全文搜索评论，可按模块、资源、用户和时间范围过滤
+	SearchComments(context.Context, *SearchCommentsRequest) (*SearchCommentsResponse, error)
+	// SetResourceCommentSettings 设置资源评论：开放、关闭或只读，最大回复层级以及新评论是否需要审核
+	SetResourceCommentSettings(context.Context, *SetResourceCommentSettingsRequest) (*ResourceCommentSettings, error)
+	// UnblockUser 取消拉黑用户
+	UnblockUser(context.Context, *UnblockUserRequest) (*BlockUserResponse, error)
 	// UnlikeComment 取消点赞评论
 	UnlikeComment(context.Context, *UnlikeCommentRequest) (*UnlikeResponse, error)
 }
@@ -45,6 +102,25 @@ func RegisterCommentServiceHTTPServer(s *http.Server, srv CommentServiceHTTPServ
 	r.DELETE("/api/v1/comment", _CommentService_DeleteComment0_HTTP_Handler(srv))
 	r.POST("/api/v1/comment/like", _CommentService_LikeComment0_HTTP_Handler(srv))
 	r.POST("/api/v1/comment/unlike", _CommentService_UnlikeComment0_HTTP_Handler(srv))
+	r.GET("/api/v1/comment/mention", _CommentService_ListMentions0_HTTP_Handler(srv))
+	r.GET("/api/v1/user/comment", _CommentService_ListUserComments0_HTTP_Handler(srv))
+	r.GET("/api/v1/comment/search", _CommentService_SearchComments0_HTTP_Handler(srv))
+	r.POST("/api/v1/comment/report", _CommentService_ReportComment0_HTTP_Handler(srv))
+	r.POST("/api/v1/comment/settings", _CommentService_SetResourceCommentSettings0_HTTP_Handler(srv))
+	r.GET("/api/v1/comment/settings", _CommentService_GetResourceCommentSettings0_HTTP_Handler(srv))
+	r.POST("/api/v1/user/block", _CommentService_BlockUser0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/user/block", _CommentService_UnblockUser0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/report", _CommentService_ListReportedComments0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/report/resolve", _CommentService_ResolveReports0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/comment/approve", _CommentService_ApproveComment0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/comment/reject", _CommentService_RejectComment0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/comment/bulk_delete", _CommentService_BulkDeleteComments0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/comment/bulk_delete", _CommentService_GetBulkDeleteJob0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/webhook/subscription", _CommentService_CreateWebhookSubscription0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/admin/webhook/subscription", _CommentService_DeleteWebhookSubscription0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/webhook/subscription", _CommentService_ListWebhookSubscriptions0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/webhook/delivery", _CommentService_ListWebhookDeliveries0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/webhook/delivery/retry", _CommentService_RetryWebhookDelivery0_HTTP_Handler(srv))
 }
 
 func _CommentService_CreateComment0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
@@ -151,11 +227,418 @@ func _CommentService_UnlikeComment0_HTTP_Handler(srv CommentServiceHTTPServer) f
 	}
 }
 
+func _CommentService_ListMentions0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMentionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceListMentions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMentions(ctx, req.(*ListMentionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMentionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ListUserComments0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceListUserComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserComments(ctx, req.(*ListUserCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserCommentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_SearchComments0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceSearchComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchComments(ctx, req.(*SearchCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchCommentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ReportComment0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceReportComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportComment(ctx, req.(*ReportCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_SetResourceCommentSettings0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetResourceCommentSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceSetResourceCommentSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetResourceCommentSettings(ctx, req.(*SetResourceCommentSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourceCommentSettings)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_GetResourceCommentSettings0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetResourceCommentSettingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceGetResourceCommentSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetResourceCommentSettings(ctx, req.(*GetResourceCommentSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourceCommentSettings)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_BlockUser0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceBlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BlockUser(ctx, req.(*BlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_UnblockUser0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnblockUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceUnblockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnblockUser(ctx, req.(*UnblockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BlockUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ListReportedComments0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListReportedCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceListReportedComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListReportedComments(ctx, req.(*ListReportedCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListReportedCommentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ResolveReports0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResolveReportsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceResolveReports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResolveReports(ctx, req.(*ResolveReportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResolveReportsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ApproveComment0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ModerateCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceApproveComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveComment(ctx, req.(*ModerateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Comment)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_RejectComment0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ModerateCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceRejectComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectComment(ctx, req.(*ModerateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Comment)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_BulkDeleteComments0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkDeleteCommentsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceBulkDeleteComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkDeleteComments(ctx, req.(*BulkDeleteCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkDeleteJob)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_GetBulkDeleteJob0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBulkDeleteJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceGetBulkDeleteJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBulkDeleteJob(ctx, req.(*GetBulkDeleteJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkDeleteJob)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_CreateWebhookSubscription0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateWebhookSubscriptionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceCreateWebhookSubscription)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WebhookSubscription)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_DeleteWebhookSubscription0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteWebhookSubscriptionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceDeleteWebhookSubscription)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ListWebhookSubscriptions0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhookSubscriptionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceListWebhookSubscriptions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhookSubscriptionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_ListWebhookDeliveries0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListWebhookDeliveriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceListWebhookDeliveries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListWebhookDeliveriesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CommentService_RetryWebhookDelivery0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RetryWebhookDeliveryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceRetryWebhookDelivery)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WebhookDelivery)
+		return ctx.Result(200, reply)
+	}
+}
+
 type CommentServiceHTTPClient interface {
+	ApproveComment(ctx context.Context, req *ModerateCommentRequest, opts ...http.CallOption) (rsp *Comment, err error)
+	BlockUser(ctx context.Context, req *BlockUserRequest, opts ...http.CallOption) (rsp *BlockUserResponse, err error)
+	BulkDeleteComments(ctx context.Context, req *BulkDeleteCommentsRequest, opts ...http.CallOption) (rsp *BulkDeleteJob, err error)
 	CreateComment(ctx context.Context, req *CreateCommentRequest, opts ...http.CallOption) (rsp *Comment, err error)
+	CreateWebhookSubscription(ctx context.Context, req *CreateWebhookSubscriptionRequest, opts ...http.CallOption) (rsp *WebhookSubscription, err error)
 	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *DeleteResponse, err error)
+	DeleteWebhookSubscription(ctx context.Context, req *DeleteWebhookSubscriptionRequest, opts ...http.CallOption) (rsp *DeleteResponse, err error)
+	GetBulkDeleteJob(ctx context.Context, req *GetBulkDeleteJobRequest, opts ...http.CallOption) (rsp *BulkDeleteJob, err error)
 	GetComment(ctx context.Context, req *GetCommentRequest, opts ...http.CallOption) (rsp *CommentTree, err error)
+	GetResourceCommentSettings(ctx context.Context, req *GetResourceCommentSettingsRequest, opts ...http.CallOption) (rsp *ResourceCommentSettings, err error)
 	LikeComment(ctx context.Context, req *LikeCommentRequest, opts ...http.CallOption) (rsp *LikeResponse, err error)
+	ListMentions(ctx context.Context, req *ListMentionsRequest, opts ...http.CallOption) (rsp *ListMentionsResponse, err error)
+	ListReportedComments(ctx context.Context, req *ListReportedCommentsRequest, opts ...http.CallOption) (rsp *ListReportedCommentsResponse, err error)
+	ListUserComments(ctx context.Context, req *ListUserCommentsRequest, opts ...http.CallOption) (rsp *ListUserCommentsResponse, err error)
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest, opts ...http.CallOption) (rsp *ListWebhookDeliveriesResponse, err error)
+	ListWebhookSubscriptions(ctx context.Context, req *ListWebhookSubscriptionsRequest, opts ...http.CallOption) (rsp *ListWebhookSubscriptionsResponse, err error)
+	RejectComment(ctx context.Context, req *ModerateCommentRequest, opts ...http.CallOption) (rsp *Comment, err error)
+	ReportComment(ctx context.Context, req *ReportCommentRequest, opts ...http.CallOption) (rsp *ReportCommentResponse, err error)
+	ResolveReports(ctx context.Context, req *ResolveReportsRequest, opts ...http.CallOption) (rsp *ResolveReportsResponse, err error)
+	RetryWebhookDelivery(ctx context.Context, req *RetryWebhookDeliveryRequest, opts ...http.CallOption) (rsp *WebhookDelivery, err error)
+	SearchComments(ctx context.Context, req *SearchCommentsRequest, opts ...http.CallOption) (rsp *SearchCommentsResponse, err error)
+	SetResourceCommentSettings(ctx context.Context, req *SetResourceCommentSettingsRequest, opts ...http.CallOption) (rsp *ResourceCommentSettings, err error)
+	UnblockUser(ctx context.Context, req *UnblockUserRequest, opts ...http.CallOption) (rsp *BlockUserResponse, err error)
 	UnlikeComment(ctx context.Context, req *UnlikeCommentRequest, opts ...http.CallOption) (rsp *UnlikeResponse, err error)
 }
 
@@ -167,6 +650,45 @@ func NewCommentServiceHTTPClient(client *http.Client) CommentServiceHTTPClient {
 	return &CommentServiceHTTPClientImpl{client}
 }
 
+func (c *CommentServiceHTTPClientImpl) ApproveComment(ctx context.Context, in *ModerateCommentRequest, opts ...http.CallOption) (*Comment, error) {
+	var out Comment
+	pattern := "/api/v1/admin/comment/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceApproveComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...http.CallOption) (*BlockUserResponse, error) {
+	var out BlockUserResponse
+	pattern := "/api/v1/user/block"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceBlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) BulkDeleteComments(ctx context.Context, in *BulkDeleteCommentsRequest, opts ...http.CallOption) (*BulkDeleteJob, error) {
+	var out BulkDeleteJob
+	pattern := "/api/v1/admin/comment/bulk_delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceBulkDeleteComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CommentServiceHTTPClientImpl) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...http.CallOption) (*Comment, error) {
 	var out Comment
 	pattern := "/api/v1/comment"
@@ -180,6 +702,19 @@ func (c *CommentServiceHTTPClientImpl) CreateComment(ctx context.Context, in *Cr
 	return &out, nil
 }
 
+func (c *CommentServiceHTTPClientImpl) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...http.CallOption) (*WebhookSubscription, error) {
+	var out WebhookSubscription
+	pattern := "/api/v1/admin/webhook/subscription"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceCreateWebhookSubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CommentServiceHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...http.CallOption) (*DeleteResponse, error) {
 	var out DeleteResponse
 	pattern := "/api/v1/comment"
@@ -193,6 +728,32 @@ func (c *CommentServiceHTTPClientImpl) DeleteComment(ctx context.Context, in *De
 	return &out, nil
 }
 
+func (c *CommentServiceHTTPClientImpl) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...http.CallOption) (*DeleteResponse, error) {
+	var out DeleteResponse
+	pattern := "/api/v1/admin/webhook/subscription"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceDeleteWebhookSubscription))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) GetBulkDeleteJob(ctx context.Context, in *GetBulkDeleteJobRequest, opts ...http.CallOption) (*BulkDeleteJob, error) {
+	var out BulkDeleteJob
+	pattern := "/api/v1/admin/comment/bulk_delete"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceGetBulkDeleteJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CommentServiceHTTPClientImpl) GetComment(ctx context.Context, in *GetCommentRequest, opts ...http.CallOption) (*CommentTree, error) {
 	var out CommentTree
 	pattern := "/api/v1/comment"
@@ -206,6 +767,19 @@ func (c *CommentServiceHTTPClientImpl) GetComment(ctx context.Context, in *GetCo
 	return &out, nil
 }
 
+func (c *CommentServiceHTTPClientImpl) GetResourceCommentSettings(ctx context.Context, in *GetResourceCommentSettingsRequest, opts ...http.CallOption) (*ResourceCommentSettings, error) {
+	var out ResourceCommentSettings
+	pattern := "/api/v1/comment/settings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceGetResourceCommentSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CommentServiceHTTPClientImpl) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...http.CallOption) (*LikeResponse, error) {
 	var out LikeResponse
 	pattern := "/api/v1/comment/like"
@@ -219,6 +793,162 @@ func (c *CommentServiceHTTPClientImpl) LikeComment(ctx context.Context, in *Like
 	return &out, nil
 }
 
+func (c *CommentServiceHTTPClientImpl) ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...http.CallOption) (*ListMentionsResponse, error) {
+	var out ListMentionsResponse
+	pattern := "/api/v1/comment/mention"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceListMentions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) ListReportedComments(ctx context.Context, in *ListReportedCommentsRequest, opts ...http.CallOption) (*ListReportedCommentsResponse, error) {
+	var out ListReportedCommentsResponse
+	pattern := "/api/v1/admin/report"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceListReportedComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) ListUserComments(ctx context.Context, in *ListUserCommentsRequest, opts ...http.CallOption) (*ListUserCommentsResponse, error) {
+	var out ListUserCommentsResponse
+	pattern := "/api/v1/user/comment"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceListUserComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...http.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	var out ListWebhookDeliveriesResponse
+	pattern := "/api/v1/admin/webhook/delivery"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceListWebhookDeliveries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...http.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	var out ListWebhookSubscriptionsResponse
+	pattern := "/api/v1/admin/webhook/subscription"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceListWebhookSubscriptions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) RejectComment(ctx context.Context, in *ModerateCommentRequest, opts ...http.CallOption) (*Comment, error) {
+	var out Comment
+	pattern := "/api/v1/admin/comment/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceRejectComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) ReportComment(ctx context.Context, in *ReportCommentRequest, opts ...http.CallOption) (*ReportCommentResponse, error) {
+	var out ReportCommentResponse
+	pattern := "/api/v1/comment/report"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceReportComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...http.CallOption) (*ResolveReportsResponse, error) {
+	var out ResolveReportsResponse
+	pattern := "/api/v1/admin/report/resolve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceResolveReports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...http.CallOption) (*WebhookDelivery, error) {
+	var out WebhookDelivery
+	pattern := "/api/v1/admin/webhook/delivery/retry"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceRetryWebhookDelivery))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) SearchComments(ctx context.Context, in *SearchCommentsRequest, opts ...http.CallOption) (*SearchCommentsResponse, error) {
+	var out SearchCommentsResponse
+	pattern := "/api/v1/comment/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceSearchComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) SetResourceCommentSettings(ctx context.Context, in *SetResourceCommentSettingsRequest, opts ...http.CallOption) (*ResourceCommentSettings, error) {
+	var out ResourceCommentSettings
+	pattern := "/api/v1/comment/settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceSetResourceCommentSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CommentServiceHTTPClientImpl) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...http.CallOption) (*BlockUserResponse, error) {
+	var out BlockUserResponse
+	pattern := "/api/v1/user/block"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCommentServiceUnblockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CommentServiceHTTPClientImpl) UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...http.CallOption) (*UnlikeResponse, error) {
 	var out UnlikeResponse
 	pattern := "/api/v1/comment/unlike"
@@ -21,22 +21,83 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 业务错误原因，作为 Kratos 错误的 reason 返回给客户端
 type ErrorReason int32
 
 const (
 	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
 	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	// 业务模块未注册
+	ErrorReason_UNKNOWN_MODULE ErrorReason = 2
+	// 评论内容超过模块允许的最大长度
+	ErrorReason_CONTENT_TOO_LONG ErrorReason = 3
+	// 模块不允许该互动类型
+	ErrorReason_REACTION_NOT_ALLOWED ErrorReason = 4
+	// 资源已关闭评论
+	ErrorReason_COMMENTS_CLOSED ErrorReason = 5
+	// 资源评论只读，不能发表评论和点赞
+	ErrorReason_COMMENTS_READ_ONLY ErrorReason = 6
+	// 回复层级超过允许的最大层级
+	ErrorReason_REPLY_DEPTH_EXCEEDED ErrorReason = 7
+	// 被回复评论的作者已拉黑当前用户
+	ErrorReason_BLOCKED_BY_AUTHOR ErrorReason = 8
+	// 不能拉黑自己
+	ErrorReason_BLOCK_SELF ErrorReason = 9
+	// 幂等键已用于内容不同的请求
+	ErrorReason_IDEMPOTENCY_KEY_CONFLICT ErrorReason = 10
+	// 使用相同幂等键的请求仍在处理中
+	ErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS ErrorReason = 11
+	// 用户已举报过该评论
+	ErrorReason_COMMENT_ALREADY_REPORTED ErrorReason = 12
+	// 附件内容不完整或地址格式错误
+	ErrorReason_INVALID_ATTACHMENT ErrorReason = 13
+	// 附件地址的域名不在允许列表中
+	ErrorReason_ATTACHMENT_HOST_NOT_ALLOWED ErrorReason = 14
+	// 评论内容与该用户近期评论重复或近似
+	ErrorReason_DUPLICATE_COMMENT ErrorReason = 15
+	// Webhook 订阅由配置文件管理，不能通过接口删除
+	ErrorReason_CONFIG_SUBSCRIPTION ErrorReason = 16
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "GREETER_UNSPECIFIED",
-		1: "USER_NOT_FOUND",
+		0:  "GREETER_UNSPECIFIED",
+		1:  "USER_NOT_FOUND",
+		2:  "UNKNOWN_MODULE",
+		3:  "CONTENT_TOO_LONG",
+		4:  "REACTION_NOT_ALLOWED",
+		5:  "COMMENTS_CLOSED",
+		6:  "COMMENTS_READ_ONLY",
+		7:  "REPLY_DEPTH_EXCEEDED",
+		8:  "BLOCKED_BY_AUTHOR",
+		9:  "BLOCK_SELF",
+		10: "IDEMPOTENCY_KEY_CONFLICT",
+		11: "IDEMPOTENCY_KEY_IN_PROGRESS",
+		12: "COMMENT_ALREADY_REPORTED",
+		13: "INVALID_ATTACHMENT",
+		14: "ATTACHMENT_HOST_NOT_ALLOWED",
+		15: "DUPLICATE_COMMENT",
+		16: "CONFIG_SUBSCRIPTION",
 	}
 	ErrorReason_value = map[string]int32{
-		"GREETER_UNSPECIFIED": 0,
-		"USER_NOT_FOUND":      1,
+		"GREETER_UNSPECIFIED":         0,
+		"USER_NOT_FOUND":              1,
+		"UNKNOWN_MODULE":              2,
+		"CONTENT_TOO_LONG":            3,
+		"REACTION_NOT_ALLOWED":        4,
+		"COMMENTS_CLOSED":             5,
+		"COMMENTS_READ_ONLY":          6,
+		"REPLY_DEPTH_EXCEEDED":        7,
+		"BLOCKED_BY_AUTHOR":           8,
+		"BLOCK_SELF":                  9,
+		"IDEMPOTENCY_KEY_CONFLICT":    10,
+		"IDEMPOTENCY_KEY_IN_PROGRESS": 11,
+		"COMMENT_ALREADY_REPORTED":    12,
+		"INVALID_ATTACHMENT":          13,
+		"ATTACHMENT_HOST_NOT_ALLOWED": 14,
+		"DUPLICATE_COMMENT":           15,
+		"CONFIG_SUBSCRIPTION":         16,
 	}
 )
 
@@ -118,10 +179,27 @@ var File_comment_v1_error_reason_proto protoreflect.FileDescriptor
 const file_comment_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
 	"\x1dcomment/v1/error_reason.proto\x12\n" +
-	"comment.v1*:\n" +
+	"comment.v1*\xb2\x03\n" +
 	"\vErrorReason\x12\x17\n" +
 	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_NOT_FOUND\x10\x01*6\n" +
+	"\x0eUSER_NOT_FOUND\x10\x01\x12\x12\n" +
+	"\x0eUNKNOWN_MODULE\x10\x02\x12\x14\n" +
+	"\x10CONTENT_TOO_LONG\x10\x03\x12\x18\n" +
+	"\x14REACTION_NOT_ALLOWED\x10\x04\x12\x13\n" +
+	"\x0fCOMMENTS_CLOSED\x10\x05\x12\x16\n" +
+	"\x12COMMENTS_READ_ONLY\x10\x06\x12\x18\n" +
+	"\x14REPLY_DEPTH_EXCEEDED\x10\a\x12\x15\n" +
+	"\x11BLOCKED_BY_AUTHOR\x10\b\x12\x0e\n" +
+	"\n" +
+	"BLOCK_SELF\x10\t\x12\x1c\n" +
+	"\x18IDEMPOTENCY_KEY_CONFLICT\x10\n" +
+	"\x12\x1f\n" +
+	"\x1bIDEMPOTENCY_KEY_IN_PROGRESS\x10\v\x12\x1c\n" +
+	"\x18COMMENT_ALREADY_REPORTED\x10\f\x12\x16\n" +
+	"\x12INVALID_ATTACHMENT\x10\r\x12\x1f\n" +
+	"\x1bATTACHMENT_HOST_NOT_ALLOWED\x10\x0e\x12\x15\n" +
+	"\x11DUPLICATE_COMMENT\x10\x0f\x12\x17\n" +
+	"\x13CONFIG_SUBSCRIPTION\x10\x10*6\n" +
 	"\rSuccessReason\x12\x17\n" +
 	"\x13SUCCESS_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\aSUCCESS\x10\xc8\x01B\x1dP\x01Z\x19comment/api/comment/v1;v1b\x06proto3"
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bufio"
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/internal/data"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// runExport 执行 export 子命令：导出用户或资源的全部评论到文件，日志输出到标准输出，因此导出内容必须写入 -o 指定的文件
+func runExport(c *conf.Data, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	userID := fs.String("user", "", "export all comments of the user")
+	module := fs.Int("module", 0, "module of the resource to export")
+	resourceID := fs.String("resource", "", "id of the resource to export")
+	format := fs.String("format", biz.ExportJSONL, "output format, jsonl or csv")
+	output := fs.String("o", "", "output file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *output == "" {
+		return fmt.Errorf("usage: comment [-conf path] export -user id | -module n -resource id [-format jsonl|csv] -o file")
+	}
+
+	d, cleanup, err := data.NewData(c)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	exported, err := biz.NewExportUsecase(data.NewExportRepo(d)).ExportComments(context.Background(), &biz.ExportQuery{
+		UserID:     *userID,
+		Module:     int32(*module),
+		ResourceID: *resourceID,
+	}, *format, func(chunk []byte) error {
+		_, err := w.Write(chunk)
+		return err
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// 不保留不完整的导出文件
+		os.Remove(*output)
+		return err
+	}
+	fmt.Printf("exported %d comments to %s\n", exported, *output)
+	return nil
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"comment/internal/biz"
 	"comment/internal/conf"
 	"flag"
+	"fmt"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/config/file"
@@ -27,9 +29,13 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-conf path] [migrate up|down|status | reconcile [-dry-run] | export -user id | -module n -resource id [-format jsonl|csv] -o file]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ed *biz.EventDispatcher, ww *biz.WebhookWorker, wh *biz.WatchHub, bw *biz.BulkDeleteWorker, pb *biz.CommentPathBackfiller, cr *biz.CounterReconciler, ca *biz.CommentArchiver) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -39,6 +45,13 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			ed,
+			ww,
+			wh,
+			bw,
+			pb,
+			cr,
+			ca,
 		),
 	)
 }
@@ -70,6 +83,26 @@ func main() {
 		panic(err)
 	}
 
+	// 子命令在加载配置后执行，不启动服务
+	if flag.NArg() > 0 {
+		var err error
+		switch cmd := flag.Arg(0); cmd {
+		case "migrate":
+			err = runMigrate(bc.Data, flag.Args()[1:])
+		case "reconcile":
+			err = runReconcile(bc.Data, flag.Args()[1:])
+		case "export":
+			err = runExport(bc.Data, flag.Args()[1:])
+		default:
+			err = fmt.Errorf("unknown command %q", cmd)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
 	if err != nil {
 		panic(err)
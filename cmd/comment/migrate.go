@@ -0,0 +1,79 @@
+package main
+
+import (
+	"comment/internal/conf"
+	"comment/internal/data"
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// runMigrate 执行 migrate 子命令：up 执行所有未执行的迁移并创建缺少的分片表和归档表，down 回滚最近一个迁移，status 查看迁移状态
+func runMigrate(c *conf.Data, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: comment [-conf path] migrate up|down|status")
+	}
+
+	d, cleanup, err := data.NewData(c)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	m, err := data.NewMigrator(d)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		done, err := m.Up(ctx)
+		for _, migration := range done {
+			fmt.Printf("applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(done) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		// 以迁移后的 comment 表为模板创建缺少的分片表和归档表
+		created, err := m.CreateCommentTables(ctx)
+		for _, table := range created {
+			fmt.Printf("created table %s\n", table)
+		}
+		if err != nil {
+			return err
+		}
+	case "down":
+		reverted, err := m.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			fmt.Println("no applied migrations")
+			return nil
+		}
+		fmt.Printf("reverted %04d_%s\n", reverted.Version, reverted.Name)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedGmt != nil {
+				applied = s.AppliedGmt.Local().Format(time.DateTime)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", args[0])
+	}
+	return nil
+}
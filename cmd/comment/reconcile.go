@@ -0,0 +1,47 @@
+package main
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/internal/data"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+// runReconcile 执行 reconcile 子命令：重新统计所有评论的点赞数和回复数，输出并修复不一致的计数，-dry-run 时只输出
+func runReconcile(c *conf.Data, args []string) error {
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "report mismatches without repairing them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("usage: comment [-conf path] reconcile [-dry-run]")
+	}
+
+	d, cleanup, err := data.NewData(c)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	report, err := biz.NewCounterReconciler(c, data.NewCounterRepo(d)).Reconcile(context.Background(), !*dryRun)
+	if report != nil && len(report.Mismatches) > 0 {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "COMMENT_ID\tCOUNTER\tSTORED\tACTUAL\tREPAIRED")
+		for _, m := range report.Mismatches {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%t\n", m.CommentID, m.Counter, m.Stored, m.Actual, m.Repaired)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("scanned %d comments, %d mismatches, %d repaired\n", report.Scanned, len(report.Mismatches), report.Repaired)
+	return nil
+}
@@ -25,11 +25,41 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		return nil, nil, err
 	}
 	commentRepo := data.NewCommentRepo(dataData)
-	commentUsecase := biz.NewCommentUsecase(commentRepo)
-	commentService := service.NewCommentService(commentUsecase)
-	grpcServer := server.NewGRPCServer(confServer, commentService)
-	httpServer := server.NewHTTPServer(confServer, commentService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	idempotencyRepo := data.NewIdempotencyRepo(dataData)
+	fingerprintStore := data.NewFingerprintStore(confData, dataData)
+	duplicateDetector := biz.NewDuplicateDetector(confData, fingerprintStore)
+	blockRepo := data.NewBlockRepo(dataData)
+	settingRepo := data.NewSettingRepo(dataData)
+	moduleRegistry := biz.NewModuleRegistry(confData)
+	txnManager := data.NewTxnManager(dataData)
+	idGenerator := biz.NewIDGenerator(confData)
+	commentUsecase := biz.NewCommentUsecase(confData, commentRepo, idempotencyRepo, duplicateDetector, blockRepo, settingRepo, moduleRegistry, txnManager, idGenerator)
+	webhookRepo := data.NewWebhookRepo(dataData)
+	webhookUsecase := biz.NewWebhookUsecase(confData, webhookRepo)
+	watchBroker := data.NewWatchBroker(confData, dataData)
+	watchHub := biz.NewWatchHub(confData, commentRepo, watchBroker)
+	commentSearcher := data.NewCommentSearcher(confData, dataData)
+	searchUsecase := biz.NewSearchUsecase(commentSearcher)
+	bulkDeleteRepo := data.NewBulkDeleteRepo(dataData)
+	bulkDeleteUsecase := biz.NewBulkDeleteUsecase(bulkDeleteRepo)
+	exportRepo := data.NewExportRepo(dataData)
+	exportUsecase := biz.NewExportUsecase(exportRepo)
+	commentService := service.NewCommentService(commentUsecase, webhookUsecase, watchHub, searchUsecase, bulkDeleteUsecase, exportUsecase)
+	grpcServer := server.NewGRPCServer(confServer, commentService, moduleRegistry)
+	httpServer := server.NewHTTPServer(confServer, commentService, moduleRegistry, logger)
+	eventRepo := data.NewEventRepo(dataData)
+	publisher := data.NewPublisher(confData)
+	eventDispatcher := biz.NewEventDispatcher(confData, eventRepo, publisher, webhookUsecase, watchHub)
+	webhookClient := data.NewWebhookClient(confData)
+	webhookWorker := biz.NewWebhookWorker(confData, webhookUsecase, webhookClient)
+	bulkDeleteWorker := biz.NewBulkDeleteWorker(confData, bulkDeleteUsecase)
+	commentPathRepo := data.NewCommentPathRepo(dataData)
+	commentPathBackfiller := biz.NewCommentPathBackfiller(commentPathRepo)
+	counterRepo := data.NewCounterRepo(dataData)
+	counterReconciler := biz.NewCounterReconciler(confData, counterRepo)
+	archiveRepo := data.NewArchiveRepo(dataData)
+	commentArchiver := biz.NewCommentArchiver(confData, archiveRepo)
+	app := newApp(logger, grpcServer, httpServer, eventDispatcher, webhookWorker, watchHub, bulkDeleteWorker, commentPathBackfiller, counterReconciler, commentArchiver)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"time"
+)
+
+const (
+	// defaultArchiveInterval 扫描不活跃资源的默认间隔
+	defaultArchiveInterval = time.Hour
+	// defaultArchiveBatchSize 每批归档的默认资源数
+	defaultArchiveBatchSize = 100
+)
+
+// InactiveResource 最后一条评论早于归档阈值的资源
+type InactiveResource struct {
+	Module     int32
+	ResourceID string
+}
+
+// ArchiveRepo 冷评论归档仓储
+type ArchiveRepo interface {
+	// ListInactiveResources 查询最后一条评论早于 before 且尚未归档的资源，至多 limit 个
+	ListInactiveResources(ctx context.Context, before time.Time, limit int) ([]*InactiveResource, error)
+	// ArchiveResource 把资源的评论移到归档表，返回移动的评论数；资源在 before 之后有新评论时不归档，返回 0
+	ArchiveResource(ctx context.Context, res *InactiveResource, before time.Time) (int64, error)
+}
+
+// CommentArchiver 定期把长时间没有新评论的资源的评论移到归档表，缩小评论表；
+// 已归档资源的评论列表和评论详情透明地从归档表读取，资源有新评论时评论移回评论表
+type CommentArchiver struct {
+	repo          ArchiveRepo
+	inactiveAfter time.Duration
+	interval      time.Duration
+	batchSize     int
+	stop          chan struct{}
+	done          chan struct{}
+}
+
+// NewCommentArchiver new a CommentArchiver.
+func NewCommentArchiver(c *conf.Data, repo ArchiveRepo) *CommentArchiver {
+	a := &CommentArchiver{
+		repo:      repo,
+		interval:  defaultArchiveInterval,
+		batchSize: defaultArchiveBatchSize,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if ac := c.GetArchive(); ac != nil {
+		if ac.InactiveAfter != nil && ac.InactiveAfter.AsDuration() > 0 {
+			a.inactiveAfter = ac.InactiveAfter.AsDuration()
+		}
+		if ac.Interval != nil && ac.Interval.AsDuration() > 0 {
+			a.interval = ac.Interval.AsDuration()
+		}
+		if ac.BatchSize > 0 {
+			a.batchSize = int(ac.BatchSize)
+		}
+	}
+	return a
+}
+
+// Start 按间隔归档不活跃的资源，阻塞直到 Stop 被调用；未配置归档阈值时不归档
+func (a *CommentArchiver) Start(ctx context.Context) error {
+	defer close(a.done)
+	if a.inactiveAfter <= 0 {
+		log.Info(ctx, "comment archiver disabled.")
+		select {
+		case <-a.stop:
+		case <-ctx.Done():
+		}
+		return nil
+	}
+	log.Info(ctx, "comment archiver started.", "inactive_after", a.inactiveAfter, "interval", a.interval, "batch_size", a.batchSize)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := a.RunOnce(ctx); err != nil {
+				log.Error(ctx, "archive comments error.", "err", err)
+			}
+		}
+	}
+}
+
+// Stop 停止归档，执行中的归档在当前资源完成后中断
+func (a *CommentArchiver) Stop(ctx context.Context) error {
+	close(a.stop)
+	select {
+	case <-a.done:
+	case <-ctx.Done():
+	}
+	log.Info(ctx, "comment archiver stopped.")
+	return nil
+}
+
+// RunOnce 分批归档所有不活跃的资源，直到没有可归档的资源，返回归档的资源数
+func (a *CommentArchiver) RunOnce(ctx context.Context) (int, error) {
+	before := time.Now().Add(-a.inactiveAfter)
+	archived := 0
+	for {
+		resources, err := a.repo.ListInactiveResources(ctx, before, a.batchSize)
+		if err != nil {
+			return archived, err
+		}
+		if len(resources) == 0 {
+			return archived, nil
+		}
+		for _, res := range resources {
+			select {
+			case <-a.stop:
+				return archived, nil
+			case <-ctx.Done():
+				return archived, nil
+			default:
+			}
+
+			moved, err := a.repo.ArchiveResource(ctx, res, before)
+			if err != nil {
+				return archived, err
+			}
+			if moved == 0 {
+				continue
+			}
+			archived++
+			log.Info(ctx, "archive resource comments.", "module", res.Module, "resource_id", res.ResourceID, "comments", moved)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ArchiveRepoMock 是ArchiveRepo接口的mock实现
+type ArchiveRepoMock struct {
+	mock.Mock
+}
+
+func (m *ArchiveRepoMock) ListInactiveResources(ctx context.Context, before time.Time, limit int) ([]*InactiveResource, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).([]*InactiveResource), args.Error(1)
+}
+
+func (m *ArchiveRepoMock) ArchiveResource(ctx context.Context, res *InactiveResource, before time.Time) (int64, error) {
+	args := m.Called(ctx, res, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestCommentArchiver_RunOnce(t *testing.T) {
+	c := &conf.Data{Archive: &conf.Data_Archive{InactiveAfter: durationpb.New(24 * time.Hour), BatchSize: 2}}
+	r1, r2, r3 := &InactiveResource{Module: 1, ResourceID: "r1"}, &InactiveResource{Module: 1, ResourceID: "r2"}, &InactiveResource{Module: 1, ResourceID: "r3"}
+	recent := mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= 24*time.Hour && time.Since(before) < 25*time.Hour
+	})
+
+	t.Run("分批归档直到没有不活跃的资源", func(t *testing.T) {
+		repo := new(ArchiveRepoMock)
+		repo.On("ListInactiveResources", mock.Anything, recent, 2).Return([]*InactiveResource{r1, r2}, nil).Once()
+		repo.On("ListInactiveResources", mock.Anything, recent, 2).Return([]*InactiveResource{r3}, nil).Once()
+		repo.On("ListInactiveResources", mock.Anything, recent, 2).Return([]*InactiveResource{}, nil).Once()
+		repo.On("ArchiveResource", mock.Anything, r1, recent).Return(int64(5), nil).Once()
+		// 资源在归档前有了新评论
+		repo.On("ArchiveResource", mock.Anything, r2, recent).Return(int64(0), nil).Once()
+		repo.On("ArchiveResource", mock.Anything, r3, recent).Return(int64(1), nil).Once()
+
+		archived, err := NewCommentArchiver(c, repo).RunOnce(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, archived)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("出错时停止本轮归档", func(t *testing.T) {
+		repo := new(ArchiveRepoMock)
+		repo.On("ListInactiveResources", mock.Anything, recent, 2).Return([]*InactiveResource{r1, r2}, nil).Once()
+		repo.On("ArchiveResource", mock.Anything, r1, recent).Return(int64(0), errors.New("lock wait timeout")).Once()
+
+		archived, err := NewCommentArchiver(c, repo).RunOnce(context.Background())
+		assert.Error(t, err)
+		assert.Zero(t, archived)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestCommentArchiver_Start(t *testing.T) {
+	repo := new(ArchiveRepoMock)
+	a := NewCommentArchiver(nil, repo)
+	go a.Start(context.Background())
+	assert.NoError(t, a.Stop(context.Background()))
+	repo.AssertNotCalled(t, "ListInactiveResources", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,142 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 附件类型，取值与 v1.Attachment_Type 一致
+const (
+	AttachmentImage   int32 = 1
+	AttachmentLink    int32 = 2
+	AttachmentSticker int32 = 3
+)
+
+// Attachment 评论附件：图片、链接预览或表情贴纸
+type Attachment struct {
+	// ID 附件唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// CommentID 附件所属的评论ID
+	CommentID int64 `gorm:"column:comment_id;type:bigint;not null;index:idx_attachment_comment"`
+
+	// Type 附件类型
+	Type int32 `gorm:"column:type;type:tinyint;not null"`
+
+	// URL 图片、链接或贴纸地址
+	URL string `gorm:"column:url;type:varchar(1024);not null"`
+
+	// Width 图片宽度（像素）
+	Width int32 `gorm:"column:width;type:int;not null;default:0"`
+
+	// Height 图片高度（像素）
+	Height int32 `gorm:"column:height;type:int;not null;default:0"`
+
+	// Title 链接预览标题
+	Title string `gorm:"column:title;type:varchar(200);not null;default:''"`
+
+	// Description 链接预览描述
+	Description string `gorm:"column:description;type:varchar(500);not null;default:''"`
+
+	// ThumbnailURL 链接预览缩略图地址
+	ThumbnailURL string `gorm:"column:thumbnail_url;type:varchar(1024);not null;default:''"`
+
+	// StickerID 表情贴纸ID
+	StickerID string `gorm:"column:sticker_id;type:varchar(64);not null;default:''"`
+
+	// Sort 附件在评论中的顺序
+	Sort int32 `gorm:"column:sort;type:int;not null;default:0"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (a *Attachment) TableName() string {
+	return "comment_attachment"
+}
+
+// hostAllowList 附件地址允许的域名，*.example.com 匹配 example.com 的所有子域名
+type hostAllowList []string
+
+// allowed 判断地址的域名是否在允许列表中
+func (l hostAllowList) allowed(host string) bool {
+	host = strings.ToLower(host)
+	for _, pattern := range l {
+		pattern = strings.ToLower(pattern)
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+			continue
+		}
+		if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// checkURL 校验附件地址：必须是 http(s) 绝对地址，且域名在允许列表中
+func (l hostAllowList) checkURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errors.BadRequest(v1.ErrorReason_INVALID_ATTACHMENT.String(), "invalid attachment url.")
+	}
+	if !l.allowed(u.Hostname()) {
+		return errors.BadRequest(v1.ErrorReason_ATTACHMENT_HOST_NOT_ALLOWED.String(), "attachment host not allowed.")
+	}
+	return nil
+}
+
+// validateAttachments 校验附件内容完整性及地址域名
+func (l hostAllowList) validateAttachments(attachments []*Attachment) error {
+	for _, a := range attachments {
+		switch a.Type {
+		case AttachmentImage:
+			if a.Width <= 0 || a.Height <= 0 {
+				return errors.BadRequest(v1.ErrorReason_INVALID_ATTACHMENT.String(), "image attachment requires width and height.")
+			}
+		case AttachmentLink:
+			if a.ThumbnailURL != "" {
+				if err := l.checkURL(a.ThumbnailURL); err != nil {
+					return err
+				}
+			}
+		case AttachmentSticker:
+			if a.StickerID == "" {
+				return errors.BadRequest(v1.ErrorReason_INVALID_ATTACHMENT.String(), "sticker attachment requires sticker id.")
+			}
+		default:
+			return errors.BadRequest(v1.ErrorReason_INVALID_ATTACHMENT.String(), "unknown attachment type.")
+		}
+		if err := l.checkURL(a.URL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertToAPIAttachments 将评论附件转换为 API 格式
+func convertToAPIAttachments(attachments []*Attachment) []*v1.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	apiAttachments := make([]*v1.Attachment, len(attachments))
+	for i, a := range attachments {
+		apiAttachments[i] = &v1.Attachment{
+			Type:         v1.Attachment_Type(a.Type),
+			Url:          a.URL,
+			Width:        a.Width,
+			Height:       a.Height,
+			Title:        a.Title,
+			Description:  a.Description,
+			ThumbnailUrl: a.ThumbnailURL,
+			StickerId:    a.StickerID,
+		}
+	}
+	return apiAttachments
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"context"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHostAllowList_Allowed(t *testing.T) {
+	l := hostAllowList{"img.example.com", "*.cdn.example.com"}
+	assert.True(t, l.allowed("img.example.com"))
+	assert.True(t, l.allowed("IMG.example.com"))
+	assert.True(t, l.allowed("a.cdn.example.com"))
+	assert.False(t, l.allowed("cdn.example.com"))
+	assert.False(t, l.allowed("evil-img.example.com"))
+	assert.False(t, l.allowed("img.example.com.evil.com"))
+	assert.False(t, hostAllowList(nil).allowed("img.example.com"))
+}
+
+func TestHostAllowList_ValidateAttachments(t *testing.T) {
+	l := hostAllowList{"img.example.com", "*.example.org"}
+	tests := []struct {
+		name       string
+		attachment *Attachment
+		wantReason string
+	}{
+		{name: "图片", attachment: &Attachment{Type: AttachmentImage, URL: "https://img.example.com/a.png", Width: 100, Height: 80}},
+		{name: "图片缺少宽高", attachment: &Attachment{Type: AttachmentImage, URL: "https://img.example.com/a.png"}, wantReason: v1.ErrorReason_INVALID_ATTACHMENT.String()},
+		{name: "链接预览", attachment: &Attachment{Type: AttachmentLink, URL: "https://www.example.org/post/1", Title: "post", ThumbnailURL: "https://img.example.com/t.png"}},
+		{name: "链接预览缩略图域名不允许", attachment: &Attachment{Type: AttachmentLink, URL: "https://www.example.org/post/1", ThumbnailURL: "https://evil.com/t.png"}, wantReason: v1.ErrorReason_ATTACHMENT_HOST_NOT_ALLOWED.String()},
+		{name: "表情贴纸", attachment: &Attachment{Type: AttachmentSticker, URL: "https://img.example.com/s/1.gif", StickerID: "s1"}},
+		{name: "表情贴纸缺少ID", attachment: &Attachment{Type: AttachmentSticker, URL: "https://img.example.com/s/1.gif"}, wantReason: v1.ErrorReason_INVALID_ATTACHMENT.String()},
+		{name: "域名不允许", attachment: &Attachment{Type: AttachmentImage, URL: "https://evil.com/a.png", Width: 1, Height: 1}, wantReason: v1.ErrorReason_ATTACHMENT_HOST_NOT_ALLOWED.String()},
+		{name: "非 http 地址", attachment: &Attachment{Type: AttachmentImage, URL: "javascript:alert(1)", Width: 1, Height: 1}, wantReason: v1.ErrorReason_INVALID_ATTACHMENT.String()},
+		{name: "未知类型", attachment: &Attachment{Type: 9, URL: "https://img.example.com/a.png"}, wantReason: v1.ErrorReason_INVALID_ATTACHMENT.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := l.validateAttachments([]*Attachment{tt.attachment})
+			if tt.wantReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantReason, kerrors.Reason(err))
+		})
+	}
+}
+
+func TestCommentUsecase_CreateComment_Attachments(t *testing.T) {
+	c := &conf.Data{Attachment: &conf.Data_Attachment{AllowedHosts: []string{"img.example.com"}}}
+	attachments := []*Attachment{{Type: AttachmentImage, URL: "https://img.example.com/a.png", Width: 100, Height: 80}}
+
+	t.Run("附件随评论保存并返回", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool { return len(c.Attachments) == 1 })).
+			Return(&Comment{ID: 1, Attachments: attachments}, nil).Once()
+
+		got, err := NewCommentUsecase(c, repo, nil, nil, nil, nil, nil, nil, nil).CreateComment(context.Background(), &Comment{UserID: "u1", Content: "hi", Attachments: attachments}, "")
+		assert.NoError(t, err)
+		assert.Len(t, got.Attachments, 1)
+		assert.Equal(t, int32(100), got.Attachments[0].Width)
+	})
+
+	t.Run("未配置允许的域名时拒绝附件", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).CreateComment(context.Background(), &Comment{UserID: "u1", Content: "hi", Attachments: attachments}, "")
+		assert.Equal(t, v1.ErrorReason_ATTACHMENT_HOST_NOT_ALLOWED.String(), kerrors.Reason(err))
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
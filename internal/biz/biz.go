@@ -1,17 +1,30 @@
 package biz
 
 import (
-	"container/heap"
 	"context"
 	"github.com/google/wire"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewCommentUsecase)
+var ProviderSet = wire.NewSet(NewCommentUsecase, NewEventDispatcher, NewWebhookUsecase, NewWebhookWorker, NewWatchHub, NewDuplicateDetector, NewModuleRegistry, NewSearchUsecase, NewBulkDeleteUsecase, NewExportUsecase, NewBulkDeleteWorker, NewCommentPathBackfiller, NewIDGenerator, NewCounterReconciler, NewCommentArchiver)
 
 // TxnManager 事务管理
 type TxnManager interface {
 	// Txn 开启一个事务执行 fn 函数
+	// 仓储方法使用 fn 收到的上下文时加入同一事务，fn 返回错误时整体回滚
 	Txn(ctx context.Context, fn func(ctx context.Context) error) error
-	heap.Interface
+}
+
+// primaryKey 上下文中要求读主库的标记
+type primaryKey struct{}
+
+// WithPrimary 标记上下文中的查询走主库，写入后紧接着的读取不受从库复制延迟影响
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsePrimary 上下文是否要求查询走主库
+func UsePrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
 }
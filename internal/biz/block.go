@@ -0,0 +1,92 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/pkg/log"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// UserBlock 用户拉黑关系，UserID 拉黑了 BlockedUserID
+type UserBlock struct {
+	// ID 记录唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// UserID 发起拉黑的用户
+	UserID string `gorm:"column:user_id;type:varchar(32);not null;index:uk_user_blocked,unique"`
+
+	// BlockedUserID 被拉黑的用户
+	BlockedUserID string `gorm:"column:blocked_user_id;type:varchar(32);not null;index:uk_user_blocked,unique;index:idx_blocked"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (b *UserBlock) TableName() string {
+	return "user_block"
+}
+
+// BlockRepo 用户拉黑关系仓储
+type BlockRepo interface {
+	// Block 拉黑用户，已拉黑时不做处理
+	Block(ctx context.Context, userID, blockedUserID string) error
+	// Unblock 取消拉黑
+	Unblock(ctx context.Context, userID, blockedUserID string) error
+	// IsBlocked 判断 userID 是否拉黑了 blockedUserID
+	IsBlocked(ctx context.Context, userID, blockedUserID string) (bool, error)
+	// ListBlockedUserIDs 获取用户拉黑的所有用户
+	ListBlockedUserIDs(ctx context.Context, userID string) ([]string, error)
+}
+
+// BlockUser 拉黑用户，拉黑后对方的评论和回复不再出现在该用户看到的评论列表中，对方也不能回复该用户的评论
+func (uc *CommentUsecase) BlockUser(ctx context.Context, userID, blockedUserID string) error {
+	log.Debug(ctx, "block user.", "user_id", userID, "blocked_user_id", blockedUserID)
+	if userID == blockedUserID {
+		return errors.BadRequest(v1.ErrorReason_BLOCK_SELF.String(), "cannot block yourself.")
+	}
+	if err := uc.blocks.Block(ctx, userID, blockedUserID); err != nil {
+		log.Error(ctx, "block user error.", "err", err)
+		return errors.BadRequest(err.Error(), "block user error.")
+	}
+	log.Info(ctx, "repo block user successful.")
+	return nil
+}
+
+// UnblockUser 取消拉黑
+func (uc *CommentUsecase) UnblockUser(ctx context.Context, userID, blockedUserID string) error {
+	log.Debug(ctx, "unblock user.", "user_id", userID, "blocked_user_id", blockedUserID)
+	if err := uc.blocks.Unblock(ctx, userID, blockedUserID); err != nil {
+		log.Error(ctx, "unblock user error.", "err", err)
+		return errors.BadRequest(err.Error(), "unblock user error.")
+	}
+	log.Info(ctx, "repo unblock user successful.")
+	return nil
+}
+
+// blockedUserIDs 获取查看者拉黑的用户，未指定查看者时返回 nil
+func (uc *CommentUsecase) blockedUserIDs(ctx context.Context, viewerID string) ([]string, error) {
+	if viewerID == "" || uc.blocks == nil {
+		return nil, nil
+	}
+	return uc.blocks.ListBlockedUserIDs(ctx, viewerID)
+}
+
+// checkReplyAllowed 回复评论时检查被回复评论的作者是否拉黑了当前用户
+func (uc *CommentUsecase) checkReplyAllowed(ctx context.Context, c *Comment, parent *Comment) error {
+	if parent == nil || uc.blocks == nil {
+		return nil
+	}
+
+	blocked, err := uc.blocks.IsBlocked(ctx, parent.UserID, c.UserID)
+	if err != nil {
+		log.Error(ctx, "check block error.", "err", err)
+		return errors.BadRequest(err.Error(), "check block error.")
+	}
+	if blocked {
+		log.Warn(ctx, "reply blocked by author.", "user_id", c.UserID, "parent_comment_id", c.ParentCommentID)
+		return errors.Forbidden(v1.ErrorReason_BLOCKED_BY_AUTHOR.String(), "blocked by the comment author.")
+	}
+	return nil
+}
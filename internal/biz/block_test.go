@@ -0,0 +1,100 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"context"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type BlockRepoMock struct {
+	mock.Mock
+}
+
+func (m *BlockRepoMock) Block(ctx context.Context, userID, blockedUserID string) error {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Error(0)
+}
+
+func (m *BlockRepoMock) Unblock(ctx context.Context, userID, blockedUserID string) error {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Error(0)
+}
+
+func (m *BlockRepoMock) IsBlocked(ctx context.Context, userID, blockedUserID string) (bool, error) {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *BlockRepoMock) ListBlockedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	args := m.Called(ctx, userID)
+	ids, _ := args.Get(0).([]string)
+	return ids, args.Error(1)
+}
+
+func TestCommentUsecase_BlockUser(t *testing.T) {
+	t.Run("不能拉黑自己", func(t *testing.T) {
+		blocks := new(BlockRepoMock)
+		err := NewCommentUsecase(nil, new(CommentRepoMock), nil, nil, blocks, nil, nil, nil, nil).BlockUser(context.Background(), "u1", "u1")
+		assert.Equal(t, v1.ErrorReason_BLOCK_SELF.String(), kerrors.Reason(err))
+		blocks.AssertNotCalled(t, "Block", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("拉黑成功", func(t *testing.T) {
+		blocks := new(BlockRepoMock)
+		blocks.On("Block", mock.Anything, "u1", "u2").Return(nil).Once()
+		err := NewCommentUsecase(nil, new(CommentRepoMock), nil, nil, blocks, nil, nil, nil, nil).BlockUser(context.Background(), "u1", "u2")
+		assert.NoError(t, err)
+		blocks.AssertExpectations(t)
+	})
+}
+
+func TestCommentUsecase_CreateComment_Blocked(t *testing.T) {
+	repo := new(CommentRepoMock)
+	blocks := new(BlockRepoMock)
+	repo.On("Get", mock.Anything, int64(1)).Return(&Comment{ID: 1, UserID: "author"}, nil).Once()
+	blocks.On("IsBlocked", mock.Anything, "author", "u2").Return(true, nil).Once()
+
+	_, err := NewCommentUsecase(nil, repo, nil, nil, blocks, nil, nil, nil, nil).CreateComment(context.Background(), &Comment{
+		UserID:          "u2",
+		ParentCommentID: 1,
+		Content:         "reply",
+	}, "")
+	assert.Equal(t, v1.ErrorReason_BLOCKED_BY_AUTHOR.String(), kerrors.Reason(err))
+	assert.Equal(t, 403, kerrors.Code(err))
+	repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestCommentUsecase_GetComments_ExcludeBlocked(t *testing.T) {
+	repo := new(CommentRepoMock)
+	blocks := new(BlockRepoMock)
+	blocked := []string{"spammer"}
+	blocks.On("ListBlockedUserIDs", mock.Anything, "viewer").Return(blocked, nil).Once()
+	repo.On("ListRootComments", mock.Anything, int32(1), "r1", int32(1), int32(10), int32(0), blocked).
+		Return([]*Comment{{ID: 1, UserID: "u1"}}, nil).Once()
+	repo.On("ListReplyComments", mock.Anything, int32(1), "r1", []int64{1}, int32(3), int32(0), blocked).
+		Return([]*Comment{{ID: 2, RootCommentID: 1, ParentCommentID: 1, UserID: "u2", Level: 1}}, nil).Once()
+
+	comments, err := NewCommentUsecase(nil, repo, nil, nil, blocks, nil, nil, nil, nil).GetComments(context.Background(), 1, "r1", 3, 1, 10, 0, "viewer")
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Len(t, comments[0].ReplyComments, 1)
+	repo.AssertExpectations(t)
+	blocks.AssertExpectations(t)
+}
+
+func TestBuildCommentTree_ExcludeBlocked(t *testing.T) {
+	roots := []*Comment{{ID: 1, UserID: "u1"}}
+	replies := []*Comment{
+		{ID: 2, RootCommentID: 1, ParentCommentID: 1, UserID: "spammer", Level: 1},
+		{ID: 3, RootCommentID: 1, ParentCommentID: 1, UserID: "u2", Level: 1},
+	}
+
+	uc := NewCommentUsecase(nil, new(CommentRepoMock), nil, nil, nil, nil, nil, nil, nil)
+	uc.buildCommentTree(roots, replies, 10, []string{"spammer"})
+	assert.Len(t, roots[0].ReplyComments, 1)
+	assert.Equal(t, int64(3), roots[0].ReplyComments[0].ID)
+}
@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 批量删除方式
+const (
+	BulkDeleteHard int32 = 0 // 物理删除评论及其回复、点赞、提及和附件
+	BulkDeleteSoft int32 = 1 // 软删除，评论标记为已删除并隐藏，点赞等记录保留
+)
+
+// 批量删除任务状态
+const (
+	BulkDeletePending   int32 = 0 // 等待执行
+	BulkDeleteRunning   int32 = 1 // 执行中
+	BulkDeleteSucceeded int32 = 2 // 执行完成
+	BulkDeleteFailed    int32 = 3 // 执行失败
+)
+
+// 批量删除默认配置
+const (
+	defaultBulkDeleteChunkSize    = 200
+	defaultBulkDeletePollInterval = time.Second
+	defaultBulkDeleteLease        = time.Minute
+)
+
+// BulkDeleteJob 批量删除任务，按用户或资源删除评论，由后台 worker 分批执行并记录进度
+type BulkDeleteJob struct {
+	// ID 任务唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// UserID 按用户删除时的用户ID，为空表示按资源删除
+	UserID string `gorm:"column:user_id;type:varchar(32);not null;default:''"`
+
+	// Module 按资源删除时的业务模块
+	Module int32 `gorm:"column:module;type:tinyint;not null;default:0"`
+
+	// ResourceID 按资源删除时的资源ID
+	ResourceID string `gorm:"column:resource_id;type:varchar(32);not null;default:''"`
+
+	// Mode 删除方式：物理删除、软删除
+	Mode int32 `gorm:"column:mode;type:tinyint;not null;default:0"`
+
+	// Status 任务状态
+	Status int32 `gorm:"column:status;type:tinyint;not null;default:0;index:idx_status_update,priority:1"`
+
+	// Total 创建任务时匹配的评论数
+	Total int64 `gorm:"column:total;type:int;not null;default:0"`
+
+	// Processed 已处理的匹配评论数
+	Processed int64 `gorm:"column:processed;type:int;not null;default:0"`
+
+	// Affected 实际删除的评论数，物理删除时包含其他用户在这些评论下的回复
+	Affected int64 `gorm:"column:affected;type:int;not null;default:0"`
+
+	// Error 失败原因
+	Error string `gorm:"column:error;type:varchar(255);not null;default:''"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+
+	// UpdateGmt 更新时间，执行中的任务每处理一批刷新一次，作为 worker 的心跳
+	UpdateGmt time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_status_update,priority:2"`
+}
+
+func (j *BulkDeleteJob) TableName() string {
+	return "comment_bulk_delete_job"
+}
+
+// BulkDeleteRepo 批量删除任务仓储
+type BulkDeleteRepo interface {
+	// CreateBulkDeleteJob 创建任务，并统计匹配的评论数
+	CreateBulkDeleteJob(ctx context.Context, job *BulkDeleteJob) (*BulkDeleteJob, error)
+	// GetBulkDeleteJob 获取任务
+	GetBulkDeleteJob(ctx context.Context, id int64) (*BulkDeleteJob, error)
+	// ClaimBulkDeleteJob 领取一个等待执行或心跳超过 lease 的任务并置为执行中，没有可领取的任务时返回 nil
+	ClaimBulkDeleteJob(ctx context.Context, lease time.Duration) (*BulkDeleteJob, error)
+	// DeleteChunk 在一个事务中删除一批匹配的评论并更新任务进度，返回本批处理的匹配评论数，为 0 表示已全部处理
+	DeleteChunk(ctx context.Context, job *BulkDeleteJob, chunkSize int) (int, error)
+	// FinishBulkDeleteJob 记录任务的最终状态
+	FinishBulkDeleteJob(ctx context.Context, job *BulkDeleteJob) error
+}
+
+// BulkDeleteUsecase 管理按用户或资源批量删除评论的任务
+type BulkDeleteUsecase struct {
+	repo BulkDeleteRepo
+}
+
+// NewBulkDeleteUsecase new a BulkDelete usecase.
+func NewBulkDeleteUsecase(repo BulkDeleteRepo) *BulkDeleteUsecase {
+	return &BulkDeleteUsecase{repo: repo}
+}
+
+// SubmitBulkDelete 创建批量删除任务，user_id 与 (module, resource_id) 必须且只能指定一个
+func (uc *BulkDeleteUsecase) SubmitBulkDelete(ctx context.Context, job *BulkDeleteJob) (*BulkDeleteJob, error) {
+	log.Debug(ctx, "submit bulk delete.", "user_id", job.UserID, "module", job.Module, "resource_id", job.ResourceID, "mode", job.Mode)
+
+	byUser := job.UserID != ""
+	byResource := job.Module > 0 || job.ResourceID != ""
+	if byUser == byResource {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "either user_id or module and resource_id is required.")
+	}
+	if byResource && (job.Module <= 0 || job.ResourceID == "") {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "module and resource_id are required together.")
+	}
+	if job.Mode != BulkDeleteHard && job.Mode != BulkDeleteSoft {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "invalid bulk delete mode.")
+	}
+
+	now := time.Now().UTC()
+	job.Status = BulkDeletePending
+	job.CreateGmt = now
+	job.UpdateGmt = now
+	created, err := uc.repo.CreateBulkDeleteJob(ctx, job)
+	if err != nil {
+		log.Error(ctx, "create bulk delete job error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "create bulk delete job error.")
+	}
+	log.Info(ctx, "repo create bulk delete job successful.", "id", created.ID, "total", created.Total)
+	return created, nil
+}
+
+// GetBulkDeleteJob 获取批量删除任务及其进度
+func (uc *BulkDeleteUsecase) GetBulkDeleteJob(ctx context.Context, id int64) (*BulkDeleteJob, error) {
+	job, err := uc.repo.GetBulkDeleteJob(ctx, id)
+	if err != nil {
+		log.Error(ctx, "get bulk delete job error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get bulk delete job error.")
+	}
+	return job, nil
+}
+
+// BulkDeleteWorker 轮询批量删除任务，每批一个事务执行，多实例部署时通过心跳租约避免重复执行
+type BulkDeleteWorker struct {
+	uc        *BulkDeleteUsecase
+	interval  time.Duration
+	chunkSize int
+	lease     time.Duration
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewBulkDeleteWorker new a BulkDeleteWorker.
+func NewBulkDeleteWorker(c *conf.Data, uc *BulkDeleteUsecase) *BulkDeleteWorker {
+	w := &BulkDeleteWorker{
+		uc:        uc,
+		interval:  defaultBulkDeletePollInterval,
+		chunkSize: defaultBulkDeleteChunkSize,
+		lease:     defaultBulkDeleteLease,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if bc := c.GetBulkDelete(); bc != nil {
+		if bc.ChunkSize > 0 {
+			w.chunkSize = int(bc.ChunkSize)
+		}
+		if bc.PollInterval != nil && bc.PollInterval.AsDuration() > 0 {
+			w.interval = bc.PollInterval.AsDuration()
+		}
+		if bc.Lease != nil && bc.Lease.AsDuration() > 0 {
+			w.lease = bc.Lease.AsDuration()
+		}
+	}
+	return w
+}
+
+// Start 启动任务轮询，阻塞直到 Stop 被调用
+func (w *BulkDeleteWorker) Start(ctx context.Context) error {
+	defer close(w.done)
+	log.Info(ctx, "bulk delete worker started.", "interval", w.interval, "chunk_size", w.chunkSize, "lease", w.lease)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// 领取到任务时立即尝试下一个，直到没有可执行的任务
+			for w.RunOnce(ctx) {
+			}
+		}
+	}
+}
+
+// Stop 停止任务轮询，执行中的任务在当前批次完成后中断，租约过期后由其他实例继续执行
+func (w *BulkDeleteWorker) Stop(ctx context.Context) error {
+	close(w.stop)
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+	}
+	log.Info(ctx, "bulk delete worker stopped.")
+	return nil
+}
+
+// RunOnce 领取并执行一个任务，返回是否领取到任务
+func (w *BulkDeleteWorker) RunOnce(ctx context.Context) bool {
+	job, err := w.uc.repo.ClaimBulkDeleteJob(ctx, w.lease)
+	if err != nil {
+		log.Error(ctx, "claim bulk delete job error.", "err", err)
+		return false
+	}
+	if job == nil {
+		return false
+	}
+	log.Info(ctx, "bulk delete job started.", "id", job.ID, "total", job.Total, "processed", job.Processed)
+
+	for {
+		select {
+		case <-w.stop:
+			return false
+		case <-ctx.Done():
+			return false
+		default:
+		}
+
+		n, err := w.uc.repo.DeleteChunk(ctx, job, w.chunkSize)
+		if err != nil {
+			log.Error(ctx, "bulk delete chunk error.", "id", job.ID, "err", err)
+			job.Status = BulkDeleteFailed
+			job.Error = err.Error()
+			if runes := []rune(job.Error); len(runes) > 255 {
+				job.Error = string(runes[:255])
+			}
+			break
+		}
+		if n == 0 {
+			job.Status = BulkDeleteSucceeded
+			break
+		}
+		log.Debug(ctx, "bulk delete chunk done.", "id", job.ID, "processed", job.Processed, "affected", job.Affected)
+	}
+
+	if err := w.uc.repo.FinishBulkDeleteJob(ctx, job); err != nil {
+		log.Error(ctx, "finish bulk delete job error.", "id", job.ID, "err", err)
+	}
+	log.Info(ctx, "bulk delete job finished.", "id", job.ID, "status", job.Status, "processed", job.Processed, "affected", job.Affected)
+	return true
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// BulkDeleteRepoMock 是BulkDeleteRepo接口的mock实现
+type BulkDeleteRepoMock struct {
+	mock.Mock
+}
+
+func (m *BulkDeleteRepoMock) CreateBulkDeleteJob(ctx context.Context, job *BulkDeleteJob) (*BulkDeleteJob, error) {
+	args := m.Called(ctx, job)
+	return args.Get(0).(*BulkDeleteJob), args.Error(1)
+}
+
+func (m *BulkDeleteRepoMock) GetBulkDeleteJob(ctx context.Context, id int64) (*BulkDeleteJob, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*BulkDeleteJob), args.Error(1)
+}
+
+func (m *BulkDeleteRepoMock) ClaimBulkDeleteJob(ctx context.Context, lease time.Duration) (*BulkDeleteJob, error) {
+	args := m.Called(ctx, lease)
+	job, _ := args.Get(0).(*BulkDeleteJob)
+	return job, args.Error(1)
+}
+
+func (m *BulkDeleteRepoMock) DeleteChunk(ctx context.Context, job *BulkDeleteJob, chunkSize int) (int, error) {
+	args := m.Called(ctx, job, chunkSize)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *BulkDeleteRepoMock) FinishBulkDeleteJob(ctx context.Context, job *BulkDeleteJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func TestBulkDeleteUsecase_SubmitBulkDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     *BulkDeleteJob
+		wantErr bool
+	}{
+		{name: "按用户删除", job: &BulkDeleteJob{UserID: "u1"}},
+		{name: "按资源软删除", job: &BulkDeleteJob{Module: 2, ResourceID: "v1", Mode: BulkDeleteSoft}},
+		{name: "未指定删除范围", job: &BulkDeleteJob{}, wantErr: true},
+		{name: "同时指定用户和资源", job: &BulkDeleteJob{UserID: "u1", Module: 2, ResourceID: "v1"}, wantErr: true},
+		{name: "资源缺少业务模块", job: &BulkDeleteJob{ResourceID: "v1"}, wantErr: true},
+		{name: "无效的删除方式", job: &BulkDeleteJob{UserID: "u1", Mode: 9}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(BulkDeleteRepoMock)
+			uc := NewBulkDeleteUsecase(repo)
+			if !tt.wantErr {
+				repo.On("CreateBulkDeleteJob", mock.Anything, tt.job).Return(tt.job, nil).Once()
+			}
+
+			job, err := uc.SubmitBulkDelete(context.Background(), tt.job)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, "INVALID_ARGUMENT", kerrors.Reason(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, BulkDeletePending, job.Status)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBulkDeleteWorker_RunOnce(t *testing.T) {
+	c := &conf.Data{BulkDelete: &conf.Data_BulkDelete{ChunkSize: 2}}
+
+	t.Run("没有待执行的任务", func(t *testing.T) {
+		repo := new(BulkDeleteRepoMock)
+		repo.On("ClaimBulkDeleteJob", mock.Anything, defaultBulkDeleteLease).Return(nil, nil).Once()
+
+		w := NewBulkDeleteWorker(c, NewBulkDeleteUsecase(repo))
+		assert.False(t, w.RunOnce(context.Background()))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("分批执行直到完成", func(t *testing.T) {
+		repo := new(BulkDeleteRepoMock)
+		job := &BulkDeleteJob{ID: 1, UserID: "u1", Status: BulkDeleteRunning, Total: 3}
+		repo.On("ClaimBulkDeleteJob", mock.Anything, defaultBulkDeleteLease).Return(job, nil).Once()
+		repo.On("DeleteChunk", mock.Anything, job, 2).Return(2, nil).Once()
+		repo.On("DeleteChunk", mock.Anything, job, 2).Return(1, nil).Once()
+		repo.On("DeleteChunk", mock.Anything, job, 2).Return(0, nil).Once()
+		repo.On("FinishBulkDeleteJob", mock.Anything, job).Return(nil).Once()
+
+		w := NewBulkDeleteWorker(c, NewBulkDeleteUsecase(repo))
+		assert.True(t, w.RunOnce(context.Background()))
+		assert.Equal(t, BulkDeleteSucceeded, job.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("批次失败时任务失败", func(t *testing.T) {
+		repo := new(BulkDeleteRepoMock)
+		job := &BulkDeleteJob{ID: 1, Module: 2, ResourceID: "v1", Status: BulkDeleteRunning}
+		repo.On("ClaimBulkDeleteJob", mock.Anything, defaultBulkDeleteLease).Return(job, nil).Once()
+		repo.On("DeleteChunk", mock.Anything, job, 2).Return(0, errors.New("deadlock found")).Once()
+		repo.On("FinishBulkDeleteJob", mock.Anything, job).Return(nil).Once()
+
+		w := NewBulkDeleteWorker(c, NewBulkDeleteUsecase(repo))
+		assert.True(t, w.RunOnce(context.Background()))
+		assert.Equal(t, BulkDeleteFailed, job.Status)
+		assert.Equal(t, "deadlock found", job.Error)
+		repo.AssertExpectations(t)
+	})
+}
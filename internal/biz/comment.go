@@ -2,6 +2,7 @@ package biz
 
 import (
 	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
 	"comment/pkg/log"
 	"context"
 	"github.com/go-kratos/kratos/v2/errors"
@@ -12,22 +13,26 @@ import (
 // Comment is a Comment model.
 type Comment struct {
 	// Module 业务模块表示，用于区分不同业务场景下的评论
-Module int32 `gorm:"column:module;type:tinyint;not null;comment:1:文章,2:视频"`
+Module int32 `gorm:"column:module;type:tinyint;not null;index:idx_module_resource,priority:1;comment:业务模块，取值见模块注册表"`
 
 	// ResourceID 资源唯一标识，表示被评论的资源ID
-	ResourceID string `gorm:"column:resource_id;type:varchar(32);not null"`
+	ResourceID string `gorm:"column:resource_id;type:varchar(32);not null;index:idx_module_resource,priority:2"`
 
 	// ID 评论唯一标识
-	ID int64 `gorm:"column:id;type:bigint;primaryKey;autoIncrement"`
+	ID int64 `gorm:"column:id;type:bigint;primaryKey;autoIncrement:false"`
 
 	// RootCommentID 根评论ID，用于标识评论所属的根评论
-	RootCommentID int64 `gorm:"column:root_id;type:varchar(32);not null;comment:根评论"`
+	RootCommentID int64 `gorm:"column:root_id;type:varchar(32);not null;index:idx_root_id;comment:根评论"`
 
 	// ParentCommentID 父评论ID，用于构建评论回复关系
-	ParentCommentID int64 `gorm:"column:parent_id;type:varchar(32);not null"`
+	ParentCommentID int64 `gorm:"column:parent_id;type:varchar(32);not null;index:idx_parent_id"`
+
+	// Path 物化路径，从根评论到自身的ID序列，如 /1/5/9/，删除时按前缀选取任意评论的整棵子树；为空表示尚未回填，此时按 parent_id 逐层选取
+	// MySQL 迁移中使用 ascii 字符集以缩短索引长度
+	Path string `gorm:"column:path;type:varchar(700);not null;default:'';index:idx_path"`
 
 	// UserID 用户唯一标识
-	UserID string `gorm:"column:user_id;type:varchar(32);not null"`
+	UserID string `gorm:"column:user_id;type:varchar(32);not null;index:idx_user_create,priority:1"`
 
 	// Username 用户名
 	Username string `gorm:"column:username;type:varchar(24);not null"`
@@ -36,31 +41,64 @@ Module int32 `gorm:"column:module;type:tinyint;not null;comment:1:文章,2:视
 	Avatar string `gorm:"column:avatar;type:varchar(255);not null;comment:头像 url"`
 
 	// Content 评论内容
+	// MySQL 上的 ft_content 全文索引只由 MySQL 迁移创建，其他数据库没有对应的索引类型
 	Content string `gorm:"column:content;type:text;not null"`
 
+	// ContentHTML 渲染后的安全 HTML，未开启渲染时为空
+	ContentHTML string `gorm:"column:content_html;type:text;not null"`
+
+	// ContentText 去除标记后的纯文本，未开启渲染时为空
+	ContentText string `gorm:"column:content_text;type:text;not null"`
+
 	// Level 层级
-	Level int32 `gorm:"column:level;type:int;not null;default:0"`
+	Level int32 `gorm:"column:level;type:int;not null;default:0;index:idx_module_resource,priority:3"`
 
 	// LikeCount 点赞数
-	LikeCount int64 `gorm:"column:like_count;type:int;not null;default:0"`
+	LikeCount int64 `gorm:"column:like_count;type:int;not null;default:0;index:idx_module_resource,priority:4"`
 
 	// ReplyCount 回复数
 	ReplyCount int64 `gorm:"column:reply_count;type:int;not null;default:0"`
 
 	// CreateGmt 创建时间
-	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_user_create,priority:2"`
 
 	// UpdateGmt 更新时间
 	UpdateGmt time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP;updateAt"`
 
 	// ReplyComments 回复评论列表（内存中构建，不存储在数据库中）
 	ReplyComments []*Comment `gorm:"-"`
+
+	// Mentions 评论内容中的 @ 提及，存储在 comment_mention 表
+	Mentions []*Mention `gorm:"foreignKey:CommentID"`
+
+	// Attachments 评论附件（图片、链接预览、表情贴纸），存储在 comment_attachment 表
+	Attachments []*Attachment `gorm:"foreignKey:CommentID"`
+
+	// Flagged 是否被标记为疑似重复内容
+	Flagged bool `gorm:"column:flagged;type:tinyint(1);not null;default:0"`
+
+	// Hidden 是否因举报成立或批量软删除被隐藏，隐藏的评论不出现在评论列表中；与审核状态相互独立
+	Hidden bool `gorm:"column:hidden;type:tinyint(1);not null;default:0"`
+
+	// ModerationStatus 审核状态，取值见 ModerationApproved 等，未审核通过的评论不出现在评论列表和实时推送中
+	ModerationStatus int32 `gorm:"column:moderation_status;type:tinyint;not null;default:0"`
+
+	// Deleted 是否被管理员批量软删除，软删除的评论同时隐藏，不计入父评论的回复数
+	Deleted bool `gorm:"column:deleted;type:tinyint(1);not null;default:0"`
+
+	// Relevance 全文搜索的相关度，仅按相关度排序的搜索结果有值，不存储
+	Relevance float64 `gorm:"column:relevance;->;-:migration"`
 }
 
 func (c *Comment) TableName() string {
 	return "comment"
 }
 
+// Visible 评论是否对普通用户可见：未被隐藏且已审核通过
+func (c *Comment) Visible() bool {
+	return !c.Hidden && c.ModerationStatus == ModerationApproved
+}
+
 // CommentRepo is a Comment repo.
 type CommentRepo interface {
 	// Save saves a Comment.
@@ -69,62 +107,367 @@ type CommentRepo interface {
 	Get(context.Context, int64) (*Comment, error)
 	// Delete deletes a Comment by ID.
 	Delete(context.Context, int64) error
-	// DeleteBatch deletes Comments by root ID or ID.
+	// DeleteBatch deletes a Comment and its whole subtree.
 	DeleteBatch(context.Context, int64) error
-	// ListRootComments 获取根评论列表
-	ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize int32, sortType int32) ([]*Comment, error)
-	// ListReplyComments 获取回复评论列表
-	ListReplyComments(ctx context.Context, rootIDs []int64, replyLimit int32, sortType int32) ([]*Comment, error)
+	// ListRootComments 获取根评论列表，excludeUserIDs 中用户发表的评论不返回
+	ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize int32, sortType int32, excludeUserIDs []string) ([]*Comment, error)
+	// ListReplyComments 获取资源下指定根评论的回复列表，excludeUserIDs 中用户发表的回复不返回
+	ListReplyComments(ctx context.Context, module int32, resourceID string, rootIDs []int64, replyLimit int32, sortType int32, excludeUserIDs []string) ([]*Comment, error)
 	// LikeComment 点赞评论
 	LikeComment(ctx context.Context, commentID int64, userID string) (int64, error)
 	// UnlikeComment 取消点赞评论
 	UnlikeComment(ctx context.Context, commentID int64, userID string) (int64, error)
+	// ListMentions 获取提及指定用户的评论列表
+	ListMentions(ctx context.Context, userID string, page, pageSize int32) ([]*Comment, error)
+	// ListUserComments 按创建时间和ID游标获取用户发表的评论，不含被隐藏或未审核通过的评论
+	ListUserComments(ctx context.Context, q *UserCommentQuery) ([]*Comment, error)
+	// ListByIDs 批量获取评论，不存在的评论不返回
+	ListByIDs(ctx context.Context, ids []int64) ([]*Comment, error)
+	// ReportComment 记录举报，待处理举报数达到 hideThreshold 时隐藏评论；重复举报返回 ErrAlreadyReported
+	ReportComment(ctx context.Context, report *Report, hideThreshold int64) (reportCount int64, hidden bool, err error)
+	// ListReportedComments 按评论聚合查询举报，每条评论附带最近 recentLimit 条举报
+	ListReportedComments(ctx context.Context, filter *ReportFilter, recentLimit int) ([]*ReportedComment, error)
+	// ResolveReports 将评论的待处理举报更新为 status，并设置评论的隐藏状态，返回处理的举报数；
+	// 取消隐藏时只恢复举报造成的隐藏，软删除或已有成立举报的评论保持隐藏
+	ResolveReports(ctx context.Context, commentID int64, status int32, hidden bool) (int64, error)
+	// ModerateComment 设置评论的审核状态，审核通过时写入 CommentApproved 事件
+	ModerateComment(ctx context.Context, commentID int64, status int32) (*Comment, error)
 }
 
 // CommentUsecase is a Comment usecase.
 type CommentUsecase struct {
-	repo CommentRepo
+	repo                CommentRepo
+	idem                IdempotencyRepo
+	idempotencyTTL      time.Duration
+	idempotencyTimeout  time.Duration
+	duplicate           *DuplicateDetector
+	reportHideThreshold int64
+	blocks              BlockRepo
+	settings            SettingRepo
+	modules             *ModuleRegistry
+	attachmentHosts     hostAllowList
+	renderer            ContentRenderer
+	txn                 TxnManager
+	ids                 IDGenerator
 }
 
 // NewCommentUsecase new a Comment usecase.
-func NewCommentUsecase(repo CommentRepo) *CommentUsecase {
-	return &CommentUsecase{repo: repo}
+func NewCommentUsecase(c *conf.Data, repo CommentRepo, idem IdempotencyRepo, duplicate *DuplicateDetector, blocks BlockRepo, settings SettingRepo, modules *ModuleRegistry, txn TxnManager, ids IDGenerator) *CommentUsecase {
+	uc := &CommentUsecase{
+		repo:                repo,
+		idem:                idem,
+		idempotencyTTL:      defaultIdempotencyTTL,
+		idempotencyTimeout:  defaultIdempotencyReservationTimeout,
+		duplicate:           duplicate,
+		reportHideThreshold: defaultReportHideThreshold,
+		blocks:              blocks,
+		settings:            settings,
+		modules:             modules,
+		txn:                 txn,
+		ids:                 ids,
+	}
+	if ic := c.GetIdempotency(); ic != nil && ic.Ttl != nil && ic.Ttl.AsDuration() > 0 {
+		uc.idempotencyTTL = ic.Ttl.AsDuration()
+	}
+	if ic := c.GetIdempotency(); ic != nil && ic.ReservationTimeout != nil && ic.ReservationTimeout.AsDuration() > 0 {
+		uc.idempotencyTimeout = ic.ReservationTimeout.AsDuration()
+	}
+	if rc := c.GetReport(); rc != nil && rc.HideThreshold > 0 {
+		uc.reportHideThreshold = int64(rc.HideThreshold)
+	}
+	uc.attachmentHosts = c.GetAttachment().GetAllowedHosts()
+	if c.GetMarkdown().GetEnabled() {
+		uc.renderer = markdownRenderer{}
+	}
+	return uc
 }
 
 // CreateComment creates a Comment, and returns the new Comment.
-func (uc *CommentUsecase) CreateComment(ctx context.Context, c *Comment) (*v1.Comment, error) {
+// idempotencyKey 非空时，同一用户使用相同幂等键和相同内容重试将返回首次创建的评论
+func (uc *CommentUsecase) CreateComment(ctx context.Context, c *Comment, idempotencyKey string) (*v1.Comment, error) {
+	// 写入流程中的查询走主库，避免读到从库上尚未复制的父评论或幂等记录
+	ctx = WithPrimary(ctx)
+	// 写入前分配评论ID
+	if err := uc.assignID(ctx, c); err != nil {
+		return nil, err
+	}
+	if idempotencyKey == "" || uc.idem == nil {
+		return uc.createComment(ctx, c)
+	}
+	log.Debug(ctx, "create comment with idempotency key.", "user_id", c.UserID, "idempotency_key", idempotencyKey)
+
+	// 占用幂等键，同时记录预先分配的评论ID，评论写入后即完成，无需再次更新幂等键
+	record := &Idempotency{
+		UserID:      c.UserID,
+		Key:         idempotencyKey,
+		Fingerprint: commentFingerprint(c),
+		CommentID:   c.ID,
+		ExpireGmt:   time.Now().Add(uc.idempotencyTTL).UTC(),
+		CreateGmt:   time.Now().UTC(),
+	}
+	reserved, err := uc.idem.ReserveIdempotency(ctx, record)
+	if err != nil {
+		log.Error(ctx, "reserve idempotency key error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "reserve idempotency key error.")
+	}
+	if !reserved {
+		return uc.replayComment(ctx, c, record)
+	}
+	return uc.createReserved(ctx, c, record)
+}
+
+// createReserved 在占用幂等键后创建评论，创建失败时释放幂等键以便客户端重试
+func (uc *CommentUsecase) createReserved(ctx context.Context, c *Comment, record *Idempotency) (*v1.Comment, error) {
+	comment, err := uc.createComment(ctx, c)
+	if err != nil {
+		if err := uc.idem.ReleaseIdempotency(ctx, record.UserID, record.Key, record.CommentID); err != nil {
+			log.Error(ctx, "release idempotency key error.", "err", err)
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// replayComment 处理幂等键已被占用的请求：内容一致时返回首次创建的评论
+func (uc *CommentUsecase) replayComment(ctx context.Context, c *Comment, record *Idempotency) (*v1.Comment, error) {
+	existing, err := uc.idem.GetIdempotency(ctx, record.UserID, record.Key)
+	if err != nil {
+		log.Error(ctx, "get idempotency key error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get idempotency key error.")
+	}
+	if existing == nil {
+		// 占用失败后记录恰好过期，按处理中返回，由客户端重试
+		return nil, errors.Conflict(v1.ErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS.String(), "idempotency key is in progress.")
+	}
+	if existing.Fingerprint != record.Fingerprint {
+		log.Warn(ctx, "idempotency key reused with different payload.", "user_id", record.UserID, "idempotency_key", record.Key)
+		return nil, errors.Conflict(v1.ErrorReason_IDEMPOTENCY_KEY_CONFLICT.String(), "idempotency key reused with different payload.")
+	}
+	if existing.CommentID == 0 {
+		return uc.takeOverReservation(ctx, c, record, existing)
+	}
+
+	// 与 Get 一样预加载提及和附件，重试返回的评论与首次响应一致
+	comment, err := uc.repo.Get(ctx, existing.CommentID)
+	if err != nil {
+		// 预先分配的评论尚未写入时，首次请求仍在处理中
+		if comments, listErr := uc.repo.ListByIDs(ctx, []int64{existing.CommentID}); listErr == nil && len(comments) == 0 {
+			return uc.takeOverReservation(ctx, c, record, existing)
+		}
+		log.Error(ctx, "get idempotent comment error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get idempotent comment error.")
+	}
+	log.Info(ctx, "replay idempotent comment.", "comment_id", comment.ID)
+	return convertToAPIComment(comment), nil
+}
+
+// takeOverReservation 处理评论尚未写入的幂等键：占用超过 idempotencyTimeout 时视为首次请求已中断（进程崩溃或释放失败），
+// 由当前请求接管幂等键并重新创建评论；未超时或已被其他请求接管时按处理中返回
+func (uc *CommentUsecase) takeOverReservation(ctx context.Context, c *Comment, record, existing *Idempotency) (*v1.Comment, error) {
+	taken, err := uc.idem.TakeOverIdempotency(ctx, record, existing.CommentID, time.Now().Add(-uc.idempotencyTimeout).UTC())
+	if err != nil {
+		log.Error(ctx, "take over idempotency key error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "take over idempotency key error.")
+	}
+	if !taken {
+		return nil, errors.Conflict(v1.ErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS.String(), "idempotency key is in progress.")
+	}
+	log.Warn(ctx, "take over stale idempotency key.", "user_id", record.UserID, "idempotency_key", record.Key, "stale_comment_id", existing.CommentID)
+	return uc.createReserved(ctx, c, record)
+}
+
+// assignID 在写入前为评论分配包含资源分桶的ID，不依赖数据库自增，各分片表的ID全局唯一；已有ID或未注入ID生成器时不分配
+func (uc *CommentUsecase) assignID(ctx context.Context, c *Comment) error {
+	if c.ID > 0 || uc.ids == nil {
+		return nil
+	}
+	id, err := uc.ids.NextID(ResourceBucket(c.Module, c.ResourceID))
+	if err != nil {
+		log.Error(ctx, "generate comment id error.", "err", err)
+		return errors.BadRequest(err.Error(), "generate comment id error.")
+	}
+	c.ID = id
+	return nil
+}
+
+// createComment 创建评论
+func (uc *CommentUsecase) createComment(ctx context.Context, c *Comment) (*v1.Comment, error) {
 	log.Debug(ctx, "create comment.", "user_id", c.UserID, "content", c.Content)
-	// 落库
-	comment, err := uc.repo.Save(ctx, c)
+	// 回复评论时获取被回复的评论
+	parent, err := uc.parentComment(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	// 被回复评论的作者拉黑了当前用户时不允许回复
+	if err := uc.checkReplyAllowed(ctx, c, parent); err != nil {
+		return nil, err
+	}
+
+	// 应用业务模块规则
+	if err := uc.applyModuleRules(ctx, c, parent); err != nil {
+		return nil, err
+	}
+
+	// 应用资源评论设置
+	if err := uc.applyResourceSetting(ctx, c, parent); err != nil {
+		return nil, err
+	}
+
+	// 校验附件，附件随评论一起落库
+	if err := uc.attachmentHosts.validateAttachments(c.Attachments); err != nil {
+		log.Warn(ctx, "invalid attachments.", "user_id", c.UserID, "err", err)
+		return nil, err
+	}
+
+	// 重复内容检测
+	fp, err := uc.detectDuplicate(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	// 渲染评论内容
+	if uc.renderer != nil {
+		c.ContentHTML, c.ContentText = uc.renderer.Render(c.Content)
+	}
+
+	// 解析 @ 提及，随评论一起落库
+	c.Mentions = parseMentions(c.Content)
+
+	// 落库，评论、回复数和领域事件由 Save 在同一事务中写入
+	var comment *Comment
+	err = uc.transaction(ctx, func(ctx context.Context) error {
+		saved, err := uc.repo.Save(ctx, c)
+		if err != nil {
+			log.Error(ctx, "create comment error.", "err", err)
+			return errors.BadRequest(err.Error(), "create comment error.")
+		}
+		comment = saved
+		return nil
+	})
 	if err != nil {
-		log.Error(ctx, "create comment error.", "err", err)
-		return nil, errors.BadRequest(err.Error(), "create comment error.")
+		return nil, err
 	}
 	log.Info(ctx, "repo save successful.")
 
+	// 记录内容指纹，供后续评论检测
+	if fp != nil {
+		uc.duplicate.Remember(ctx, comment, fp)
+	}
+
 	// 返回参数
+	return convertToAPIComment(comment), nil
+}
+
+// transaction 在事务中执行 fn，未注入事务管理器时直接执行
+func (uc *CommentUsecase) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if uc.txn == nil {
+		return fn(ctx)
+	}
+	return uc.txn.Txn(ctx, fn)
+}
+
+// parentComment 获取被回复的评论，不是回复评论或无需检查被回复评论时返回 nil
+func (uc *CommentUsecase) parentComment(ctx context.Context, c *Comment) (*Comment, error) {
+	if c.ParentCommentID <= 0 || (uc.blocks == nil && uc.settings == nil && uc.modules == nil) {
+		return nil, nil
+	}
+	parent, err := uc.repo.Get(ctx, c.ParentCommentID)
+	if err != nil {
+		log.Error(ctx, "get parent comment error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get parent comment error.")
+	}
+	return parent, nil
+}
+
+// detectDuplicate 按模块策略检测评论是否与该用户近期评论重复或近似：
+// reject 策略直接拒绝，flag 策略标记后放行。返回需要记录的内容指纹，无需记录时为 nil
+func (uc *CommentUsecase) detectDuplicate(ctx context.Context, c *Comment) (*ContentFingerprint, error) {
+	if uc.duplicate == nil {
+		return nil, nil
+	}
+	policy := uc.duplicate.Policy(c.Module)
+	if policy == DuplicatePolicyOff {
+		return nil, nil
+	}
+	fp := uc.duplicate.Fingerprint(c)
+	if fp == nil {
+		return nil, nil
+	}
+
+	hit, err := uc.duplicate.Detect(ctx, c, fp)
+	if err != nil {
+		// 指纹存储不可用时放行，不影响正常发布
+		log.Error(ctx, "detect duplicate comment error.", "err", err)
+		return fp, nil
+	}
+	if hit == nil {
+		return fp, nil
+	}
+
+	log.Warn(ctx, "duplicate comment detected.", "user_id", c.UserID, "module", c.Module, "resource_id", c.ResourceID, "similar_comment_id", hit.CommentID, "policy", policy)
+	if policy == DuplicatePolicyReject {
+		return nil, errors.BadRequest(v1.ErrorReason_DUPLICATE_COMMENT.String(), "duplicate comment.")
+	}
+	c.Flagged = true
+	return fp, nil
+}
+
+// convertToAPIComment 将新创建的评论转换为 API 响应
+func convertToAPIComment(comment *Comment) *v1.Comment {
 	return &v1.Comment{
-		Module:        comment.Module,
-		ResourceId:    comment.ResourceID,
-		CommentId:     comment.ID,
-		UserId:        comment.UserID,
-		Username:      comment.Username,
-		Avatar:        comment.Avatar,
-		Content:       comment.Content,
-		Level:         comment.Level,
-		LikeCount:     comment.LikeCount,
-		ReplyCount:    comment.ReplyCount,
-		ReplyComments: nil,
-		CreateTime:    timestamppb.New(comment.CreateGmt),
-	}, nil
+		Module:           comment.Module,
+		ResourceId:       comment.ResourceID,
+		CommentId:        comment.ID,
+		UserId:           comment.UserID,
+		Username:         comment.Username,
+		Avatar:           comment.Avatar,
+		Content:          comment.Content,
+		ContentHtml:      comment.ContentHTML,
+		ContentText:      comment.ContentText,
+		Level:            comment.Level,
+		LikeCount:        comment.LikeCount,
+		ReplyCount:       comment.ReplyCount,
+		ReplyComments:    nil,
+		CreateTime:       timestamppb.New(comment.CreateGmt),
+		Mentions:         convertToAPIMentions(comment.Mentions),
+		Attachments:      convertToAPIAttachments(comment.Attachments),
+		Flagged:          comment.Flagged,
+		Hidden:           comment.Hidden,
+		ModerationStatus: v1.Comment_ModerationStatus(comment.ModerationStatus),
+	}
 }
 
 // GetComments gets comments by module and resource id.
-func (uc *CommentUsecase) GetComments(ctx context.Context, module int32, resourceID string, replyLimit, page, pageSize int32, sortType int32) ([]*Comment, error) {
-	log.Debug(ctx, "get comments.", "module", module, "resource_id", resourceID, "reply_limit", replyLimit, "page", page, "page_size", pageSize, "sort_type", sortType)
+// viewerID 非空时，不返回查看者拉黑的用户发表的评论和回复
+func (uc *CommentUsecase) GetComments(ctx context.Context, module int32, resourceID string, replyLimit, page, pageSize int32, sortType int32, viewerID string) ([]*Comment, error) {
+	log.Debug(ctx, "get comments.", "module", module, "resource_id", resourceID, "reply_limit", replyLimit, "page", page, "page_size", pageSize, "sort_type", sortType, "viewer_id", viewerID)
+
+	// 校验业务模块，未指定排序时使用模块的默认排序
+	sortType, err := uc.resolveSortType(module, sortType)
+	if err != nil {
+		return nil, err
+	}
+
+	// 资源已关闭评论时不展示评论
+	setting, err := uc.resourceSetting(ctx, module, resourceID)
+	if err != nil {
+		log.Error(ctx, "get resource setting error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get resource setting error.")
+	}
+	if setting.Status == ResourceCommentClosed {
+		return nil, errors.Forbidden(v1.ErrorReason_COMMENTS_CLOSED.String(), "comments are closed.")
+	}
+
+	// 获取查看者拉黑的用户
+	blockedUserIDs, err := uc.blockedUserIDs(ctx, viewerID)
+	if err != nil {
+		log.Error(ctx, "get blocked users error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get blocked users error.")
+	}
 
 	// 获取根评论
-	comments, err := uc.repo.ListRootComments(ctx, module, resourceID, page, pageSize, sortType)
+	comments, err := uc.repo.ListRootComments(ctx, module, resourceID, page, pageSize, sortType, blockedUserIDs)
 	if err != nil {
 		log.Error(ctx, "get root comments error.", "err", err)
 		return nil, errors.BadRequest(err.Error(), "get root comments error.")
@@ -139,14 +482,14 @@ func (uc *CommentUsecase) GetComments(ctx context.Context, module int32, resourc
 		}
 
 		// 获取所有回复评论，按照replyLimit限制每个根评论的回复数
-		replyComments, err := uc.repo.ListReplyComments(ctx, rootIDs, replyLimit, sortType)
+		replyComments, err := uc.repo.ListReplyComments(ctx, module, resourceID, rootIDs, replyLimit, sortType, blockedUserIDs)
 		if err != nil {
 			log.Error(ctx, "get reply comments error.", "err", err)
 			return nil, errors.BadRequest(err.Error(), "get reply comments error.")
 		}
 
 		// 构建评论树
-		uc.buildCommentTree(comments, replyComments, replyLimit)
+		uc.buildCommentTree(comments, replyComments, replyLimit, blockedUserIDs)
 	}
 
 	log.Info(ctx, "repo get comments successful.")
@@ -155,15 +498,24 @@ func (uc *CommentUsecase) GetComments(ctx context.Context, module int32, resourc
 
 // buildCommentTree 构建评论树
 // replyLimit 限制每个根评论下的直接回复个数
-func (uc *CommentUsecase) buildCommentTree(rootComments []*Comment, replyComments []*Comment, replyLimit int32) {
+// blockedUserIDs 中用户发表的回复不挂到树上，其下的回复也随之不再展示
+func (uc *CommentUsecase) buildCommentTree(rootComments []*Comment, replyComments []*Comment, replyLimit int32, blockedUserIDs []string) {
+	blocked := make(map[string]struct{}, len(blockedUserIDs))
+	for _, userID := range blockedUserIDs {
+		blocked[userID] = struct{}{}
+	}
+
 	// 创建一个map用于快速查找评论
 	commentMap := make(map[int64]*Comment)
 	for _, comment := range rootComments {
 		commentMap[comment.ID] = comment
 	}
 
-	// 将回复评论也加入map
+	// 将回复评论也加入map，跳过被拉黑用户的回复
 	for _, comment := range replyComments {
+		if _, ok := blocked[comment.UserID]; ok {
+			continue
+		}
 		commentMap[comment.ID] = comment
 	}
 
@@ -177,6 +529,10 @@ func (uc *CommentUsecase) buildCommentTree(rootComments []*Comment, replyComment
 
 	// 将回复评论挂到对应的父评论下
 	for _, reply := range replyComments {
+		// 被拉黑用户的回复不挂到树上
+		if _, ok := blocked[reply.UserID]; ok {
+			continue
+		}
 		// 查找父评论
 		if parent, exists := commentMap[reply.ParentCommentID]; exists {
 			// 检查是否超过回复数量限制
@@ -191,6 +547,7 @@ func (uc *CommentUsecase) buildCommentTree(rootComments []*Comment, replyComment
 // DeleteComment deletes a Comment by ID.
 func (uc *CommentUsecase) DeleteComment(ctx context.Context, id int64) error {
 	log.Debug(ctx, "delete comment.", "id", id)
+	ctx = WithPrimary(ctx)
 
 	// 首先获取要删除的评论
 	comment, err := uc.repo.Get(ctx, id)
@@ -199,8 +556,7 @@ func (uc *CommentUsecase) DeleteComment(ctx context.Context, id int64) error {
 		return errors.BadRequest(err.Error(), "get comment error.")
 	}
 
-	// 删除根评论ID为该评论ID的所有回复评论，或者ID为该评论ID的评论
-	// 这将删除该评论及其所有回复和回复的回复
+	// 删除该评论及其整棵子树，包括回复和回复的回复
 	err = uc.deleteCommentAndReplies(ctx, comment)
 	if err != nil {
 		log.Error(ctx, "delete comment and replies error.", "err", err)
@@ -213,9 +569,8 @@ func (uc *CommentUsecase) DeleteComment(ctx context.Context, id int64) error {
 
 // deleteCommentAndReplies 删除评论及其所有回复
 func (uc *CommentUsecase) deleteCommentAndReplies(ctx context.Context, comment *Comment) error {
-	// 删除所有根评论ID为当前评论ID的评论（即该评论的所有直接回复和间接回复）
-	// 或者ID为当前评论ID的评论（即当前评论本身）
-	// 这样可以一次性删除整个评论树
+	// 子树由仓储按物化路径前缀选取，路径尚未回填的回复按 parent_id 逐层补充，
+	// 删除任意层级的评论时不会误删同一根评论下的其他分支
 	err := uc.repo.DeleteBatch(ctx, comment.ID)
 	return err
 }
@@ -223,6 +578,10 @@ func (uc *CommentUsecase) deleteCommentAndReplies(ctx context.Context, comment *
 // LikeComment 点赞评论
 func (uc *CommentUsecase) LikeComment(ctx context.Context, commentID int64, userID string) (int64, error) {
 	log.Debug(ctx, "like comment.", "comment_id", commentID, "user_id", userID)
+	ctx = WithPrimary(ctx)
+	if err := uc.checkLikeAllowed(ctx, commentID); err != nil {
+		return 0, err
+	}
 	// 调用repo层进行点赞操作
 	likeCount, err := uc.repo.LikeComment(ctx, commentID, userID)
 	if err != nil {
@@ -233,6 +592,33 @@ func (uc *CommentUsecase) LikeComment(ctx context.Context, commentID int64, user
 	return likeCount, nil
 }
 
+// checkLikeAllowed 检查评论是否允许点赞：模块需允许点赞，资源不能关闭评论或只读
+func (uc *CommentUsecase) checkLikeAllowed(ctx context.Context, commentID int64) error {
+	if uc.settings == nil && uc.modules == nil {
+		return nil
+	}
+	comment, err := uc.repo.Get(ctx, commentID)
+	if err != nil {
+		log.Error(ctx, "get comment error.", "err", err)
+		return errors.BadRequest(err.Error(), "get comment error.")
+	}
+	if uc.modules != nil {
+		m, err := uc.modules.Get(comment.Module)
+		if err != nil {
+			return err
+		}
+		if !m.AllowReaction(ReactionLike) {
+			return errors.Forbidden(v1.ErrorReason_REACTION_NOT_ALLOWED.String(), "reaction not allowed.")
+		}
+	}
+	setting, err := uc.resourceSetting(ctx, comment.Module, comment.ResourceID)
+	if err != nil {
+		log.Error(ctx, "get resource setting error.", "err", err)
+		return errors.BadRequest(err.Error(), "get resource setting error.")
+	}
+	return uc.checkWritable(ctx, setting)
+}
+
 // UnlikeComment 取消点赞评论
 func (uc *CommentUsecase) UnlikeComment(ctx context.Context, commentID int64, userID string) (int64, error) {
 	log.Debug(ctx, "unlike comment.", "comment_id", commentID, "user_id", userID)
@@ -245,3 +631,15 @@ func (uc *CommentUsecase) UnlikeComment(ctx context.Context, commentID int64, us
 	log.Info(ctx, "repo unlike successful.")
 	return likeCount, nil
 }
+
+// ListMentions 获取提及指定用户的评论
+func (uc *CommentUsecase) ListMentions(ctx context.Context, userID string, page, pageSize int32) ([]*Comment, error) {
+	log.Debug(ctx, "list mentions.", "user_id", userID, "page", page, "page_size", pageSize)
+	comments, err := uc.repo.ListMentions(ctx, userID, page, pageSize)
+	if err != nil {
+		log.Error(ctx, "list mentions error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "list mentions error.")
+	}
+	log.Info(ctx, "repo list mentions successful.")
+	return comments, nil
+}
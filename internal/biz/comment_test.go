@@ -36,13 +36,13 @@ func (m *CommentRepoMock) DeleteBatch(ctx context.Context, rootID int64) error {
 	return args.Error(0)
 }
 
-func (m *CommentRepoMock) ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize int32, sortType int32) ([]*Comment, error) {
-	args := m.Called(ctx, module, resourceID, page, pageSize, sortType)
+func (m *CommentRepoMock) ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize int32, sortType int32, excludeUserIDs []string) ([]*Comment, error) {
+	args := m.Called(ctx, module, resourceID, page, pageSize, sortType, excludeUserIDs)
 	return args.Get(0).([]*Comment), args.Error(1)
 }
 
-func (m *CommentRepoMock) ListReplyComments(ctx context.Context, rootIDs []int64, replyLimit int32, sortType int32) ([]*Comment, error) {
-	args := m.Called(ctx, rootIDs, replyLimit, sortType)
+func (m *CommentRepoMock) ListReplyComments(ctx context.Context, module int32, resourceID string, rootIDs []int64, replyLimit int32, sortType int32, excludeUserIDs []string) ([]*Comment, error) {
+	args := m.Called(ctx, module, resourceID, rootIDs, replyLimit, sortType, excludeUserIDs)
 	return args.Get(0).([]*Comment), args.Error(1)
 }
 
@@ -56,6 +56,44 @@ func (m *CommentRepoMock) UnlikeComment(ctx context.Context, commentID int64, us
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *CommentRepoMock) ListMentions(ctx context.Context, userID string, page, pageSize int32) ([]*Comment, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func (m *CommentRepoMock) ListUserComments(ctx context.Context, q *UserCommentQuery) ([]*Comment, error) {
+	args := m.Called(ctx, q)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func (m *CommentRepoMock) ListByIDs(ctx context.Context, ids []int64) ([]*Comment, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func (m *CommentRepoMock) ReportComment(ctx context.Context, report *Report, hideThreshold int64) (int64, bool, error) {
+	args := m.Called(ctx, report, hideThreshold)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *CommentRepoMock) ListReportedComments(ctx context.Context, filter *ReportFilter, recentLimit int) ([]*ReportedComment, error) {
+	args := m.Called(ctx, filter, recentLimit)
+	return args.Get(0).([]*ReportedComment), args.Error(1)
+}
+
+func (m *CommentRepoMock) ResolveReports(ctx context.Context, commentID int64, status int32, hidden bool) (int64, error) {
+	args := m.Called(ctx, commentID, status, hidden)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *CommentRepoMock) ModerateComment(ctx context.Context, commentID int64, status int32) (*Comment, error) {
+	args := m.Called(ctx, commentID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Comment), args.Error(1)
+}
+
 // CommentTestSuite 是测试套件
 type CommentTestSuite struct {
 	suite.Suite
@@ -117,7 +155,7 @@ func TestComment_TableName(t *testing.T) {
 
 func (s *CommentTestSuite) SetupTest() {
 	s.repoMock = new(CommentRepoMock)
-	s.usecase = NewCommentUsecase(s.repoMock)
+	s.usecase = NewCommentUsecase(nil, s.repoMock, nil, nil, nil, nil, nil, nil, nil)
 }
 
 // TestNewCommentUsecase 测试创建CommentUsecase
@@ -136,7 +174,7 @@ func (s *CommentTestSuite) TestNewCommentUsecase() {
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			got := NewCommentUsecase(tt.repo)
+			got := NewCommentUsecase(nil, tt.repo, nil, nil, nil, nil, nil, nil, nil)
 			s.Assert().NotNil(got)
 			s.Assert().Equal(tt.repo, got.repo)
 		})
@@ -218,7 +256,7 @@ func (s *CommentTestSuite) TestCommentUsecase_CreateComment() {
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			got, err := s.usecase.CreateComment(context.Background(), tt.args)
+			got, err := s.usecase.CreateComment(context.Background(), tt.args, "")
 			if (err != nil) != tt.wantErr {
 				s.T().Errorf("CreateComment() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -331,7 +369,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 		{
 			name: "正常获取根评论",
 			prepare: func() {
-				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0)).
+				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0), []string(nil)).
 					Return([]*Comment{
 						{
 							Module:          1,
@@ -395,7 +433,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 			name: "获取根评论和回复评论",
 			prepare: func() {
 				// 模拟获取根评论
-				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0)).
+				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0), []string(nil)).
 					Return([]*Comment{
 						{
 							ID:              1,
@@ -407,7 +445,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 					}, nil).Once()
 
 				// 模拟获取回复评论
-				s.repoMock.On("ListReplyComments", mock.Anything, []int64{1}, int32(2), int32(0)).
+				s.repoMock.On("ListReplyComments", mock.Anything, int32(1), "resource_123", []int64{1}, int32(2), int32(0), []string(nil)).
 					Return([]*Comment{
 						{
 							ID:              2,
@@ -444,7 +482,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 		{
 			name: "获取根评论时数据库错误",
 			prepare: func() {
-				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0)).
+				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0), []string(nil)).
 					Return(([]*Comment)(nil), errors.New("数据库查询失败")).Once()
 			},
 			module:     1,
@@ -460,7 +498,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 			name: "获取回复评论时数据库错误",
 			prepare: func() {
 				// 模拟获取根评论成功
-				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0)).
+				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0), []string(nil)).
 					Return([]*Comment{
 						{
 							ID:              1,
@@ -472,7 +510,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 					}, nil).Once()
 
 				// 模拟获取回复评论失败
-				s.repoMock.On("ListReplyComments", mock.Anything, []int64{1}, int32(2), int32(0)).
+				s.repoMock.On("ListReplyComments", mock.Anything, int32(1), "resource_123", []int64{1}, int32(2), int32(0), []string(nil)).
 					Return(([]*Comment)(nil), errors.New("数据库查询失败")).Once()
 			},
 			module:     1,
@@ -487,7 +525,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 		{
 			name: "没有根评论的情况",
 			prepare: func() {
-				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0)).
+				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0), []string(nil)).
 					Return([]*Comment{}, nil).Once()
 			},
 			module:     1,
@@ -503,7 +541,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 			name: "replyLimit为0时不获取回复评论",
 			prepare: func() {
 				// 模拟获取根评论
-				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0)).
+				s.repoMock.On("ListRootComments", mock.Anything, int32(1), "resource_123", int32(1), int32(10), int32(0), []string(nil)).
 					Return([]*Comment{
 						{
 							ID:              1,
@@ -540,7 +578,7 @@ func (s *CommentTestSuite) TestCommentUsecase_GetComments() {
 			if tt.prepare != nil {
 				tt.prepare()
 			}
-			got, err := s.usecase.GetComments(context.Background(), tt.module, tt.resourceID, tt.replyLimit, tt.page, tt.pageSize, tt.sortType)
+			got, err := s.usecase.GetComments(context.Background(), tt.module, tt.resourceID, tt.replyLimit, tt.page, tt.pageSize, tt.sortType, "")
 			if (err != nil) != tt.wantErr {
 				s.T().Errorf("GetComments() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -901,7 +939,7 @@ func (s *CommentTestSuite) TestCommentUsecase_buildCommentTree() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			// 执行构建评论树操作
-			s.usecase.buildCommentTree(tt.rootComments, tt.replyComments, tt.replyLimit)
+			s.usecase.buildCommentTree(tt.rootComments, tt.replyComments, tt.replyLimit, nil)
 
 			// 验证结果
 			s.Assert().Equal(len(tt.want), len(tt.rootComments))
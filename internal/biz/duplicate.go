@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DuplicatePolicy 重复内容处理策略
+type DuplicatePolicy string
+
+const (
+	// DuplicatePolicyOff 不检测
+	DuplicatePolicyOff DuplicatePolicy = "off"
+	// DuplicatePolicyFlag 标记为疑似重复后放行
+	DuplicatePolicyFlag DuplicatePolicy = "flag"
+	// DuplicatePolicyReject 拒绝发布
+	DuplicatePolicyReject DuplicatePolicy = "reject"
+)
+
+// ContentFingerprint 一条评论的内容指纹
+type ContentFingerprint struct {
+	CommentID  int64     `json:"comment_id"`
+	Module     int32     `json:"module"`
+	ResourceID string    `json:"resource_id"`
+	Digest     string    `json:"digest"`
+	SimHash    uint64    `json:"simhash"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// FingerprintStore 用户近期评论指纹存储
+type FingerprintStore interface {
+	// RecentFingerprints 获取用户在 since 之后发布评论的指纹
+	RecentFingerprints(ctx context.Context, userID string, since time.Time) ([]*ContentFingerprint, error)
+	// AddFingerprint 记录用户新发布评论的指纹，只保留最近 maxRecent 条，window 之前的指纹可被清理
+	AddFingerprint(ctx context.Context, userID string, fp *ContentFingerprint, window time.Duration, maxRecent int) error
+}
+
+// DuplicateDetector 检测用户跨资源发布的重复和近似重复内容
+type DuplicateDetector struct {
+	store          FingerprintStore
+	window         time.Duration
+	maxRecent      int
+	distance       int
+	minLength      int
+	policy         DuplicatePolicy
+	modulePolicies map[int32]DuplicatePolicy
+}
+
+// NewDuplicateDetector new a DuplicateDetector.
+func NewDuplicateDetector(c *conf.Data, store FingerprintStore) *DuplicateDetector {
+	d := &DuplicateDetector{
+		store:          store,
+		window:         10 * time.Minute,
+		maxRecent:      50,
+		distance:       6,
+		minLength:      8,
+		policy:         DuplicatePolicyOff,
+		modulePolicies: make(map[int32]DuplicatePolicy),
+	}
+	dc := c.GetDuplicate()
+	if dc == nil {
+		return d
+	}
+	if dc.Window != nil && dc.Window.AsDuration() > 0 {
+		d.window = dc.Window.AsDuration()
+	}
+	if dc.MaxRecent > 0 {
+		d.maxRecent = int(dc.MaxRecent)
+	}
+	if dc.SimhashDistance > 0 {
+		d.distance = int(dc.SimhashDistance)
+	}
+	if dc.MinLength > 0 {
+		d.minLength = int(dc.MinLength)
+	}
+	switch p := DuplicatePolicy(dc.Policy); p {
+	case "":
+	case DuplicatePolicyOff, DuplicatePolicyFlag, DuplicatePolicyReject:
+		d.policy = p
+	default:
+		log.Fatal(nil, "duplicate policy error.", "policy", dc.Policy)
+	}
+	for module, policy := range dc.ModulePolicies {
+		switch p := DuplicatePolicy(policy); p {
+		case DuplicatePolicyOff, DuplicatePolicyFlag, DuplicatePolicyReject:
+			d.modulePolicies[module] = p
+		default:
+			log.Fatal(nil, "duplicate policy error.", "module", module, "policy", policy)
+		}
+	}
+	return d
+}
+
+// Policy 获取模块的重复内容处理策略
+func (d *DuplicateDetector) Policy(module int32) DuplicatePolicy {
+	if policy, ok := d.modulePolicies[module]; ok {
+		return policy
+	}
+	return d.policy
+}
+
+// Fingerprint 计算评论的内容指纹，内容过短时返回 nil
+func (d *DuplicateDetector) Fingerprint(c *Comment) *ContentFingerprint {
+	normalized := normalizeContent(c.Content)
+	if len(normalized) < d.minLength {
+		return nil
+	}
+	digest := sha256.Sum256([]byte(string(normalized)))
+	return &ContentFingerprint{
+		Module:     c.Module,
+		ResourceID: c.ResourceID,
+		Digest:     hex.EncodeToString(digest[:]),
+		SimHash:    simHash(normalized),
+	}
+}
+
+// Detect 检测评论是否与用户近期评论重复或近似，返回命中的近期指纹
+func (d *DuplicateDetector) Detect(ctx context.Context, c *Comment, fp *ContentFingerprint) (*ContentFingerprint, error) {
+	recent, err := d.store.RecentFingerprints(ctx, c.UserID, time.Now().Add(-d.window))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range recent {
+		if r.Digest == fp.Digest {
+			return r, nil
+		}
+		if hammingDistance(r.SimHash, fp.SimHash) <= d.distance {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// Remember 记录新发布评论的指纹，失败只记录日志
+func (d *DuplicateDetector) Remember(ctx context.Context, c *Comment, fp *ContentFingerprint) {
+	fp.CommentID = c.ID
+	fp.CreatedAt = time.Now().UTC()
+	if err := d.store.AddFingerprint(ctx, c.UserID, fp, d.window, d.maxRecent); err != nil {
+		log.Error(ctx, "add fingerprint error.", "user_id", c.UserID, "err", err)
+	}
+}
+
+// normalizeContent 归一化评论内容：转为小写，去除空白、标点和符号
+func normalizeContent(content string) []rune {
+	normalized := make([]rune, 0, len(content))
+	for _, r := range strings.ToLower(content) {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		normalized = append(normalized, r)
+	}
+	return normalized
+}
+
+// simHash 以相邻两个字符为特征计算 64 位 SimHash，兼顾中文和英文
+func simHash(runes []rune) uint64 {
+	var weights [64]int
+	addFeature := func(feature string) {
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	if len(runes) < 2 {
+		addFeature(string(runes))
+	}
+	for i := 0; i+1 < len(runes); i++ {
+		addFeature(string(runes[i : i+2]))
+	}
+
+	var hash uint64
+	for i := 0; i < 64; i++ {
+		if weights[i] > 0 {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// hammingDistance 计算两个 SimHash 的汉明距离
+func hammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
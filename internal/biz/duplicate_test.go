@@ -0,0 +1,117 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"context"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// FingerprintStoreMock 是FingerprintStore接口的mock实现
+type FingerprintStoreMock struct {
+	mock.Mock
+}
+
+func (m *FingerprintStoreMock) RecentFingerprints(ctx context.Context, userID string, since time.Time) ([]*ContentFingerprint, error) {
+	args := m.Called(ctx, userID, since)
+	return args.Get(0).([]*ContentFingerprint), args.Error(1)
+}
+
+func (m *FingerprintStoreMock) AddFingerprint(ctx context.Context, userID string, fp *ContentFingerprint, window time.Duration, maxRecent int) error {
+	args := m.Called(ctx, userID, fp, window, maxRecent)
+	return args.Error(0)
+}
+
+func TestSimHash(t *testing.T) {
+	a := simHash(normalizeContent("这个视频真的太好看了，强烈推荐大家去看看原版！"))
+	b := simHash(normalizeContent("这个视频真的太好看了，强烈推荐大家去看看原版!!"))
+	c := simHash(normalizeContent("这个视频真的太好看了，强烈推荐大家去看看原著"))
+	d := simHash(normalizeContent("The quick brown fox jumps over the lazy dog"))
+
+	// 仅标点不同，归一化后完全相同
+	assert.Equal(t, a, b)
+	assert.LessOrEqual(t, hammingDistance(a, c), 6)
+	assert.Greater(t, hammingDistance(a, d), 6)
+}
+
+func TestDuplicateDetector_Policy(t *testing.T) {
+	d := NewDuplicateDetector(&conf.Data{Duplicate: &conf.Data_Duplicate{
+		Policy:         "flag",
+		ModulePolicies: map[int32]string{2: "reject", 3: "off"},
+	}}, nil)
+
+	assert.Equal(t, DuplicatePolicyFlag, d.Policy(1))
+	assert.Equal(t, DuplicatePolicyReject, d.Policy(2))
+	assert.Equal(t, DuplicatePolicyOff, d.Policy(3))
+	assert.Equal(t, DuplicatePolicyOff, NewDuplicateDetector(nil, nil).Policy(1))
+}
+
+func TestCommentUsecase_CreateComment_Duplicate(t *testing.T) {
+	c := &conf.Data{Duplicate: &conf.Data_Duplicate{
+		Policy:         "flag",
+		ModulePolicies: map[int32]string{2: "reject"},
+	}}
+	content := "关注我的主页领取免费会员，限时名额先到先得"
+	newComment := func(module int32, resourceID, content string) *Comment {
+		return &Comment{Module: module, ResourceID: resourceID, UserID: "bot", Content: content}
+	}
+	recent := func(content string) []*ContentFingerprint {
+		detector := NewDuplicateDetector(c, nil)
+		fp := detector.Fingerprint(newComment(1, "r1", content))
+		fp.CommentID = 1
+		return []*ContentFingerprint{fp}
+	}
+
+	t.Run("跨资源发布相同内容被标记", func(t *testing.T) {
+		repo, store := new(CommentRepoMock), new(FingerprintStoreMock)
+		store.On("RecentFingerprints", mock.Anything, "bot", mock.Anything).Return(recent(content), nil).Once()
+		repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool { return c.Flagged })).
+			Return(&Comment{ID: 2, UserID: "bot", Flagged: true}, nil).Once()
+		store.On("AddFingerprint", mock.Anything, "bot", mock.MatchedBy(func(fp *ContentFingerprint) bool { return fp.CommentID == 2 }), 10*time.Minute, 50).Return(nil).Once()
+
+		uc := NewCommentUsecase(nil, repo, nil, NewDuplicateDetector(c, store), nil, nil, nil, nil, nil)
+		got, err := uc.CreateComment(context.Background(), newComment(1, "r2", content), "")
+		assert.NoError(t, err)
+		assert.True(t, got.Flagged)
+		repo.AssertExpectations(t)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("reject 策略拒绝近似内容", func(t *testing.T) {
+		repo, store := new(CommentRepoMock), new(FingerprintStoreMock)
+		store.On("RecentFingerprints", mock.Anything, "bot", mock.Anything).Return(recent(content), nil).Once()
+
+		uc := NewCommentUsecase(nil, repo, nil, NewDuplicateDetector(c, store), nil, nil, nil, nil, nil)
+		_, err := uc.CreateComment(context.Background(), newComment(2, "r2", content+"！！"), "")
+		assert.Equal(t, v1.ErrorReason_DUPLICATE_COMMENT.String(), kerrors.Reason(err))
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("不同内容正常发布", func(t *testing.T) {
+		repo, store := new(CommentRepoMock), new(FingerprintStoreMock)
+		store.On("RecentFingerprints", mock.Anything, "bot", mock.Anything).Return(recent(content), nil).Once()
+		repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool { return !c.Flagged })).
+			Return(&Comment{ID: 3, UserID: "bot"}, nil).Once()
+		store.On("AddFingerprint", mock.Anything, "bot", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		uc := NewCommentUsecase(nil, repo, nil, NewDuplicateDetector(c, store), nil, nil, nil, nil, nil)
+		_, err := uc.CreateComment(context.Background(), newComment(2, "r2", "剧情节奏把控得很好，配乐也很出彩"), "")
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("短内容不检测", func(t *testing.T) {
+		repo, store := new(CommentRepoMock), new(FingerprintStoreMock)
+		repo.On("Save", mock.Anything, mock.Anything).Return(&Comment{ID: 4, UserID: "bot"}, nil).Once()
+
+		uc := NewCommentUsecase(nil, repo, nil, NewDuplicateDetector(c, store), nil, nil, nil, nil, nil)
+		_, err := uc.CreateComment(context.Background(), newComment(2, "r2", "好看！"), "")
+		assert.NoError(t, err)
+		store.AssertNotCalled(t, "RecentFingerprints", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
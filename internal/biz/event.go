@@ -0,0 +1,316 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// EventType 领域事件类型
+type EventType string
+
+const (
+	// EventCommentCreated 评论已创建（包含根评论和回复）
+	EventCommentCreated EventType = "CommentCreated"
+	// EventCommentReplied 评论被回复，TargetUserID 为被回复评论的作者
+	EventCommentReplied EventType = "CommentReplied"
+	// EventCommentLiked 评论被点赞，TargetUserID 为被点赞评论的作者
+	EventCommentLiked EventType = "CommentLiked"
+	// EventCommentUnliked 评论被取消点赞，TargetUserID 为被取消点赞评论的作者
+	EventCommentUnliked EventType = "CommentUnliked"
+	// EventCommentDeleted 评论及其回复被删除
+	EventCommentDeleted EventType = "CommentDeleted"
+	// EventCommentApproved 需要审核的评论审核通过
+	EventCommentApproved EventType = "CommentApproved"
+)
+
+// Event 领域事件，与业务数据在同一事务中写入 outbox，再由 EventDispatcher 异步投递
+type Event struct {
+	// ID outbox 记录ID，投递语义为至少一次，消费方应据此去重
+	ID int64 `json:"id"`
+
+	// Type 事件类型
+	Type EventType `json:"type"`
+
+	// Module 业务模块
+	Module int32 `json:"module"`
+
+	// ResourceID 资源唯一标识
+	ResourceID string `json:"resource_id"`
+
+	// CommentID 事件关联的评论ID
+	CommentID int64 `json:"comment_id"`
+
+	// RootCommentID 根评论ID
+	RootCommentID int64 `json:"root_comment_id,omitempty"`
+
+	// ParentCommentID 父评论ID
+	ParentCommentID int64 `json:"parent_comment_id,omitempty"`
+
+	// UserID 触发事件的用户
+	UserID string `json:"user_id"`
+
+	// TargetUserID 被回复或被点赞评论的作者
+	TargetUserID string `json:"target_user_id,omitempty"`
+
+	// MentionedUserIDs 评论中被 @ 的用户
+	MentionedUserIDs []string `json:"mentioned_user_ids,omitempty"`
+
+	// LikeCount 事件发生后的点赞数
+	LikeCount int64 `json:"like_count,omitempty"`
+
+	// DeletedCommentIDs 随本次删除一并删除的评论ID（包含 CommentID 本身）
+	DeletedCommentIDs []int64 `json:"deleted_comment_ids,omitempty"`
+
+	// OccurredAt 事件发生时间
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// Attempts 已投递次数，仅用于投递调度
+	Attempts int32 `json:"-"`
+
+	// DeliveredTo 已投递成功的发布者名称，重试时跳过，仅用于投递调度
+	DeliveredTo []string `json:"-"`
+}
+
+// NewCommentEvent 根据评论构造领域事件
+func NewCommentEvent(typ EventType, c *Comment) *Event {
+	e := &Event{
+		Type:            typ,
+		Module:          c.Module,
+		ResourceID:      c.ResourceID,
+		CommentID:       c.ID,
+		RootCommentID:   c.RootCommentID,
+		ParentCommentID: c.ParentCommentID,
+		UserID:          c.UserID,
+		LikeCount:       c.LikeCount,
+		OccurredAt:      time.Now().UTC(),
+	}
+	for _, m := range c.Mentions {
+		if m.UserID != "" {
+			e.MentionedUserIDs = append(e.MentionedUserIDs, m.UserID)
+		}
+	}
+	return e
+}
+
+// Publisher 事件发布者
+type Publisher interface {
+	// Publish 发布一个事件，返回错误时事件会被重新投递
+	Publish(ctx context.Context, e *Event) error
+}
+
+// EventRepo 领域事件 outbox 仓储
+type EventRepo interface {
+	// ListPendingEvents 获取到期待投递的事件，按写入顺序排列
+	ListPendingEvents(ctx context.Context, limit int) ([]*Event, error)
+	// MarkEventDelivered 标记事件投递成功
+	MarkEventDelivered(ctx context.Context, id int64) error
+	// MarkEventFailed 记录一次投递失败及已投递成功的发布者，dead 为 true 时不再重试
+	MarkEventFailed(ctx context.Context, id int64, nextRetry time.Time, reason string, dead bool, deliveredTo []string) error
+	// DeleteDeliveredEvents 删除一批在 before 之前投递成功的事件，返回删除的数量
+	DeleteDeliveredEvents(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// 发布者名称，记录在 outbox 中以区分各发布者的投递状态
+const (
+	// PublisherEvent 配置的外部发布者（进程内 / Webhook）
+	PublisherEvent = "event"
+	// PublisherWebhook 将事件展开为各 Webhook 订阅的投递记录
+	PublisherWebhook = "webhook"
+	// PublisherWatch 将事件推送给实时订阅者
+	PublisherWatch = "watch"
+)
+
+// namedPublisher 带名称的发布者
+type namedPublisher struct {
+	name string
+	Publisher
+}
+
+// EventDispatcher 轮询 outbox 并通过各 Publisher 投递事件，各发布者的投递状态分别记录，
+// 重试时只投递给失败的发布者；实现 transport.Server 以随应用启停
+type EventDispatcher struct {
+	repo        EventRepo
+	publishers  []namedPublisher
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int32
+	// retention 已投递事件的保留时间，不大于 0 时不清理
+	retention       time.Duration
+	cleanupInterval time.Duration
+	stop            chan struct{}
+	done            chan struct{}
+}
+
+// cleanupBatchSize 每次删除的已投递事件数，避免单条语句锁定过多行
+const cleanupBatchSize = 1000
+
+// NewEventDispatcher new an EventDispatcher.
+// publisher 为配置的外部发布者，webhook 将事件展开为各订阅的投递记录，hub 将事件推送给实时订阅者
+func NewEventDispatcher(c *conf.Data, repo EventRepo, publisher Publisher, webhook *WebhookUsecase, hub *WatchHub) *EventDispatcher {
+	d := &EventDispatcher{
+		repo:            repo,
+		publishers:      []namedPublisher{{name: PublisherEvent, Publisher: publisher}},
+		interval:        time.Second,
+		batchSize:       100,
+		maxAttempts:     16,
+		retention:       7 * 24 * time.Hour,
+		cleanupInterval: time.Hour,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+	if ec := c.GetEvent(); ec != nil {
+		if ec.PollInterval != nil && ec.PollInterval.AsDuration() > 0 {
+			d.interval = ec.PollInterval.AsDuration()
+		}
+		if ec.BatchSize > 0 {
+			d.batchSize = int(ec.BatchSize)
+		}
+		if ec.MaxAttempts > 0 {
+			d.maxAttempts = ec.MaxAttempts
+		}
+		if ec.Retention != nil && ec.Retention.AsDuration() != 0 {
+			d.retention = ec.Retention.AsDuration()
+		}
+		if ec.CleanupInterval != nil && ec.CleanupInterval.AsDuration() > 0 {
+			d.cleanupInterval = ec.CleanupInterval.AsDuration()
+		}
+	}
+	if webhook != nil {
+		d.publishers = append(d.publishers, namedPublisher{name: PublisherWebhook, Publisher: webhook})
+	}
+	if hub != nil {
+		d.publishers = append(d.publishers, namedPublisher{name: PublisherWatch, Publisher: hub})
+	}
+	return d
+}
+
+// Start 启动投递循环，阻塞直到 Stop 被调用
+func (d *EventDispatcher) Start(ctx context.Context) error {
+	log.Info(ctx, "event dispatcher started.", "interval", d.interval, "batch_size", d.batchSize, "retention", d.retention)
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	cleanup := time.NewTicker(d.cleanupInterval)
+	defer cleanup.Stop()
+	for {
+		select {
+		case <-d.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.Dispatch(ctx)
+		case <-cleanup.C:
+			d.Cleanup(ctx)
+		}
+	}
+}
+
+// Stop 停止投递循环
+func (d *EventDispatcher) Stop(ctx context.Context) error {
+	close(d.stop)
+	select {
+	case <-d.done:
+	case <-ctx.Done():
+	}
+	log.Info(ctx, "event dispatcher stopped.")
+	return nil
+}
+
+// Dispatch 投递一批到期事件，返回投递成功的数量
+func (d *EventDispatcher) Dispatch(ctx context.Context) int {
+	events, err := d.repo.ListPendingEvents(ctx, d.batchSize)
+	if err != nil {
+		log.Error(ctx, "list pending events error.", "err", err)
+		return 0
+	}
+
+	delivered := 0
+	for _, e := range events {
+		if deliveredTo, err := d.publish(ctx, e); err != nil {
+			attempts := e.Attempts + 1
+			dead := attempts >= d.maxAttempts
+			log.Warn(ctx, "publish event error.", "id", e.ID, "type", e.Type, "attempts", attempts, "dead", dead, "delivered_to", deliveredTo, "err", err)
+			if err := d.repo.MarkEventFailed(ctx, e.ID, time.Now().Add(retryBackoff(attempts)), err.Error(), dead, deliveredTo); err != nil {
+				log.Error(ctx, "mark event failed error.", "id", e.ID, "err", err)
+			}
+			continue
+		}
+		if err := d.repo.MarkEventDelivered(ctx, e.ID); err != nil {
+			// 标记失败时事件会被再次投递，由消费方按事件ID去重
+			log.Error(ctx, "mark event delivered error.", "id", e.ID, "err", err)
+			continue
+		}
+		delivered++
+	}
+	return delivered
+}
+
+// Cleanup 分批删除超过保留时间的已投递事件，返回删除的数量；未投递和不再重试的事件保留以便排查
+func (d *EventDispatcher) Cleanup(ctx context.Context) int64 {
+	if d.retention <= 0 {
+		return 0
+	}
+	before := time.Now().Add(-d.retention)
+	var total int64
+	for {
+		select {
+		case <-d.stop:
+			return total
+		default:
+		}
+		deleted, err := d.repo.DeleteDeliveredEvents(ctx, before, cleanupBatchSize)
+		if err != nil {
+			log.Error(ctx, "delete delivered events error.", "err", err)
+			return total
+		}
+		total += deleted
+		if deleted < cleanupBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		log.Info(ctx, "delete delivered events successful.", "deleted", total, "before", before)
+	}
+	return total
+}
+
+// publish 通过尚未投递成功的发布者投递事件，某个发布者失败不影响其他发布者；
+// 返回累计投递成功的发布者，有发布者失败时返回合并后的错误，事件稍后只重新投递给失败的发布者
+func (d *EventDispatcher) publish(ctx context.Context, e *Event) ([]string, error) {
+	deliveredTo := append([]string(nil), e.DeliveredTo...)
+	var errs []error
+	for _, p := range d.publishers {
+		if slices.Contains(e.DeliveredTo, p.name) {
+			continue
+		}
+		if err := p.Publish(ctx, e); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.name, err))
+			continue
+		}
+		deliveredTo = append(deliveredTo, p.name)
+	}
+	return deliveredTo, errors.Join(errs...)
+}
+
+// retryBackoff 计算第 attempts 次失败后的重试间隔：1s、2s、4s……最长 10 分钟
+func retryBackoff(attempts int32) time.Duration {
+	const maxBackoff = 10 * time.Minute
+	if attempts <= 0 {
+		return time.Second
+	}
+	if attempts > 10 {
+		return maxBackoff
+	}
+	backoff := time.Second << (attempts - 1)
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
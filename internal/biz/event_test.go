@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// EventRepoMock 是EventRepo接口的mock实现
+type EventRepoMock struct {
+	mock.Mock
+}
+
+func (m *EventRepoMock) ListPendingEvents(ctx context.Context, limit int) ([]*Event, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*Event), args.Error(1)
+}
+
+func (m *EventRepoMock) MarkEventDelivered(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *EventRepoMock) MarkEventFailed(ctx context.Context, id int64, nextRetry time.Time, reason string, dead bool, deliveredTo []string) error {
+	args := m.Called(ctx, id, nextRetry, reason, dead, deliveredTo)
+	return args.Error(0)
+}
+
+func (m *EventRepoMock) DeleteDeliveredEvents(ctx context.Context, before time.Time, limit int) (int64, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// PublisherMock 是Publisher接口的mock实现
+type PublisherMock struct {
+	mock.Mock
+}
+
+func (m *PublisherMock) Publish(ctx context.Context, e *Event) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func TestEventDispatcher_Dispatch(t *testing.T) {
+	c := &conf.Data{Event: &conf.Data_Event{BatchSize: 10, MaxAttempts: 3}}
+
+	t.Run("投递成功后标记已投递", func(t *testing.T) {
+		repo, pub := new(EventRepoMock), new(PublisherMock)
+		e := &Event{ID: 1, Type: EventCommentCreated}
+		repo.On("ListPendingEvents", mock.Anything, 10).Return([]*Event{e}, nil).Once()
+		pub.On("Publish", mock.Anything, e).Return(nil).Once()
+		repo.On("MarkEventDelivered", mock.Anything, int64(1)).Return(nil).Once()
+
+		d := NewEventDispatcher(c, repo, pub, nil, nil)
+		assert.Equal(t, 1, d.Dispatch(context.Background()))
+		repo.AssertExpectations(t)
+		pub.AssertExpectations(t)
+	})
+
+	t.Run("投递失败后等待重试", func(t *testing.T) {
+		repo, pub := new(EventRepoMock), new(PublisherMock)
+		e := &Event{ID: 2, Type: EventCommentLiked, Attempts: 0}
+		repo.On("ListPendingEvents", mock.Anything, 10).Return([]*Event{e}, nil).Once()
+		pub.On("Publish", mock.Anything, e).Return(errors.New("下游不可用")).Once()
+		repo.On("MarkEventFailed", mock.Anything, int64(2), mock.Anything, "event: 下游不可用", false, []string(nil)).Return(nil).Once()
+
+		d := NewEventDispatcher(c, repo, pub, nil, nil)
+		assert.Equal(t, 0, d.Dispatch(context.Background()))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("超过最大投递次数后不再重试", func(t *testing.T) {
+		repo, pub := new(EventRepoMock), new(PublisherMock)
+		e := &Event{ID: 3, Type: EventCommentDeleted, Attempts: 2}
+		repo.On("ListPendingEvents", mock.Anything, 10).Return([]*Event{e}, nil).Once()
+		pub.On("Publish", mock.Anything, e).Return(errors.New("下游不可用")).Once()
+		repo.On("MarkEventFailed", mock.Anything, int64(3), mock.Anything, "event: 下游不可用", true, []string(nil)).Return(nil).Once()
+
+		d := NewEventDispatcher(c, repo, pub, nil, nil)
+		assert.Equal(t, 0, d.Dispatch(context.Background()))
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestEventDispatcher_PublisherState(t *testing.T) {
+	c := &conf.Data{Event: &conf.Data_Event{BatchSize: 10, MaxAttempts: 3}}
+
+	t.Run("某个发布者失败不影响其他发布者，重试只投递给失败的发布者", func(t *testing.T) {
+		repo, pub, webhookRepo := new(EventRepoMock), new(PublisherMock), new(WebhookRepoMock)
+		pushed := 0
+		hub := NewWatchHub(&conf.Data{}, nil, &watchBrokerStub{handler: func(*CommentChange) { pushed++ }})
+		webhook := NewWebhookUsecase(&conf.Data{}, webhookRepo)
+		d := NewEventDispatcher(c, repo, pub, webhook, hub)
+
+		e := &Event{ID: 4, Type: EventCommentLiked, Module: 1}
+		repo.On("ListPendingEvents", mock.Anything, 10).Return([]*Event{e}, nil).Once()
+		pub.On("Publish", mock.Anything, e).Return(nil).Once()
+		webhookRepo.On("ListSubscriptionsForModule", mock.Anything, int32(1)).Return([]*WebhookSubscription(nil), errors.New("数据库不可用")).Once()
+		repo.On("MarkEventFailed", mock.Anything, int64(4), mock.Anything, "webhook: 数据库不可用", false,
+			[]string{PublisherEvent, PublisherWatch}).Return(nil).Once()
+		assert.Equal(t, 0, d.Dispatch(context.Background()))
+
+		// 重试时已投递成功的发布者不再收到事件
+		retry := &Event{ID: 4, Type: EventCommentLiked, Module: 1, Attempts: 1, DeliveredTo: []string{PublisherEvent, PublisherWatch}}
+		repo.On("ListPendingEvents", mock.Anything, 10).Return([]*Event{retry}, nil).Once()
+		webhookRepo.On("ListSubscriptionsForModule", mock.Anything, int32(1)).Return([]*WebhookSubscription{}, nil).Once()
+		repo.On("MarkEventDelivered", mock.Anything, int64(4)).Return(nil).Once()
+		assert.Equal(t, 1, d.Dispatch(context.Background()))
+
+		repo.AssertExpectations(t)
+		pub.AssertExpectations(t)
+		assert.Equal(t, 1, pushed)
+		webhookRepo.AssertExpectations(t)
+	})
+}
+
+func TestEventDispatcher_Cleanup(t *testing.T) {
+	t.Run("分批删除超过保留时间的已投递事件", func(t *testing.T) {
+		repo := new(EventRepoMock)
+		c := &conf.Data{Event: &conf.Data_Event{Retention: durationpb.New(time.Hour)}}
+		before := mock.MatchedBy(func(before time.Time) bool {
+			return time.Until(before) < -59*time.Minute && time.Until(before) > -61*time.Minute
+		})
+		repo.On("DeleteDeliveredEvents", mock.Anything, before, cleanupBatchSize).Return(int64(cleanupBatchSize), nil).Once()
+		repo.On("DeleteDeliveredEvents", mock.Anything, before, cleanupBatchSize).Return(int64(3), nil).Once()
+
+		d := NewEventDispatcher(c, repo, nil, nil, nil)
+		assert.Equal(t, int64(cleanupBatchSize+3), d.Cleanup(context.Background()))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("保留时间为负数时不清理", func(t *testing.T) {
+		repo := new(EventRepoMock)
+		c := &conf.Data{Event: &conf.Data_Event{Retention: durationpb.New(-time.Second)}}
+
+		d := NewEventDispatcher(c, repo, nil, nil, nil)
+		assert.Equal(t, int64(0), d.Cleanup(context.Background()))
+		repo.AssertNotCalled(t, "DeleteDeliveredEvents", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, retryBackoff(1))
+	assert.Equal(t, 8*time.Second, retryBackoff(4))
+	assert.Equal(t, 10*time.Minute, retryBackoff(20))
+}
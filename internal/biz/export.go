@@ -0,0 +1,213 @@
+package biz
+
+import (
+	"bytes"
+	"comment/pkg/log"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 导出格式
+const (
+	ExportJSONL = "jsonl" // 每行一条评论的 JSON
+	ExportCSV   = "csv"   // 第一行为表头
+)
+
+// defaultExportChunkSize 每批读取和编码的评论数
+const defaultExportChunkSize = 500
+
+// ExportQuery 导出条件，UserID 与 (Module, ResourceID) 必须且只能指定一个
+type ExportQuery struct {
+	UserID     string
+	Module     int32
+	ResourceID string
+}
+
+// ExportRepo 评论导出仓储
+type ExportRepo interface {
+	// ListExportComments 按ID升序返回 afterID 之后至多 limit 条评论，包括被隐藏和软删除的评论，不加载提及和附件
+	ListExportComments(ctx context.Context, q *ExportQuery, afterID int64, limit int) ([]*Comment, error)
+}
+
+// ExportUsecase 按用户或资源导出全部评论，分批读取和编码，内存占用与评论总数无关
+type ExportUsecase struct {
+	repo      ExportRepo
+	chunkSize int
+}
+
+// NewExportUsecase new a comment export usecase.
+func NewExportUsecase(repo ExportRepo) *ExportUsecase {
+	return &ExportUsecase{repo: repo, chunkSize: defaultExportChunkSize}
+}
+
+// ExportComments 按评论ID顺序分批导出评论，每批编码为 format 格式的完整行后交给 emit；
+// emit 返回后不能再持有收到的数据，返回错误时停止导出。返回导出的评论数
+func (uc *ExportUsecase) ExportComments(ctx context.Context, q *ExportQuery, format string, emit func(chunk []byte) error) (int64, error) {
+	log.Debug(ctx, "export comments.", "user_id", q.UserID, "module", q.Module, "resource_id", q.ResourceID, "format", format)
+
+	byUser := q.UserID != ""
+	byResource := q.Module > 0 || q.ResourceID != ""
+	if byUser == byResource {
+		return 0, errors.BadRequest("INVALID_ARGUMENT", "either user_id or module and resource_id is required.")
+	}
+	if byResource && (q.Module <= 0 || q.ResourceID == "") {
+		return 0, errors.BadRequest("INVALID_ARGUMENT", "module and resource_id are required together.")
+	}
+
+	var buf bytes.Buffer
+	enc, err := newExportEncoder(format, &buf)
+	if err != nil {
+		return 0, err
+	}
+
+	var exported, afterID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return exported, err
+		}
+		comments, err := uc.repo.ListExportComments(ctx, q, afterID, uc.chunkSize)
+		if err != nil {
+			log.Error(ctx, "list export comments error.", "err", err)
+			return exported, errors.BadRequest(err.Error(), "list export comments error.")
+		}
+		for _, c := range comments {
+			if err := enc.encode(c); err != nil {
+				return exported, err
+			}
+		}
+		if err := enc.flush(); err != nil {
+			return exported, err
+		}
+		// CSV 没有评论时也输出表头
+		if buf.Len() > 0 {
+			if err := emit(buf.Bytes()); err != nil {
+				return exported, err
+			}
+			buf.Reset()
+		}
+		exported += int64(len(comments))
+		if len(comments) < uc.chunkSize {
+			return exported, nil
+		}
+		afterID = comments[len(comments)-1].ID
+	}
+}
+
+// exportColumns 导出的字段，JSONL 的键与 CSV 的表头一致
+var exportColumns = []string{
+	"comment_id", "module", "resource_id", "root_comment_id", "parent_comment_id", "path", "level",
+	"user_id", "username", "content", "like_count", "reply_count", "flagged", "hidden", "moderation_status", "deleted",
+	"create_time", "update_time",
+}
+
+// exportRecord 导出的一条评论；评论ID超出 JavaScript 的安全整数范围，JSONL 中与 HTTP 接口一样编码为字符串
+type exportRecord struct {
+	CommentID        int64  `json:"comment_id,string"`
+	Module           int32  `json:"module"`
+	ResourceID       string `json:"resource_id"`
+	RootCommentID    int64  `json:"root_comment_id,string"`
+	ParentCommentID  int64  `json:"parent_comment_id,string"`
+	Path             string `json:"path"`
+	Level            int32  `json:"level"`
+	UserID           string `json:"user_id"`
+	Username         string `json:"username"`
+	Content          string `json:"content"`
+	LikeCount        int64  `json:"like_count"`
+	ReplyCount       int64  `json:"reply_count"`
+	Flagged          bool   `json:"flagged"`
+	Hidden           bool   `json:"hidden"`
+	ModerationStatus int32  `json:"moderation_status"`
+	Deleted          bool   `json:"deleted"`
+	CreateTime       string `json:"create_time"`
+	UpdateTime       string `json:"update_time"`
+}
+
+func newExportRecord(c *Comment) *exportRecord {
+	return &exportRecord{
+		CommentID:        c.ID,
+		Module:           c.Module,
+		ResourceID:       c.ResourceID,
+		RootCommentID:    c.RootCommentID,
+		ParentCommentID:  c.ParentCommentID,
+		Path:             c.Path,
+		Level:            c.Level,
+		UserID:           c.UserID,
+		Username:         c.Username,
+		Content:          c.Content,
+		LikeCount:        c.LikeCount,
+		ReplyCount:       c.ReplyCount,
+		Flagged:          c.Flagged,
+		Hidden:           c.Hidden,
+		ModerationStatus: c.ModerationStatus,
+		Deleted:          c.Deleted,
+		CreateTime:       c.CreateGmt.UTC().Format(time.RFC3339Nano),
+		UpdateTime:       c.UpdateGmt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// row 按 exportColumns 的顺序返回 CSV 的一行
+func (r *exportRecord) row() []string {
+	return []string{
+		strconv.FormatInt(r.CommentID, 10), strconv.Itoa(int(r.Module)), r.ResourceID,
+		strconv.FormatInt(r.RootCommentID, 10), strconv.FormatInt(r.ParentCommentID, 10), r.Path, strconv.Itoa(int(r.Level)),
+		r.UserID, r.Username, r.Content, strconv.FormatInt(r.LikeCount, 10), strconv.FormatInt(r.ReplyCount, 10),
+		strconv.FormatBool(r.Flagged), strconv.FormatBool(r.Hidden), strconv.Itoa(int(r.ModerationStatus)), strconv.FormatBool(r.Deleted),
+		r.CreateTime, r.UpdateTime,
+	}
+}
+
+// exportEncoder 把评论编码为导出格式的行
+type exportEncoder interface {
+	encode(c *Comment) error
+	// flush 把已编码的行写入底层 writer
+	flush() error
+}
+
+// newExportEncoder 创建 format 格式的编码器，CSV 编码器先写入表头
+func newExportEncoder(format string, w io.Writer) (exportEncoder, error) {
+	switch format {
+	case ExportJSONL:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return &jsonlEncoder{enc: enc}, nil
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(exportColumns); err != nil {
+			return nil, err
+		}
+		return &csvEncoder{w: cw}, nil
+	default:
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "unknown export format.")
+	}
+}
+
+type jsonlEncoder struct {
+	enc *json.Encoder
+}
+
+func (e *jsonlEncoder) encode(c *Comment) error {
+	return e.enc.Encode(newExportRecord(c))
+}
+
+func (e *jsonlEncoder) flush() error {
+	return nil
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) encode(c *Comment) error {
+	return e.w.Write(newExportRecord(c).row())
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
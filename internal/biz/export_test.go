@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ExportRepoMock 是ExportRepo接口的mock实现
+type ExportRepoMock struct {
+	mock.Mock
+}
+
+func (m *ExportRepoMock) ListExportComments(ctx context.Context, q *ExportQuery, afterID int64, limit int) ([]*Comment, error) {
+	args := m.Called(ctx, q, afterID, limit)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func TestExportUsecase_ExportComments(t *testing.T) {
+	ctx := context.Background()
+	q := &ExportQuery{Module: 1, ResourceID: "r1"}
+	createGmt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	root := &Comment{ID: 1 << 60, Module: 1, ResourceID: "r1", Path: "/1/", UserID: "u1", Username: "alice", Content: "hello, \"world\"", ReplyCount: 1, CreateGmt: createGmt, UpdateGmt: createGmt}
+	reply := &Comment{ID: 1<<60 + 1, Module: 1, ResourceID: "r1", RootCommentID: root.ID, ParentCommentID: root.ID, Level: 1, UserID: "u2", Username: "bob", Content: "<b>hi</b>\nthere", Deleted: true, CreateGmt: createGmt, UpdateGmt: createGmt}
+	third := &Comment{ID: 1<<60 + 2, Module: 1, ResourceID: "r1", UserID: "u1", Content: "third", CreateGmt: createGmt, UpdateGmt: createGmt}
+
+	newUsecase := func() (*ExportUsecase, *ExportRepoMock) {
+		repo := new(ExportRepoMock)
+		repo.On("ListExportComments", mock.Anything, q, int64(0), 2).Return([]*Comment{root, reply}, nil).Once()
+		repo.On("ListExportComments", mock.Anything, q, reply.ID, 2).Return([]*Comment{third}, nil).Once()
+		uc := NewExportUsecase(repo)
+		uc.chunkSize = 2
+		return uc, repo
+	}
+
+	t.Run("按批导出JSONL", func(t *testing.T) {
+		uc, repo := newUsecase()
+		var chunks []string
+		exported, err := uc.ExportComments(ctx, q, ExportJSONL, func(chunk []byte) error {
+			chunks = append(chunks, string(chunk))
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), exported)
+		assert.Len(t, chunks, 2)
+		repo.AssertExpectations(t)
+
+		lines := strings.Split(strings.TrimSuffix(strings.Join(chunks, ""), "\n"), "\n")
+		if assert.Len(t, lines, 3) {
+			var record map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+			assert.Equal(t, "1152921504606846977", record["comment_id"])
+			assert.Equal(t, "1152921504606846976", record["parent_comment_id"])
+			assert.Equal(t, "1152921504606846976", record["root_comment_id"])
+			assert.Equal(t, "<b>hi</b>\nthere", record["content"])
+			assert.Equal(t, true, record["deleted"])
+			assert.Equal(t, "2026-01-02T03:04:05Z", record["create_time"])
+		}
+	})
+
+	t.Run("导出CSV带表头", func(t *testing.T) {
+		uc, _ := newUsecase()
+		var out strings.Builder
+		_, err := uc.ExportComments(ctx, q, ExportCSV, func(chunk []byte) error {
+			out.Write(chunk)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		rows, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+		assert.NoError(t, err)
+		if assert.Len(t, rows, 4) {
+			assert.Equal(t, exportColumns, rows[0])
+			assert.Equal(t, "hello, \"world\"", rows[1][9])
+			assert.Equal(t, []string{"1152921504606846977", "1", "r1", "1152921504606846976", "1152921504606846976"}, rows[2][:5])
+		}
+	})
+
+	t.Run("没有评论时CSV只有表头", func(t *testing.T) {
+		repo := new(ExportRepoMock)
+		repo.On("ListExportComments", mock.Anything, q, int64(0), defaultExportChunkSize).Return([]*Comment{}, nil).Once()
+		var out strings.Builder
+		exported, err := NewExportUsecase(repo).ExportComments(ctx, q, ExportCSV, func(chunk []byte) error {
+			out.Write(chunk)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Zero(t, exported)
+		assert.Equal(t, strings.Join(exportColumns, ",")+"\n", out.String())
+	})
+
+	t.Run("写出失败时停止导出", func(t *testing.T) {
+		repo := new(ExportRepoMock)
+		repo.On("ListExportComments", mock.Anything, q, int64(0), 2).Return([]*Comment{root, reply}, nil).Once()
+		uc := NewExportUsecase(repo)
+		uc.chunkSize = 2
+		_, err := uc.ExportComments(ctx, q, ExportJSONL, func(chunk []byte) error {
+			return errors.New("broken pipe")
+		})
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("参数校验", func(t *testing.T) {
+		repo := new(ExportRepoMock)
+		uc := NewExportUsecase(repo)
+		emit := func(chunk []byte) error { return nil }
+		for _, invalid := range []*ExportQuery{
+			{},
+			{UserID: "u1", Module: 1, ResourceID: "r1"},
+			{Module: 1},
+		} {
+			_, err := uc.ExportComments(ctx, invalid, ExportJSONL, emit)
+			assert.Error(t, err)
+		}
+		_, err := uc.ExportComments(ctx, q, "xml", emit)
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "ListExportComments", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
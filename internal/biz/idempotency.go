@@ -0,0 +1,85 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// defaultIdempotencyTTL 幂等键默认保留时间
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// defaultIdempotencyReservationTimeout 占用幂等键后评论仍未写入的默认最长时间，超过后允许重试接管
+const defaultIdempotencyReservationTimeout = time.Minute
+
+// Idempotency 幂等键记录，按用户隔离，记录首次请求的内容指纹和为其分配的评论ID
+type Idempotency struct {
+	// ID 记录唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// UserID 发起请求的用户
+	UserID string `gorm:"column:user_id;type:varchar(32);not null;index:uk_user_key,unique"`
+
+	// Key 客户端提供的幂等键
+	Key string `gorm:"column:idem_key;type:varchar(128);not null;index:uk_user_key,unique"`
+
+	// Fingerprint 请求内容指纹，用于识别同一幂等键下内容不同的请求
+	Fingerprint string `gorm:"column:fingerprint;type:char(64);not null"`
+
+	// CommentID 占用幂等键时预先分配的评论ID，该评论尚未写入表示请求仍在处理中
+	CommentID int64 `gorm:"column:comment_id;type:bigint;not null;default:0"`
+
+	// ExpireGmt 过期时间，过期后幂等键可被重新使用
+	ExpireGmt time.Time `gorm:"column:expire_gmt;type:datetime;not null;index:idx_expire"`
+
+	// CreateGmt 占用时间，接管占用超时的幂等键时更新
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (i *Idempotency) TableName() string {
+	return "comment_idempotency"
+}
+
+// IdempotencyRepo 幂等键仓储
+type IdempotencyRepo interface {
+	// GetIdempotency 获取未过期的幂等键记录，不存在时返回 nil
+	GetIdempotency(ctx context.Context, userID, key string) (*Idempotency, error)
+	// ReserveIdempotency 占用幂等键，幂等键已被占用且未过期时返回 false
+	ReserveIdempotency(ctx context.Context, record *Idempotency) (bool, error)
+	// ReleaseIdempotency 释放为 commentID 占用的幂等键，创建失败后允许客户端使用同一幂等键重试
+	ReleaseIdempotency(ctx context.Context, userID, key string, commentID int64) error
+	// TakeOverIdempotency 接管占用超时的幂等键：记录仍为 staleCommentID 占用且占用时间早于 reservedBefore 时替换为 record，
+	// 返回是否接管成功；并发的重试只有一个能接管
+	TakeOverIdempotency(ctx context.Context, record *Idempotency, staleCommentID int64, reservedBefore time.Time) (bool, error)
+}
+
+// commentFingerprint 计算创建评论请求的内容指纹
+func commentFingerprint(c *Comment) string {
+	h := sha256.New()
+	for _, field := range []string{
+		strconv.FormatInt(int64(c.Module), 10),
+		c.ResourceID,
+		strconv.FormatInt(c.RootCommentID, 10),
+		strconv.FormatInt(c.ParentCommentID, 10),
+		strconv.FormatInt(int64(c.Level), 10),
+		c.UserID,
+		c.Username,
+		c.Avatar,
+		c.Content,
+	} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	// 附件按顺序参与计算，没有附件时与不支持附件之前的指纹一致
+	for _, a := range c.Attachments {
+		h.Write([]byte(strconv.FormatInt(int64(a.Type), 10)))
+		h.Write([]byte{0})
+		h.Write([]byte(a.URL))
+		h.Write([]byte{0})
+		h.Write([]byte(a.StickerID))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
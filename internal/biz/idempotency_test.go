@@ -0,0 +1,209 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// IdempotencyRepoMock 是IdempotencyRepo接口的mock实现
+type IdempotencyRepoMock struct {
+	mock.Mock
+}
+
+func (m *IdempotencyRepoMock) GetIdempotency(ctx context.Context, userID, key string) (*Idempotency, error) {
+	args := m.Called(ctx, userID, key)
+	return args.Get(0).(*Idempotency), args.Error(1)
+}
+
+func (m *IdempotencyRepoMock) ReserveIdempotency(ctx context.Context, record *Idempotency) (bool, error) {
+	args := m.Called(ctx, record)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *IdempotencyRepoMock) ReleaseIdempotency(ctx context.Context, userID, key string, commentID int64) error {
+	args := m.Called(ctx, userID, key, commentID)
+	return args.Error(0)
+}
+
+func (m *IdempotencyRepoMock) TakeOverIdempotency(ctx context.Context, record *Idempotency, staleCommentID int64, reservedBefore time.Time) (bool, error) {
+	args := m.Called(ctx, record, staleCommentID, reservedBefore)
+	return args.Bool(0), args.Error(1)
+}
+
+// IDGeneratorStub 依次返回从 next 开始递增的ID，不包含资源分桶
+type IDGeneratorStub struct {
+	next int64
+}
+
+func (g *IDGeneratorStub) NextID(bucket int64) (int64, error) {
+	g.next++
+	return g.next - 1, nil
+}
+
+// txnKey 标记上下文处于 TxnManagerStub 开启的事务中
+type txnKey struct{}
+
+// TxnManagerStub 记录事务内执行结果的TxnManager实现，fn 返回错误视为回滚
+type TxnManagerStub struct {
+	committed  int
+	rolledBack int
+}
+
+func (m *TxnManagerStub) Txn(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, txnKey{}, true)); err != nil {
+		m.rolledBack++
+		return err
+	}
+	m.committed++
+	return nil
+}
+
+// inTxn 匹配处于事务中的上下文
+func inTxn(ctx context.Context) bool {
+	in, _ := ctx.Value(txnKey{}).(bool)
+	return in
+}
+
+func TestCommentUsecase_CreateComment_Idempotency(t *testing.T) {
+	newComment := func(content string) *Comment {
+		return &Comment{Module: 1, ResourceID: "r1", UserID: "u1", Username: "tom", Avatar: "a", Content: content}
+	}
+
+	t.Run("首次请求预先分配评论ID并随幂等键记录", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.MatchedBy(func(r *Idempotency) bool {
+			return r.UserID == "u1" && r.Key == "k1" && r.CommentID == 10 && r.ExpireGmt.After(time.Now())
+		})).Return(true, nil).Once()
+		repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool { return c.ID == 10 })).
+			Return(&Comment{ID: 10, UserID: "u1", Content: "hi"}, nil).Once()
+
+		got, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, &IDGeneratorStub{next: 10}).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), got.CommentId)
+		repo.AssertExpectations(t)
+		idem.AssertExpectations(t)
+	})
+
+	t.Run("评论在事务中写入，失败时释放幂等键", func(t *testing.T) {
+		repo, idem, txn := new(CommentRepoMock), new(IdempotencyRepoMock), new(TxnManagerStub)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(true, nil).Once()
+		repo.On("Save", mock.MatchedBy(inTxn), mock.Anything).Return((*Comment)(nil), errors.New("connection reset")).Once()
+		idem.On("ReleaseIdempotency", mock.MatchedBy(func(ctx context.Context) bool { return !inTxn(ctx) }), "u1", "k1", int64(10)).Return(nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, txn, &IDGeneratorStub{next: 10}).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.Error(t, err)
+		assert.Equal(t, 1, txn.rolledBack)
+		repo.AssertExpectations(t)
+		idem.AssertExpectations(t)
+	})
+
+	t.Run("相同内容重试返回首次创建的评论", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(false, nil).Once()
+		idem.On("GetIdempotency", mock.Anything, "u1", "k1").Return(&Idempotency{
+			UserID: "u1", Key: "k1", Fingerprint: commentFingerprint(newComment("hi")), CommentID: 10,
+		}, nil).Once()
+		repo.On("Get", mock.Anything, int64(10)).Return(&Comment{
+			ID: 10, UserID: "u1", Content: "hi @u2",
+			Mentions:    []*Mention{{CommentID: 10, UserID: "u2", Name: "u2", Offset: 3, Length: 3}},
+			Attachments: []*Attachment{{CommentID: 10, Type: AttachmentImage, URL: "https://img.example.com/a.png"}},
+		}, nil).Once()
+
+		got, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, &IDGeneratorStub{next: 11}).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), got.CommentId)
+		// 重试返回的评论包含首次响应中的提及和附件
+		assert.Len(t, got.Mentions, 1)
+		assert.Len(t, got.Attachments, 1)
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("不同内容复用幂等键返回冲突", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(false, nil).Once()
+		idem.On("GetIdempotency", mock.Anything, "u1", "k1").Return(&Idempotency{
+			UserID: "u1", Key: "k1", Fingerprint: commentFingerprint(newComment("hi")), CommentID: 10,
+		}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, nil).CreateComment(context.Background(), newComment("hello"), "k1")
+		assert.Equal(t, v1.ErrorReason_IDEMPOTENCY_KEY_CONFLICT.String(), kerrors.Reason(err))
+		assert.Equal(t, 409, kerrors.Code(err))
+	})
+
+	t.Run("首次请求仍在处理中", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(false, nil).Once()
+		idem.On("GetIdempotency", mock.Anything, "u1", "k1").Return(&Idempotency{
+			UserID: "u1", Key: "k1", Fingerprint: commentFingerprint(newComment("hi")),
+		}, nil).Once()
+		idem.On("TakeOverIdempotency", mock.Anything, mock.Anything, int64(0), mock.Anything).Return(false, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, nil).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.Equal(t, v1.ErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS.String(), kerrors.Reason(err))
+	})
+
+	t.Run("预先分配的评论尚未写入时仍在处理中", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(false, nil).Once()
+		idem.On("GetIdempotency", mock.Anything, "u1", "k1").Return(&Idempotency{
+			UserID: "u1", Key: "k1", Fingerprint: commentFingerprint(newComment("hi")), CommentID: 10,
+		}, nil).Once()
+		repo.On("Get", mock.Anything, int64(10)).Return((*Comment)(nil), errors.New("record not found")).Once()
+		repo.On("ListByIDs", mock.Anything, []int64{10}).Return([]*Comment{}, nil).Once()
+		// 占用未超时，不能接管
+		idem.On("TakeOverIdempotency", mock.Anything, mock.Anything, int64(10), mock.MatchedBy(func(before time.Time) bool {
+			return time.Until(before) < -59*time.Second && time.Until(before) > -61*time.Second
+		})).Return(false, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, &IDGeneratorStub{next: 11}).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.Equal(t, v1.ErrorReason_IDEMPOTENCY_KEY_IN_PROGRESS.String(), kerrors.Reason(err))
+		idem.AssertExpectations(t)
+	})
+
+	t.Run("占用超时且评论未写入时接管幂等键重新创建", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(false, nil).Once()
+		idem.On("GetIdempotency", mock.Anything, "u1", "k1").Return(&Idempotency{
+			UserID: "u1", Key: "k1", Fingerprint: commentFingerprint(newComment("hi")), CommentID: 10,
+			CreateGmt: time.Now().Add(-time.Hour).UTC(),
+		}, nil).Once()
+		repo.On("Get", mock.Anything, int64(10)).Return((*Comment)(nil), errors.New("record not found")).Once()
+		repo.On("ListByIDs", mock.Anything, []int64{10}).Return([]*Comment{}, nil).Once()
+		idem.On("TakeOverIdempotency", mock.Anything, mock.MatchedBy(func(r *Idempotency) bool {
+			return r.CommentID == 11
+		}), int64(10), mock.Anything).Return(true, nil).Once()
+		repo.On("Save", mock.Anything, mock.Anything).Return(&Comment{ID: 11, UserID: "u1", Content: "hi"}, nil).Once()
+
+		got, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, &IDGeneratorStub{next: 11}).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), got.CommentId)
+		idem.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("创建失败后释放幂等键", func(t *testing.T) {
+		repo, idem := new(CommentRepoMock), new(IdempotencyRepoMock)
+		idem.On("ReserveIdempotency", mock.Anything, mock.Anything).Return(true, nil).Once()
+		repo.On("Save", mock.Anything, mock.Anything).Return((*Comment)(nil), errors.New("数据库保存失败")).Once()
+		idem.On("ReleaseIdempotency", mock.Anything, "u1", "k1", int64(10)).Return(nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, idem, nil, nil, nil, nil, nil, &IDGeneratorStub{next: 10}).CreateComment(context.Background(), newComment("hi"), "k1")
+		assert.Error(t, err)
+		idem.AssertExpectations(t)
+	})
+}
+
+func TestCommentFingerprint(t *testing.T) {
+	a := &Comment{Module: 1, ResourceID: "r1", UserID: "u1", Username: "ab", Avatar: ""}
+	b := &Comment{Module: 1, ResourceID: "r1", UserID: "u1", Username: "a", Avatar: "b"}
+	assert.Equal(t, commentFingerprint(a), commentFingerprint(&Comment{Module: 1, ResourceID: "r1", UserID: "u1", Username: "ab"}))
+	// 字段之间有分隔，拼接结果相同也不会产生相同指纹
+	assert.NotEqual(t, commentFingerprint(a), commentFingerprint(b))
+}
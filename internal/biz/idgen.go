@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// ErrClockMovedBackwards 时钟回拨超过容忍范围，拒绝生成ID以免重复
+var ErrClockMovedBackwards = errors.New("clock moved backwards")
+
+// IDGenerator 评论ID生成器，生成的ID全局唯一且按时间大致递增，不依赖数据库自增
+type IDGenerator interface {
+	// NextID 生成一个包含资源分桶 bucket 的新ID，bucket 取自 ResourceBucket
+	NextID(bucket int64) (int64, error)
+}
+
+const (
+	workerIDBits       = 8
+	sequenceBits       = 6
+	resourceBucketBits = 8
+	maxWorkerID        = 1<<workerIDBits - 1
+	maxSequence        = 1<<sequenceBits - 1
+	maxResourceBucket  = 1<<resourceBucketBits - 1
+
+	// ResourceBuckets 资源分桶数，也是评论表分片数的上限
+	ResourceBuckets = maxResourceBucket + 1
+
+	defaultMaxClockBackward = 10 * time.Millisecond
+)
+
+// snowflakeEpoch ID中时间戳的起点，41 位毫秒时间戳可用到 2093 年
+var snowflakeEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ResourceBucket 资源的分桶，写入评论ID的低位；评论表按分桶分片，只凭评论ID即可定位资源所在的分片表
+func ResourceBucket(module int32, resourceID string) int64 {
+	h := fnv.New32a()
+	h.Write([]byte(strconv.Itoa(int(module))))
+	h.Write([]byte{0})
+	h.Write([]byte(resourceID))
+	return int64(h.Sum32() % ResourceBuckets)
+}
+
+// IDBucket 评论ID中的资源分桶
+func IDBucket(id int64) int64 {
+	return id & maxResourceBucket
+}
+
+// Snowflake 按 41 位毫秒时间戳、8 位机器ID、6 位序列号、8 位资源分桶生成ID，单机每毫秒最多 64 个；
+// 资源分桶在最低位，同一生成器生成的ID严格递增
+type Snowflake struct {
+	mu          sync.Mutex
+	workerID    int64
+	maxBackward time.Duration
+	// lastMillis 上一个ID的时间戳，sequence 为该毫秒内已使用的序列号
+	lastMillis int64
+	sequence   int64
+	now        func() time.Time
+}
+
+// NewIDGenerator 按配置创建 Snowflake ID 生成器，机器ID未配置时从主机名推导，无法创建时退出
+func NewIDGenerator(c *conf.Data) IDGenerator {
+	ic := c.GetIdGenerator()
+	var workerID int64
+	if ic != nil && ic.WorkerId != nil {
+		workerID = int64(ic.GetWorkerId())
+	} else {
+		hostname, err := os.Hostname()
+		if err != nil {
+			log.Fatal(nil, "get hostname error.", "err", err)
+		}
+		workerID = hostnameWorkerID(hostname)
+		log.Info(nil, "derive snowflake worker id from hostname.", "hostname", hostname, "worker_id", workerID)
+	}
+
+	maxBackward := defaultMaxClockBackward
+	if d := ic.GetMaxClockBackward(); d != nil && d.AsDuration() > 0 {
+		maxBackward = d.AsDuration()
+	}
+	s, err := NewSnowflake(workerID, maxBackward)
+	if err != nil {
+		log.Fatal(nil, "new snowflake error.", "err", err)
+	}
+	return s
+}
+
+// NewSnowflake 创建 Snowflake ID 生成器，时钟回拨不超过 maxBackward 时等待时钟追上
+func NewSnowflake(workerID int64, maxBackward time.Duration) (*Snowflake, error) {
+	if workerID < 0 || workerID > maxWorkerID {
+		return nil, fmt.Errorf("snowflake worker id %d out of range [0, %d]", workerID, maxWorkerID)
+	}
+	return &Snowflake{workerID: workerID, maxBackward: maxBackward, now: time.Now}, nil
+}
+
+// hostnameWorkerID 取主机名末尾的数字作为机器ID，如 StatefulSet 的 comment-3；
+// 不以数字结尾时取主机名哈希，不同主机可能冲突，多实例部署时应显式配置
+func hostnameWorkerID(hostname string) int64 {
+	if suffix := hostname[len(strings.TrimRightFunc(hostname, unicode.IsDigit)):]; suffix != "" {
+		if n, err := strconv.ParseInt(suffix, 10, 64); err == nil {
+			return n % (maxWorkerID + 1)
+		}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(hostname))
+	return int64(h.Sum32() % (maxWorkerID + 1))
+}
+
+// NextID 生成一个新的ID：同一毫秒内序列号递增，序列号用尽时等待下一毫秒；
+// 时钟回拨不超过容忍范围时等待时钟追上上一个ID的时间戳，超过时返回 ErrClockMovedBackwards
+func (s *Snowflake) NextID(bucket int64) (int64, error) {
+	if bucket < 0 || bucket > maxResourceBucket {
+		return 0, fmt.Errorf("resource bucket %d out of range [0, %d]", bucket, maxResourceBucket)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	millis := s.millis()
+	if millis < s.lastMillis {
+		backward := time.Duration(s.lastMillis-millis) * time.Millisecond
+		if backward > s.maxBackward {
+			log.Error(nil, "clock moved backwards.", "backward", backward)
+			return 0, fmt.Errorf("%w by %s", ErrClockMovedBackwards, backward)
+		}
+		log.Warn(nil, "clock moved backwards, wait for it to catch up.", "backward", backward)
+		millis = s.waitUntil(s.lastMillis)
+	}
+
+	if millis == s.lastMillis {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			millis = s.waitUntil(s.lastMillis + 1)
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastMillis = millis
+	return millis<<(workerIDBits+sequenceBits+resourceBucketBits) | s.workerID<<(sequenceBits+resourceBucketBits) | s.sequence<<resourceBucketBits | bucket, nil
+}
+
+// millis 当前时间相对 snowflakeEpoch 的毫秒数
+func (s *Snowflake) millis() int64 {
+	return s.now().Sub(snowflakeEpoch).Milliseconds()
+}
+
+// waitUntil 等待时钟到达 target 毫秒，返回当前毫秒数
+func (s *Snowflake) waitUntil(target int64) int64 {
+	millis := s.millis()
+	for millis < target {
+		time.Sleep(time.Duration(target-millis) * time.Millisecond)
+		millis = s.millis()
+	}
+	return millis
+}
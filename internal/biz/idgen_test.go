@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock 手动拨动的时钟，每次读取后按 step 前进
+type fakeClock struct {
+	mu   sync.Mutex
+	now  time.Time
+	step time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now
+	c.now = c.now.Add(c.step)
+	return now
+}
+
+func (c *fakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+func TestSnowflake_NextID(t *testing.T) {
+	t.Run("ID唯一且递增并包含机器ID和资源分桶", func(t *testing.T) {
+		s, err := NewSnowflake(5, time.Millisecond)
+		assert.NoError(t, err)
+		var last int64
+		for i := 0; i < 10000; i++ {
+			bucket := int64(i % ResourceBuckets)
+			id, err := s.NextID(bucket)
+			assert.NoError(t, err)
+			assert.Greater(t, id, last)
+			assert.Equal(t, int64(5), id>>(sequenceBits+resourceBucketBits)&maxWorkerID)
+			assert.Equal(t, bucket, IDBucket(id))
+			last = id
+		}
+	})
+
+	t.Run("同一毫秒序列号用尽时等待下一毫秒", func(t *testing.T) {
+		clock := &fakeClock{now: snowflakeEpoch.Add(time.Hour)}
+		s, _ := NewSnowflake(1, time.Millisecond)
+		s.now = clock.Now
+		for i := 0; i <= maxSequence; i++ {
+			_, err := s.NextID(0)
+			assert.NoError(t, err)
+		}
+		// 时钟在等待期间前进
+		clock.step = time.Millisecond
+		id, err := s.NextID(0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), id>>resourceBucketBits&maxSequence)
+		assert.Equal(t, time.Hour.Milliseconds()+1, id>>(workerIDBits+sequenceBits+resourceBucketBits))
+	})
+
+	t.Run("小幅时钟回拨时等待时钟追上", func(t *testing.T) {
+		clock := &fakeClock{now: snowflakeEpoch.Add(time.Hour)}
+		s, _ := NewSnowflake(1, 10*time.Millisecond)
+		s.now = clock.Now
+		first, _ := s.NextID(0)
+
+		clock.Set(snowflakeEpoch.Add(time.Hour - 5*time.Millisecond))
+		clock.step = time.Millisecond
+		id, err := s.NextID(0)
+		assert.NoError(t, err)
+		assert.Greater(t, id, first)
+	})
+
+	t.Run("时钟回拨超过容忍范围时拒绝生成", func(t *testing.T) {
+		clock := &fakeClock{now: snowflakeEpoch.Add(time.Hour)}
+		s, _ := NewSnowflake(1, 10*time.Millisecond)
+		s.now = clock.Now
+		_, _ = s.NextID(0)
+
+		clock.Set(snowflakeEpoch.Add(time.Hour - time.Second))
+		_, err := s.NextID(0)
+		assert.ErrorIs(t, err, ErrClockMovedBackwards)
+	})
+}
+
+func TestNewSnowflake_InvalidWorkerID(t *testing.T) {
+	_, err := NewSnowflake(maxWorkerID+1, 0)
+	assert.Error(t, err)
+	_, err = NewSnowflake(-1, 0)
+	assert.Error(t, err)
+}
+
+func TestSnowflake_InvalidBucket(t *testing.T) {
+	s, _ := NewSnowflake(1, 0)
+	_, err := s.NextID(ResourceBuckets)
+	assert.Error(t, err)
+	_, err = s.NextID(-1)
+	assert.Error(t, err)
+}
+
+func TestResourceBucket(t *testing.T) {
+	assert.Equal(t, ResourceBucket(1, "r1"), ResourceBucket(1, "r1"))
+	assert.NotEqual(t, ResourceBucket(1, "r1"), ResourceBucket(2, "r1"))
+	for i := 0; i < 1000; i++ {
+		bucket := ResourceBucket(1, strconv.Itoa(i))
+		assert.GreaterOrEqual(t, bucket, int64(0))
+		assert.Less(t, bucket, int64(ResourceBuckets))
+	}
+}
+
+func TestHostnameWorkerID(t *testing.T) {
+	assert.Equal(t, int64(3), hostnameWorkerID("comment-3"))
+	assert.Equal(t, int64(1), hostnameWorkerID("comment-1025"))
+	assert.Equal(t, hostnameWorkerID("web"), hostnameWorkerID("web"))
+	assert.LessOrEqual(t, hostnameWorkerID("web"), int64(maxWorkerID))
+}
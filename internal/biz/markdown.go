@@ -0,0 +1,216 @@
+package biz
+
+import (
+	"html"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContentRenderer 将评论内容渲染为安全的 HTML 和纯文本
+type ContentRenderer interface {
+	Render(content string) (contentHTML, contentText string)
+}
+
+// markdownRenderer 受限 Markdown 渲染器，只支持粗体、斜体、行内代码、代码块、链接和引用，
+// 其余内容一律按纯文本转义，不会输出原始 HTML
+type markdownRenderer struct{}
+
+// linkRel 链接统一添加的 rel 属性，避免评论中的链接被用于提升权重或获取来源页面
+const linkRel = "nofollow noopener noreferrer"
+
+// Render 渲染评论内容，返回 HTML 和去除标记后的纯文本
+func (markdownRenderer) Render(content string) (string, string) {
+	var h, t strings.Builder
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	var paragraph, quote []string
+	flushParagraph := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		h.WriteString("<p>")
+		renderLines(paragraph, &h, &t)
+		h.WriteString("</p>")
+		t.WriteString("\n")
+		paragraph = nil
+	}
+	flushQuote := func() {
+		if len(quote) == 0 {
+			return
+		}
+		h.WriteString("<blockquote><p>")
+		renderLines(quote, &h, &t)
+		h.WriteString("</p></blockquote>")
+		t.WriteString("\n")
+		quote = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			// 代码块：直到下一个 ``` 行，未闭合时延续到内容末尾
+			flushParagraph()
+			flushQuote()
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			block := strings.Join(code, "\n")
+			h.WriteString("<pre><code>")
+			h.WriteString(html.EscapeString(block))
+			h.WriteString("</code></pre>")
+			t.WriteString(block)
+			t.WriteString("\n")
+		case strings.HasPrefix(trimmed, ">"):
+			flushParagraph()
+			quote = append(quote, strings.TrimPrefix(strings.TrimPrefix(trimmed, ">"), " "))
+		case trimmed == "":
+			flushParagraph()
+			flushQuote()
+		default:
+			flushQuote()
+			paragraph = append(paragraph, line)
+		}
+	}
+	flushParagraph()
+	flushQuote()
+
+	return h.String(), strings.TrimRight(t.String(), "\n")
+}
+
+// renderLines 渲染段落内的多行文本，行之间以 <br> 分隔
+func renderLines(lines []string, h, t *strings.Builder) {
+	for i, line := range lines {
+		if i > 0 {
+			h.WriteString("<br>")
+			t.WriteString("\n")
+		}
+		renderInline(line, h, t)
+	}
+}
+
+// renderInline 渲染行内标记：**粗体**、*斜体* 或 _斜体_、`代码`、[文本](链接)，\ 转义标记字符
+func renderInline(s string, h, t *strings.Builder) {
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_[]()>", s[i+1]) >= 0:
+			writeText(s[i+1:i+2], h, t)
+			i += 2
+			continue
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end > 0 {
+				code := s[i+1 : i+1+end]
+				h.WriteString("<code>")
+				h.WriteString(html.EscapeString(code))
+				h.WriteString("</code>")
+				t.WriteString(code)
+				i += end + 2
+				continue
+			}
+		case c == '*' && strings.HasPrefix(s[i:], "**"):
+			if end := strings.Index(s[i+2:], "**"); end > 0 && isEmphasisContent(s[i+2:i+2+end]) {
+				h.WriteString("<strong>")
+				renderInline(s[i+2:i+2+end], h, t)
+				h.WriteString("</strong>")
+				i += end + 4
+				continue
+			}
+		case c == '*' || c == '_':
+			if end := closingEmphasis(s, i); end > 0 {
+				h.WriteString("<em>")
+				renderInline(s[i+1:end], h, t)
+				h.WriteString("</em>")
+				i = end + 1
+				continue
+			}
+		case c == '[':
+			if label, href, n, ok := parseLink(s[i:]); ok {
+				h.WriteString(`<a href="`)
+				h.WriteString(html.EscapeString(href))
+				h.WriteString(`" rel="` + linkRel + `" target="_blank">`)
+				renderInline(label, h, t)
+				h.WriteString("</a>")
+				i += n
+				continue
+			}
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		writeText(s[i:i+size], h, t)
+		i += size
+	}
+}
+
+// closingEmphasis 查找斜体的结束标记位置，找不到时返回 -1
+// _ 只在单词边界处生效，避免误识别 snake_case 之类的标识符
+func closingEmphasis(s string, start int) int {
+	marker := s[start]
+	if marker == '_' && start > 0 && isWordByte(s, start-1) {
+		return -1
+	}
+	for end := start + 1; end < len(s); end++ {
+		if s[end] != marker {
+			continue
+		}
+		if marker == '*' && end+1 < len(s) && s[end+1] == '*' {
+			// 跳过粗体标记
+			end++
+			continue
+		}
+		if marker == '_' && end+1 < len(s) && isWordByte(s, end+1) {
+			continue
+		}
+		if isEmphasisContent(s[start+1 : end]) {
+			return end
+		}
+		return -1
+	}
+	return -1
+}
+
+// isEmphasisContent 强调内容不能为空，也不能以空白开头或结尾
+func isEmphasisContent(s string) bool {
+	if s == "" {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	last, _ := utf8.DecodeLastRuneInString(s)
+	return !unicode.IsSpace(first) && !unicode.IsSpace(last)
+}
+
+// isWordByte 判断 s[i] 所在的字符是否为字母或数字
+func isWordByte(s string, i int) bool {
+	for i > 0 && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// parseLink 解析 [文本](链接)，只接受 http(s) 链接，返回文本、链接和消耗的字节数
+func parseLink(s string) (label, href string, n int, ok bool) {
+	closeLabel := strings.Index(s, "](")
+	if closeLabel <= 1 {
+		return "", "", 0, false
+	}
+	closeHref := strings.IndexByte(s[closeLabel+2:], ')')
+	if closeHref <= 0 {
+		return "", "", 0, false
+	}
+	label = s[1:closeLabel]
+	href = strings.TrimSpace(s[closeLabel+2 : closeLabel+2+closeHref])
+	u, err := url.Parse(href)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", "", 0, false
+	}
+	return label, u.String(), closeLabel + 2 + closeHref + 1, true
+}
+
+// writeText 输出转义后的普通文本
+func writeText(s string, h, t *strings.Builder) {
+	h.WriteString(html.EscapeString(s))
+	t.WriteString(s)
+}
@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMarkdownRenderer_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantHTML string
+		wantText string
+	}{
+		{
+			name:     "纯文本",
+			content:  "hello world",
+			wantHTML: "<p>hello world</p>",
+			wantText: "hello world",
+		},
+		{
+			name:     "粗体和斜体",
+			content:  "**bold** and *italic* and _em_",
+			wantHTML: "<p><strong>bold</strong> and <em>italic</em> and <em>em</em></p>",
+			wantText: "bold and italic and em",
+		},
+		{
+			name:     "粗体内嵌套斜体",
+			content:  "**very *nice* day**",
+			wantHTML: "<p><strong>very <em>nice</em> day</strong></p>",
+			wantText: "very nice day",
+		},
+		{
+			name:     "单词内的下划线不是斜体",
+			content:  "use snake_case_name here",
+			wantHTML: "<p>use snake_case_name here</p>",
+			wantText: "use snake_case_name here",
+		},
+		{
+			name:     "行内代码不解析标记",
+			content:  "run `a **b** <c>`",
+			wantHTML: "<p>run <code>a **b** &lt;c&gt;</code></p>",
+			wantText: "run a **b** <c>",
+		},
+		{
+			name:     "代码块",
+			content:  "```\nfmt.Println(\"<hi>\")\n```",
+			wantHTML: "<pre><code>fmt.Println(&#34;&lt;hi&gt;&#34;)</code></pre>",
+			wantText: "fmt.Println(\"<hi>\")",
+		},
+		{
+			name:     "链接",
+			content:  "see [docs](https://example.com/a?b=1&c=2)",
+			wantHTML: `<p>see <a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener noreferrer" target="_blank">docs</a></p>`,
+			wantText: "see docs",
+		},
+		{
+			name:     "不安全的链接按文本输出",
+			content:  "[x](javascript:alert(1))",
+			wantHTML: "<p>[x](javascript:alert(1))</p>",
+			wantText: "[x](javascript:alert(1))",
+		},
+		{
+			name:     "引用和段落",
+			content:  "> quoted\n> more\n\nreply\nline2",
+			wantHTML: "<blockquote><p>quoted<br>more</p></blockquote><p>reply<br>line2</p>",
+			wantText: "quoted\nmore\nreply\nline2",
+		},
+		{
+			name:     "HTML 被转义",
+			content:  `<script>alert("x")</script>`,
+			wantHTML: "<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</p>",
+			wantText: `<script>alert("x")</script>`,
+		},
+		{
+			name:     "转义标记字符",
+			content:  `\*not italic\*`,
+			wantHTML: "<p>*not italic*</p>",
+			wantText: "*not italic*",
+		},
+		{
+			name:     "未闭合的标记",
+			content:  "a * b ** c",
+			wantHTML: "<p>a * b ** c</p>",
+			wantText: "a * b ** c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHTML, gotText := markdownRenderer{}.Render(tt.content)
+			assert.Equal(t, tt.wantHTML, gotHTML)
+			assert.Equal(t, tt.wantText, gotText)
+		})
+	}
+}
+
+func TestCommentUsecase_CreateComment_Render(t *testing.T) {
+	repo := new(CommentRepoMock)
+	repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool {
+		return c.ContentHTML == "<p><strong>hi</strong></p>" && c.ContentText == "hi"
+	})).Return(&Comment{ID: 1, Content: "**hi**", ContentHTML: "<p><strong>hi</strong></p>", ContentText: "hi"}, nil).Once()
+
+	c := &conf.Data{Markdown: &conf.Data_Markdown{Enabled: true}}
+	got, err := NewCommentUsecase(c, repo, nil, nil, nil, nil, nil, nil, nil).CreateComment(context.Background(), &Comment{UserID: "u1", Content: "**hi**"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "<p><strong>hi</strong></p>", got.ContentHtml)
+	assert.Equal(t, "hi", got.ContentText)
+	repo.AssertExpectations(t)
+}
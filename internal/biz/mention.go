@@ -0,0 +1,77 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"regexp"
+	"time"
+	"unicode/utf8"
+)
+
+// mentionPattern 匹配 @username 或 @user_id，@ 前不能紧跟字母数字（避免误识别邮箱等）
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])(@([\p{L}\p{N}_\-]{1,32}))`)
+
+// Mention 评论内容中的 @ 提及
+type Mention struct {
+	// ID 提及记录唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// CommentID 提及所在的评论ID
+	CommentID int64 `gorm:"column:comment_id;type:bigint;not null;index:idx_mention_comment"`
+
+	// UserID 被提及用户ID，无法解析为用户时为空，不计入该用户的提及列表
+	UserID string `gorm:"column:user_id;type:varchar(32);not null;index:idx_mention_user_create,priority:1"`
+
+	// Name @ 之后的原始文本
+	Name string `gorm:"column:name;type:varchar(32);not null"`
+
+	// Offset 提及片段在评论内容中的起始位置（按字符计）
+	Offset int32 `gorm:"column:span_offset;type:int;not null"`
+
+	// Length 提及片段的长度（按字符计，包含 @）
+	Length int32 `gorm:"column:span_length;type:int;not null"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP;index:idx_mention_user_create,priority:2"`
+}
+
+func (m *Mention) TableName() string {
+	return "comment_mention"
+}
+
+// parseMentions 解析评论内容中的 @ 提及，返回按出现顺序排列的提及片段
+func parseMentions(content string) []*Mention {
+	matches := mentionPattern.FindAllStringSubmatchIndex(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	mentions := make([]*Mention, 0, len(matches))
+	for _, m := range matches {
+		// m[2], m[3] 为 "@name" 的字节区间，m[4], m[5] 为 name 的字节区间
+		name := content[m[4]:m[5]]
+		// 被提及用户ID由仓储在写入时解析
+		mentions = append(mentions, &Mention{
+			Name:   name,
+			Offset: int32(utf8.RuneCountInString(content[:m[2]])),
+			Length: int32(utf8.RuneCountInString(content[m[2]:m[3]])),
+		})
+	}
+	return mentions
+}
+
+// convertToAPIMentions 将提及片段转换为 API 格式
+func convertToAPIMentions(mentions []*Mention) []*v1.Mention {
+	if len(mentions) == 0 {
+		return nil
+	}
+	apiMentions := make([]*v1.Mention, len(mentions))
+	for i, m := range mentions {
+		apiMentions[i] = &v1.Mention{
+			UserId: m.UserID,
+			Name:   m.Name,
+			Offset: m.Offset,
+			Length: m.Length,
+		}
+	}
+	return apiMentions
+}
@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*Mention
+	}{
+		{
+			name:    "没有提及",
+			content: "这是一条普通评论",
+			want:    nil,
+		},
+		{
+			name:    "单个提及",
+			content: "@user1 你好",
+			want: []*Mention{
+				{Name: "user1", Offset: 0, Length: 6},
+			},
+		},
+		{
+			name:    "中文用户名按字符计算位置",
+			content: "同意 @张三 和 @李四 的观点",
+			want: []*Mention{
+				{Name: "张三", Offset: 3, Length: 3},
+				{Name: "李四", Offset: 9, Length: 3},
+			},
+		},
+		{
+			name:    "忽略邮箱地址",
+			content: "联系 test@example.com",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseMentions(tt.content))
+		})
+	}
+}
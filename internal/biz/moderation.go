@@ -0,0 +1,36 @@
+package biz
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 评论审核状态，取值与 v1.Comment_ModerationStatus 一致；默认值为审核通过，无需审核的评论直接展示
+const (
+	ModerationApproved int32 = 0
+	ModerationPending  int32 = 1
+	ModerationRejected int32 = 2
+)
+
+// ApproveComment 审核通过评论，评论随后出现在评论列表中并推送给实时订阅者
+func (uc *CommentUsecase) ApproveComment(ctx context.Context, commentID int64) (*Comment, error) {
+	return uc.moderateComment(ctx, commentID, ModerationApproved)
+}
+
+// RejectComment 审核拒绝评论，评论保留但不再展示
+func (uc *CommentUsecase) RejectComment(ctx context.Context, commentID int64) (*Comment, error) {
+	return uc.moderateComment(ctx, commentID, ModerationRejected)
+}
+
+func (uc *CommentUsecase) moderateComment(ctx context.Context, commentID int64, status int32) (*Comment, error) {
+	log.Debug(ctx, "moderate comment.", "comment_id", commentID, "status", status)
+	comment, err := uc.repo.ModerateComment(ctx, commentID, status)
+	if err != nil {
+		log.Error(ctx, "moderate comment error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "moderate comment error.")
+	}
+	log.Info(ctx, "repo moderate comment successful.", "comment_id", commentID, "status", status)
+	return comment, nil
+}
@@ -0,0 +1,31 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentUsecase_Moderation(t *testing.T) {
+	t.Run("审核通过", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ModerateComment", mock.Anything, int64(1), ModerationApproved).Return(&Comment{ID: 1}, nil).Once()
+
+		got, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).ApproveComment(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), got.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("审核拒绝", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ModerateComment", mock.Anything, int64(1), ModerationRejected).Return(nil, stderrors.New("record not found")).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).RejectComment(context.Background(), 1)
+		assert.Error(t, err)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,201 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ReactionLike 点赞
+const ReactionLike = "like"
+
+// 评论排序类型，取值与 v1.GetCommentRequest_SortType 一致
+const (
+	// SortUnspecified 未指定排序，使用模块的默认排序
+	SortUnspecified int32 = -1
+	// SortLikeCountDesc 按点赞数降序
+	SortLikeCountDesc int32 = 0
+	// SortCreateTimeDesc 按创建时间降序
+	SortCreateTimeDesc int32 = 1
+)
+
+// ModerationPolicy 模块审核策略
+type ModerationPolicy string
+
+const (
+	// ModerationNone 先发后审，新评论直接展示
+	ModerationNone ModerationPolicy = "none"
+	// ModerationPre 先审后发，新评论审核通过前不展示
+	ModerationPre ModerationPolicy = "pre"
+)
+
+// defaultMaxContentLength 默认评论内容最大字符数，与 CreateCommentRequest.content 的校验规则一致
+const defaultMaxContentLength = 2000
+
+// ModuleConfig 业务模块配置
+type ModuleConfig struct {
+	ID               int32
+	Name             string
+	MaxContentLength int
+	MaxLevel         int32
+	Reactions        []string
+	DefaultSort      int32
+	Moderation       ModerationPolicy
+}
+
+// AllowReaction 判断模块是否允许该互动类型
+func (m *ModuleConfig) AllowReaction(reaction string) bool {
+	for _, r := range m.Reactions {
+		if r == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// ModuleRegistry 业务模块注册表
+type ModuleRegistry struct {
+	modules map[int32]*ModuleConfig
+}
+
+// defaultModules 未配置模块时使用的内置模块
+func defaultModules() []*ModuleConfig {
+	return []*ModuleConfig{
+		{ID: 1, Name: "article", MaxContentLength: defaultMaxContentLength, Reactions: []string{ReactionLike}, DefaultSort: SortLikeCountDesc, Moderation: ModerationNone},
+		{ID: 2, Name: "video", MaxContentLength: defaultMaxContentLength, Reactions: []string{ReactionLike}, DefaultSort: SortLikeCountDesc, Moderation: ModerationNone},
+	}
+}
+
+// NewModuleRegistry 根据配置创建业务模块注册表，未配置模块时使用内置的文章和视频模块
+func NewModuleRegistry(c *conf.Data) *ModuleRegistry {
+	r := &ModuleRegistry{modules: make(map[int32]*ModuleConfig)}
+	if len(c.GetModules()) == 0 {
+		for _, m := range defaultModules() {
+			r.modules[m.ID] = m
+		}
+		return r
+	}
+
+	for _, mc := range c.GetModules() {
+		m := &ModuleConfig{
+			ID:               mc.Id,
+			Name:             mc.Name,
+			MaxContentLength: defaultMaxContentLength,
+			MaxLevel:         mc.MaxLevel,
+			Reactions:        mc.Reactions,
+			DefaultSort:      SortLikeCountDesc,
+			Moderation:       ModerationNone,
+		}
+		if mc.MaxContentLength > 0 {
+			m.MaxContentLength = int(mc.MaxContentLength)
+		}
+		switch mc.DefaultSort {
+		case "", "like_count":
+		case "create_time":
+			m.DefaultSort = SortCreateTimeDesc
+		default:
+			log.Fatal(nil, "module default sort error.", "module", mc.Id, "default_sort", mc.DefaultSort)
+		}
+		switch p := ModerationPolicy(mc.Moderation); p {
+		case "":
+		case ModerationNone, ModerationPre:
+			m.Moderation = p
+		default:
+			log.Fatal(nil, "module moderation error.", "module", mc.Id, "moderation", mc.Moderation)
+		}
+		if _, ok := r.modules[m.ID]; ok {
+			log.Fatal(nil, "module duplicated.", "module", mc.Id)
+		}
+		r.modules[m.ID] = m
+	}
+	return r
+}
+
+// Get 获取业务模块配置，模块未注册时返回 UNKNOWN_MODULE 错误
+func (r *ModuleRegistry) Get(module int32) (*ModuleConfig, error) {
+	m, ok := r.modules[module]
+	if !ok {
+		return nil, errors.BadRequest(v1.ErrorReason_UNKNOWN_MODULE.String(), "unknown module.")
+	}
+	return m, nil
+}
+
+// ValidateModule 按业务模块规则校验请求参数，供校验中间件使用
+// content 为空时不校验内容长度，level 为 0 时不校验层级
+func (r *ModuleRegistry) ValidateModule(module int32, content string, level int32) error {
+	m, err := r.Get(module)
+	if err != nil {
+		return err
+	}
+	if err := m.checkContent(content); err != nil {
+		return err
+	}
+	return m.checkLevel(level)
+}
+
+// checkContent 校验评论内容长度，按字符计
+func (m *ModuleConfig) checkContent(content string) error {
+	if utf8.RuneCountInString(content) > m.MaxContentLength {
+		return errors.BadRequest(v1.ErrorReason_CONTENT_TOO_LONG.String(), "content too long.")
+	}
+	return nil
+}
+
+// checkLevel 校验评论层级
+func (m *ModuleConfig) checkLevel(level int32) error {
+	if m.MaxLevel > 0 && level > m.MaxLevel {
+		return errors.BadRequest(v1.ErrorReason_REPLY_DEPTH_EXCEEDED.String(), "reply depth exceeded.")
+	}
+	return nil
+}
+
+// applyModuleRules 发表评论时应用业务模块规则：
+// 校验模块是否注册、内容长度和回复层级，先审后发的模块新评论先隐藏
+func (uc *CommentUsecase) applyModuleRules(ctx context.Context, c *Comment, parent *Comment) error {
+	if uc.modules == nil {
+		return nil
+	}
+	m, err := uc.modules.Get(c.Module)
+	if err != nil {
+		log.Warn(ctx, "unknown module.", "module", c.Module)
+		return err
+	}
+	if err := m.checkContent(c.Content); err != nil {
+		return err
+	}
+	// 回复评论的层级以被回复评论为准
+	level := c.Level
+	if parent != nil {
+		level = parent.Level + 1
+	}
+	if err := m.checkLevel(level); err != nil {
+		log.Warn(ctx, "reply depth exceeded.", "module", c.Module, "level", level, "max_level", m.MaxLevel)
+		return err
+	}
+	if m.Moderation == ModerationPre {
+		c.ModerationStatus = ModerationPending
+	}
+	return nil
+}
+
+// resolveSortType 校验业务模块，未指定排序时返回模块的默认排序
+func (uc *CommentUsecase) resolveSortType(module int32, sortType int32) (int32, error) {
+	if uc.modules == nil {
+		if sortType == SortUnspecified {
+			return SortLikeCountDesc, nil
+		}
+		return sortType, nil
+	}
+	m, err := uc.modules.Get(module)
+	if err != nil {
+		return 0, err
+	}
+	if sortType == SortUnspecified {
+		return m.DefaultSort, nil
+	}
+	return sortType, nil
+}
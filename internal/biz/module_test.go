@@ -0,0 +1,103 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"context"
+	"strings"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestModuleRegistry() *ModuleRegistry {
+	return NewModuleRegistry(&conf.Data{Modules: []*conf.Data_Module{
+		{Id: 1, Name: "article", MaxContentLength: 10, MaxLevel: 2, Reactions: []string{ReactionLike}},
+		{Id: 3, Name: "live", DefaultSort: "create_time", Moderation: "pre"},
+	}})
+}
+
+func TestNewModuleRegistry(t *testing.T) {
+	t.Run("未配置时使用内置模块", func(t *testing.T) {
+		r := NewModuleRegistry(nil)
+		for _, module := range []int32{1, 2} {
+			m, err := r.Get(module)
+			assert.NoError(t, err)
+			assert.True(t, m.AllowReaction(ReactionLike))
+		}
+		_, err := r.Get(3)
+		assert.Equal(t, v1.ErrorReason_UNKNOWN_MODULE.String(), kerrors.Reason(err))
+	})
+
+	t.Run("按配置加载", func(t *testing.T) {
+		r := newTestModuleRegistry()
+		_, err := r.Get(2)
+		assert.Equal(t, v1.ErrorReason_UNKNOWN_MODULE.String(), kerrors.Reason(err))
+
+		live, err := r.Get(3)
+		assert.NoError(t, err)
+		assert.Equal(t, defaultMaxContentLength, live.MaxContentLength)
+		assert.Equal(t, SortCreateTimeDesc, live.DefaultSort)
+		assert.Equal(t, ModerationPre, live.Moderation)
+		assert.False(t, live.AllowReaction(ReactionLike))
+	})
+}
+
+func TestModuleRegistry_ValidateModule(t *testing.T) {
+	r := newTestModuleRegistry()
+	assert.NoError(t, r.ValidateModule(1, "你好，世界", 2))
+	assert.Equal(t, v1.ErrorReason_CONTENT_TOO_LONG.String(), kerrors.Reason(r.ValidateModule(1, strings.Repeat("字", 11), 0)))
+	assert.Equal(t, v1.ErrorReason_REPLY_DEPTH_EXCEEDED.String(), kerrors.Reason(r.ValidateModule(1, "hi", 3)))
+	assert.Equal(t, v1.ErrorReason_UNKNOWN_MODULE.String(), kerrors.Reason(r.ValidateModule(4, "hi", 0)))
+}
+
+func TestCommentUsecase_ModuleRules(t *testing.T) {
+	t.Run("回复层级以被回复评论为准", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("Get", mock.Anything, int64(2)).Return(&Comment{ID: 2, Module: 1, Level: 2}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, newTestModuleRegistry(), nil, nil).CreateComment(context.Background(), &Comment{
+			Module: 1, ResourceID: "r1", UserID: "u1", Content: "hi", ParentCommentID: 2, Level: 1,
+		}, "")
+		assert.Equal(t, v1.ErrorReason_REPLY_DEPTH_EXCEEDED.String(), kerrors.Reason(err))
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("先审后发的模块新评论进入待审核", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool { return c.ModerationStatus == ModerationPending && !c.Hidden })).
+			Return(&Comment{ID: 1, Module: 3, ModerationStatus: ModerationPending}, nil).Once()
+
+		got, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, newTestModuleRegistry(), nil, nil).CreateComment(context.Background(), &Comment{
+			Module: 3, ResourceID: "r1", UserID: "u1", Content: "hi",
+		}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, v1.Comment_PENDING, got.ModerationStatus)
+	})
+
+	t.Run("模块不允许点赞", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("Get", mock.Anything, int64(1)).Return(&Comment{ID: 1, Module: 3}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, newTestModuleRegistry(), nil, nil).LikeComment(context.Background(), 1, "u1")
+		assert.Equal(t, v1.ErrorReason_REACTION_NOT_ALLOWED.String(), kerrors.Reason(err))
+		repo.AssertNotCalled(t, "LikeComment", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("未指定排序时使用模块默认排序", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ListRootComments", mock.Anything, int32(3), "r1", int32(1), int32(10), SortCreateTimeDesc, []string(nil)).
+			Return([]*Comment{}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, newTestModuleRegistry(), nil, nil).GetComments(context.Background(), 3, "r1", 0, 1, 10, SortUnspecified, "")
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("未注册的模块", func(t *testing.T) {
+		_, err := NewCommentUsecase(nil, new(CommentRepoMock), nil, nil, nil, nil, newTestModuleRegistry(), nil, nil).GetComments(context.Background(), 2, "r1", 0, 1, 10, SortUnspecified, "")
+		assert.Equal(t, v1.ErrorReason_UNKNOWN_MODULE.String(), kerrors.Reason(err))
+	})
+}
@@ -15,13 +15,13 @@ type MockCommentRepo struct {
 	mock.Mock
 }
 
-func (m *MockCommentRepo) ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize, sortType int32) ([]*Comment, error) {
-	args := m.Called(ctx, module, resourceID, page, pageSize, sortType)
+func (m *MockCommentRepo) ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize, sortType int32, excludeUserIDs []string) ([]*Comment, error) {
+	args := m.Called(ctx, module, resourceID, page, pageSize, sortType, excludeUserIDs)
 	return args.Get(0).([]*Comment), args.Error(1)
 }
 
-func (m *MockCommentRepo) ListReplyComments(ctx context.Context, rootIDs []int64, replyLimit int32, sortType int32) ([]*Comment, error) {
-	args := m.Called(ctx, rootIDs, replyLimit, sortType)
+func (m *MockCommentRepo) ListReplyComments(ctx context.Context, module int32, resourceID string, rootIDs []int64, replyLimit int32, sortType int32, excludeUserIDs []string) ([]*Comment, error) {
+	args := m.Called(ctx, module, resourceID, rootIDs, replyLimit, sortType, excludeUserIDs)
 	return args.Get(0).([]*Comment), args.Error(1)
 }
 
@@ -56,12 +56,50 @@ func (m *MockCommentRepo) UnlikeComment(ctx context.Context, commentID int64, us
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockCommentRepo) ListMentions(ctx context.Context, userID string, page, pageSize int32) ([]*Comment, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func (m *MockCommentRepo) ListUserComments(ctx context.Context, q *UserCommentQuery) ([]*Comment, error) {
+	args := m.Called(ctx, q)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func (m *MockCommentRepo) ListByIDs(ctx context.Context, ids []int64) ([]*Comment, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*Comment), args.Error(1)
+}
+
+func (m *MockCommentRepo) ReportComment(ctx context.Context, report *Report, hideThreshold int64) (int64, bool, error) {
+	args := m.Called(ctx, report, hideThreshold)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *MockCommentRepo) ListReportedComments(ctx context.Context, filter *ReportFilter, recentLimit int) ([]*ReportedComment, error) {
+	args := m.Called(ctx, filter, recentLimit)
+	return args.Get(0).([]*ReportedComment), args.Error(1)
+}
+
+func (m *MockCommentRepo) ResolveReports(ctx context.Context, commentID int64, status int32, hidden bool) (int64, error) {
+	args := m.Called(ctx, commentID, status, hidden)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCommentRepo) ModerateComment(ctx context.Context, commentID int64, status int32) (*Comment, error) {
+	args := m.Called(ctx, commentID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Comment), args.Error(1)
+}
+
 func TestCommentUsecase_GetComments_Pagination(t *testing.T) {
 	// 创建测试用例
 	t.Run("Should return correct page when using pagination", func(t *testing.T) {
 		// 准备模拟数据
 		mockRepo := new(MockCommentRepo)
-		uc := NewCommentUsecase(mockRepo)
+		uc := NewCommentUsecase(nil, mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		// 创建预期的评论数据
 		comments := []*Comment{
@@ -94,11 +132,11 @@ func TestCommentUsecase_GetComments_Pagination(t *testing.T) {
 		}
 
 		// 设置模拟对象的行为
-		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0)).Return(comments, nil)
-		mockRepo.On("ListReplyComments", mock.Anything, []int64{1, 2}, int32(5), int32(0)).Return([]*Comment{}, nil)
+		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0), []string(nil)).Return(comments, nil)
+		mockRepo.On("ListReplyComments", mock.Anything, int32(1), "article1", []int64{1, 2}, int32(5), int32(0), []string(nil)).Return([]*Comment{}, nil)
 
 		// 执行测试
-		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0)
+		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0, "")
 
 		// 验证结果
 		assert.NoError(t, err)
@@ -112,7 +150,7 @@ func TestCommentUsecase_GetComments_Sorting(t *testing.T) {
 	t.Run("Should sort comments by like count desc when sort type is 0", func(t *testing.T) {
 		// 准备模拟数据
 		mockRepo := new(MockCommentRepo)
-		uc := NewCommentUsecase(mockRepo)
+		uc := NewCommentUsecase(nil, mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		// 创建预期的评论数据 - 按点赞数降序排列
 		comments := []*Comment{
@@ -145,11 +183,11 @@ func TestCommentUsecase_GetComments_Sorting(t *testing.T) {
 		}
 
 		// 设置模拟对象的行为 - 使用默认排序类型(0: 按点赞数降序)
-		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0)).Return(comments, nil)
-		mockRepo.On("ListReplyComments", mock.Anything, []int64{3, 4}, int32(5), int32(0)).Return([]*Comment{}, nil)
+		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0), []string(nil)).Return(comments, nil)
+		mockRepo.On("ListReplyComments", mock.Anything, int32(1), "article1", []int64{3, 4}, int32(5), int32(0), []string(nil)).Return([]*Comment{}, nil)
 
 		// 执行测试
-		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0)
+		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0, "")
 
 		// 验证结果
 		assert.NoError(t, err)
@@ -162,7 +200,7 @@ func TestCommentUsecase_GetComments_Sorting(t *testing.T) {
 	t.Run("Should sort comments by create time desc when sort type is 1", func(t *testing.T) {
 		// 准备模拟数据
 		mockRepo := new(MockCommentRepo)
-		uc := NewCommentUsecase(mockRepo)
+		uc := NewCommentUsecase(nil, mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		// 创建预期的评论数据 - 按创建时间降序排列
 		comments := []*Comment{
@@ -195,11 +233,11 @@ func TestCommentUsecase_GetComments_Sorting(t *testing.T) {
 		}
 
 		// 设置模拟对象的行为 - 使用创建时间排序类型(1: 按创建时间降序)
-		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(1)).Return(comments, nil)
-		mockRepo.On("ListReplyComments", mock.Anything, []int64{5, 6}, int32(5), int32(1)).Return([]*Comment{}, nil)
+		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(1), []string(nil)).Return(comments, nil)
+		mockRepo.On("ListReplyComments", mock.Anything, int32(1), "article1", []int64{5, 6}, int32(5), int32(1), []string(nil)).Return([]*Comment{}, nil)
 
 		// 执行测试
-		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 1)
+		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 1, "")
 
 		// 验证结果
 		assert.NoError(t, err)
@@ -215,13 +253,13 @@ func TestCommentUsecase_GetComments_Error(t *testing.T) {
 	t.Run("Should return error when repo returns error", func(t *testing.T) {
 		// 准备模拟数据
 		mockRepo := new(MockCommentRepo)
-		uc := NewCommentUsecase(mockRepo)
+		uc := NewCommentUsecase(nil, mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		// 设置模拟对象的行为 - 返回错误
-		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0)).Return([]*Comment{}, gorm.ErrRecordNotFound)
+		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0), []string(nil)).Return([]*Comment{}, gorm.ErrRecordNotFound)
 
 		// 执行测试
-		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0)
+		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0, "")
 
 		// 验证结果
 		assert.Error(t, err)
@@ -232,7 +270,7 @@ func TestCommentUsecase_GetComments_Error(t *testing.T) {
 	t.Run("Should return error when reply comments query fails", func(t *testing.T) {
 		// 准备模拟数据
 		mockRepo := new(MockCommentRepo)
-		uc := NewCommentUsecase(mockRepo)
+		uc := NewCommentUsecase(nil, mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		// 创建预期的评论数据
 		comments := []*Comment{
@@ -252,11 +290,11 @@ func TestCommentUsecase_GetComments_Error(t *testing.T) {
 		}
 
 		// 设置模拟对象的行为 - 根评论成功，回复评论失败
-		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0)).Return(comments, nil)
-		mockRepo.On("ListReplyComments", mock.Anything, []int64{7}, int32(5), int32(0)).Return([]*Comment{}, gorm.ErrRecordNotFound)
+		mockRepo.On("ListRootComments", mock.Anything, int32(1), "article1", int32(1), int32(10), int32(0), []string(nil)).Return(comments, nil)
+		mockRepo.On("ListReplyComments", mock.Anything, int32(1), "article1", []int64{7}, int32(5), int32(0), []string(nil)).Return([]*Comment{}, gorm.ErrRecordNotFound)
 
 		// 执行测试
-		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0)
+		result, err := uc.GetComments(context.Background(), 1, "article1", 5, 1, 10, 0, "")
 
 		// 验证结果
 		assert.Error(t, err)
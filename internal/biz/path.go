@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"comment/pkg/log"
+	"context"
+	"strconv"
+	"strings"
+)
+
+// defaultPathBackfillBatchSize 回填物化路径时每批扫描的评论数
+const defaultPathBackfillBatchSize = 500
+
+// CommentPath 根据父评论的物化路径计算评论的路径，父评论路径为空时返回根路径
+func CommentPath(parentPath string, id int64) string {
+	if parentPath == "" {
+		parentPath = "/"
+	}
+	return parentPath + strconv.FormatInt(id, 10) + "/"
+}
+
+// PathIDs 解析物化路径中从根评论到自身的评论ID
+func PathIDs(path string) []int64 {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	parts := strings.Split(path, "/")
+	ids := make([]int64, 0, len(parts))
+	for _, p := range parts {
+		id, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return nil
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// CommentPathRepo 物化路径回填仓储
+type CommentPathRepo interface {
+	// BackfillCommentPaths 按ID升序为 afterID 之后路径为空的一批评论补齐路径，返回本批最后一条评论的ID，为 0 表示已全部回填
+	BackfillCommentPaths(ctx context.Context, afterID int64, limit int) (int64, error)
+}
+
+// CommentPathBackfiller 启动时在后台为引入物化路径之前创建的评论回填 path，回填完成后退出；
+// 父评论的ID总是小于回复，按ID升序回填可以保证处理回复时父评论的路径已经存在
+type CommentPathBackfiller struct {
+	repo      CommentPathRepo
+	batchSize int
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewCommentPathBackfiller new a CommentPathBackfiller.
+func NewCommentPathBackfiller(repo CommentPathRepo) *CommentPathBackfiller {
+	return &CommentPathBackfiller{
+		repo:      repo,
+		batchSize: defaultPathBackfillBatchSize,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start 分批回填物化路径，全部完成、出错或 Stop 被调用时返回；出错时下次启动会从头继续
+func (b *CommentPathBackfiller) Start(ctx context.Context) error {
+	defer close(b.done)
+	log.Info(ctx, "comment path backfill started.", "batch_size", b.batchSize)
+
+	var afterID int64
+	for {
+		select {
+		case <-b.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		lastID, err := b.repo.BackfillCommentPaths(ctx, afterID, b.batchSize)
+		if err != nil {
+			log.Error(ctx, "backfill comment paths error.", "after_id", afterID, "err", err)
+			return nil
+		}
+		if lastID == 0 {
+			log.Info(ctx, "comment path backfill finished.")
+			return nil
+		}
+		afterID = lastID
+	}
+}
+
+// Stop 停止回填
+func (b *CommentPathBackfiller) Stop(ctx context.Context) error {
+	close(b.stop)
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+	}
+	log.Info(ctx, "comment path backfill stopped.")
+	return nil
+}
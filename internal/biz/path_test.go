@@ -0,0 +1,55 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// CommentPathRepoMock 是CommentPathRepo接口的mock实现
+type CommentPathRepoMock struct {
+	mock.Mock
+}
+
+func (m *CommentPathRepoMock) BackfillCommentPaths(ctx context.Context, afterID int64, limit int) (int64, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestCommentPath(t *testing.T) {
+	assert.Equal(t, "/1/", CommentPath("", 1))
+	assert.Equal(t, "/1/5/", CommentPath("/1/", 5))
+	assert.Equal(t, "/1/5/9/", CommentPath(CommentPath("/1/", 5), 9))
+}
+
+func TestPathIDs(t *testing.T) {
+	assert.Equal(t, []int64{1, 5, 9}, PathIDs("/1/5/9/"))
+	assert.Equal(t, []int64{1}, PathIDs("/1/"))
+	assert.Nil(t, PathIDs(""))
+	assert.Nil(t, PathIDs("/1/x/"))
+}
+
+func TestCommentPathBackfiller_Start(t *testing.T) {
+	t.Run("按批回填直到完成", func(t *testing.T) {
+		repo := new(CommentPathRepoMock)
+		repo.On("BackfillCommentPaths", mock.Anything, int64(0), defaultPathBackfillBatchSize).Return(int64(500), nil).Once()
+		repo.On("BackfillCommentPaths", mock.Anything, int64(500), defaultPathBackfillBatchSize).Return(int64(730), nil).Once()
+		repo.On("BackfillCommentPaths", mock.Anything, int64(730), defaultPathBackfillBatchSize).Return(int64(0), nil).Once()
+
+		assert.NoError(t, NewCommentPathBackfiller(repo).Start(context.Background()))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("出错时停止且不影响服务启动", func(t *testing.T) {
+		repo := new(CommentPathRepoMock)
+		repo.On("BackfillCommentPaths", mock.Anything, int64(0), defaultPathBackfillBatchSize).Return(int64(0), errors.New("lock wait timeout")).Once()
+
+		b := NewCommentPathBackfiller(repo)
+		assert.NoError(t, b.Start(context.Background()))
+		assert.NoError(t, b.Stop(context.Background()))
+		repo.AssertExpectations(t)
+	})
+}
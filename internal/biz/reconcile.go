@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"time"
+)
+
+// defaultReconcileBatchSize 校对冗余计数时每批扫描的评论数
+const defaultReconcileBatchSize = 500
+
+const (
+	// CounterLikeCount 点赞数，实际值为 comment_like 中该评论的点赞记录数
+	CounterLikeCount = "like_count"
+	// CounterReplyCount 回复数，实际值为未软删除的直接回复数
+	CounterReplyCount = "reply_count"
+)
+
+// CounterMismatch 冗余计数与实际值不一致的评论
+type CounterMismatch struct {
+	CommentID int64
+	// Counter 不一致的计数列：like_count 或 reply_count
+	Counter string
+	// Stored 评论表中存储的值
+	Stored int64
+	// Actual 重新统计的实际值
+	Actual int64
+	// Repaired 是否已修复，计数在校对期间被并发修改时不修复，留给下次校对
+	Repaired bool
+}
+
+// CounterBatch 一批评论的校对结果
+type CounterBatch struct {
+	// LastID 本批最后一条评论的ID，为 0 表示已全部扫描
+	LastID int64
+	// Scanned 本批扫描的评论数
+	Scanned int
+	// Mismatches 本批不一致的计数
+	Mismatches []*CounterMismatch
+}
+
+// CounterRepo 冗余计数校对仓储
+type CounterRepo interface {
+	// CheckCounters 按ID升序为 afterID 之后的一批评论重新统计点赞数和回复数，返回不一致的计数
+	CheckCounters(ctx context.Context, afterID int64, limit int) (*CounterBatch, error)
+	// RepairCounter 将计数更新为实际值，仅在存储的值仍为 m.Stored 时更新，返回是否更新
+	RepairCounter(ctx context.Context, m *CounterMismatch) (bool, error)
+}
+
+// ReconcileReport 一次校对的结果
+type ReconcileReport struct {
+	// Scanned 扫描的评论数
+	Scanned int64
+	// Mismatches 不一致的计数
+	Mismatches []*CounterMismatch
+	// Repaired 修复的计数数
+	Repaired int64
+}
+
+// CounterReconciler 校对评论的 like_count 和 reply_count：按ID分批从 comment_like 和回复重新统计，
+// 报告并修复不一致的计数。配置了间隔时在后台定期执行，也可通过 reconcile 命令手动执行
+type CounterReconciler struct {
+	repo      CounterRepo
+	interval  time.Duration
+	batchSize int
+	dryRun    bool
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewCounterReconciler new a CounterReconciler.
+func NewCounterReconciler(c *conf.Data, repo CounterRepo) *CounterReconciler {
+	r := &CounterReconciler{
+		repo:      repo,
+		batchSize: defaultReconcileBatchSize,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if rc := c.GetReconcile(); rc != nil {
+		if rc.Interval != nil && rc.Interval.AsDuration() > 0 {
+			r.interval = rc.Interval.AsDuration()
+		}
+		if rc.BatchSize > 0 {
+			r.batchSize = int(rc.BatchSize)
+		}
+		r.dryRun = rc.DryRun
+	}
+	return r
+}
+
+// Start 按配置的间隔定期校对，阻塞直到 Stop 被调用；未配置间隔时不校对
+func (r *CounterReconciler) Start(ctx context.Context) error {
+	defer close(r.done)
+	if r.interval <= 0 {
+		log.Info(ctx, "counter reconciler disabled.")
+		select {
+		case <-r.stop:
+		case <-ctx.Done():
+		}
+		return nil
+	}
+	log.Info(ctx, "counter reconciler started.", "interval", r.interval, "batch_size", r.batchSize, "dry_run", r.dryRun)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := r.Reconcile(ctx, !r.dryRun); err != nil {
+				log.Error(ctx, "reconcile counters error.", "err", err)
+			}
+		}
+	}
+}
+
+// Stop 停止定期校对，执行中的校对在当前批次完成后中断
+func (r *CounterReconciler) Stop(ctx context.Context) error {
+	close(r.stop)
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+	}
+	log.Info(ctx, "counter reconciler stopped.")
+	return nil
+}
+
+// Reconcile 分批扫描所有评论并报告不一致的计数，repair 为 true 时修复；
+// 被 Stop 中断或出错时返回已完成部分的结果
+func (r *CounterReconciler) Reconcile(ctx context.Context, repair bool) (*ReconcileReport, error) {
+	log.Info(ctx, "reconcile counters started.", "repair", repair)
+	report := &ReconcileReport{}
+	var afterID int64
+	for {
+		select {
+		case <-r.stop:
+			return report, nil
+		case <-ctx.Done():
+			return report, ctx.Err()
+		default:
+		}
+
+		batch, err := r.repo.CheckCounters(ctx, afterID, r.batchSize)
+		if err != nil {
+			return report, err
+		}
+		report.Scanned += int64(batch.Scanned)
+		for _, m := range batch.Mismatches {
+			if repair {
+				if m.Repaired, err = r.repo.RepairCounter(ctx, m); err != nil {
+					return report, err
+				}
+				if m.Repaired {
+					report.Repaired++
+				}
+			}
+			log.Warn(ctx, "comment counter mismatch.", "comment_id", m.CommentID, "counter", m.Counter,
+				"stored", m.Stored, "actual", m.Actual, "repaired", m.Repaired)
+			report.Mismatches = append(report.Mismatches, m)
+		}
+		if batch.LastID == 0 {
+			break
+		}
+		afterID = batch.LastID
+	}
+	log.Info(ctx, "reconcile counters finished.", "scanned", report.Scanned, "mismatches", len(report.Mismatches), "repaired", report.Repaired)
+	return report, nil
+}
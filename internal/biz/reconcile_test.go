@@ -0,0 +1,104 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CounterRepoMock 是CounterRepo接口的mock实现
+type CounterRepoMock struct {
+	mock.Mock
+}
+
+func (m *CounterRepoMock) CheckCounters(ctx context.Context, afterID int64, limit int) (*CounterBatch, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).(*CounterBatch), args.Error(1)
+}
+
+func (m *CounterRepoMock) RepairCounter(ctx context.Context, mismatch *CounterMismatch) (bool, error) {
+	args := m.Called(ctx, mismatch)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestCounterReconciler_Reconcile(t *testing.T) {
+	like := &CounterMismatch{CommentID: 3, Counter: CounterLikeCount, Stored: 5, Actual: 4}
+	reply := &CounterMismatch{CommentID: 7, Counter: CounterReplyCount, Stored: 0, Actual: 2}
+	newRepo := func() *CounterRepoMock {
+		repo := new(CounterRepoMock)
+		repo.On("CheckCounters", mock.Anything, int64(0), 2).Return(&CounterBatch{LastID: 3, Scanned: 2, Mismatches: []*CounterMismatch{like}}, nil).Once()
+		repo.On("CheckCounters", mock.Anything, int64(3), 2).Return(&CounterBatch{LastID: 7, Scanned: 1, Mismatches: []*CounterMismatch{reply}}, nil).Once()
+		repo.On("CheckCounters", mock.Anything, int64(7), 2).Return(&CounterBatch{}, nil).Once()
+		return repo
+	}
+	c := &conf.Data{Reconcile: &conf.Data_Reconcile{BatchSize: 2}}
+
+	t.Run("分批校对并修复不一致的计数", func(t *testing.T) {
+		repo := newRepo()
+		repo.On("RepairCounter", mock.Anything, like).Return(true, nil).Once()
+		// 校对期间计数被并发修改，不修复
+		repo.On("RepairCounter", mock.Anything, reply).Return(false, nil).Once()
+
+		report, err := NewCounterReconciler(c, repo).Reconcile(context.Background(), true)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), report.Scanned)
+		assert.Equal(t, []*CounterMismatch{like, reply}, report.Mismatches)
+		assert.Equal(t, int64(1), report.Repaired)
+		assert.True(t, like.Repaired)
+		assert.False(t, reply.Repaired)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("不修复时只报告", func(t *testing.T) {
+		repo := newRepo()
+
+		report, err := NewCounterReconciler(c, repo).Reconcile(context.Background(), false)
+		assert.NoError(t, err)
+		assert.Len(t, report.Mismatches, 2)
+		assert.Zero(t, report.Repaired)
+		repo.AssertNotCalled(t, "RepairCounter", mock.Anything, mock.Anything)
+	})
+
+	t.Run("出错时返回已完成部分的结果", func(t *testing.T) {
+		repo := new(CounterRepoMock)
+		repo.On("CheckCounters", mock.Anything, int64(0), 2).Return(&CounterBatch{LastID: 3, Scanned: 2}, nil).Once()
+		repo.On("CheckCounters", mock.Anything, int64(3), 2).Return((*CounterBatch)(nil), errors.New("lock wait timeout")).Once()
+
+		report, err := NewCounterReconciler(c, repo).Reconcile(context.Background(), true)
+		assert.Error(t, err)
+		assert.Equal(t, int64(2), report.Scanned)
+	})
+}
+
+func TestCounterReconciler_Start(t *testing.T) {
+	t.Run("未配置间隔时不校对", func(t *testing.T) {
+		repo := new(CounterRepoMock)
+		r := NewCounterReconciler(nil, repo)
+		go r.Start(context.Background())
+		assert.NoError(t, r.Stop(context.Background()))
+		repo.AssertNotCalled(t, "CheckCounters", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("按间隔定期校对", func(t *testing.T) {
+		repo := new(CounterRepoMock)
+		checked := make(chan struct{}, 1)
+		repo.On("CheckCounters", mock.Anything, int64(0), defaultReconcileBatchSize).Return(&CounterBatch{}, nil).
+			Run(func(mock.Arguments) {
+				select {
+				case checked <- struct{}{}:
+				default:
+				}
+			})
+
+		r := NewCounterReconciler(&conf.Data{Reconcile: &conf.Data_Reconcile{Interval: durationpb.New(10 * time.Millisecond)}}, repo)
+		go r.Start(context.Background())
+		<-checked
+		assert.NoError(t, r.Stop(context.Background()))
+	})
+}
@@ -0,0 +1,150 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/pkg/log"
+	"context"
+	stderrors "errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrAlreadyReported 用户已举报过该评论
+var ErrAlreadyReported = stderrors.New("comment already reported")
+
+// defaultReportHideThreshold 默认自动隐藏评论的待处理举报数
+const defaultReportHideThreshold = 5
+
+// 举报处理状态，取值与 v1.Report_Status 一致
+const (
+	ReportPending   int32 = 0
+	ReportAccepted  int32 = 1
+	ReportDismissed int32 = 2
+)
+
+// ReportAction 举报处理方式，取值与 v1.ResolveReportsRequest_Action 一致
+type ReportAction int32
+
+const (
+	// ReportActionDismiss 驳回举报，恢复显示评论
+	ReportActionDismiss ReportAction = 1
+	// ReportActionHide 举报成立，隐藏评论
+	ReportActionHide ReportAction = 2
+	// ReportActionDelete 举报成立，删除评论及其回复
+	ReportActionDelete ReportAction = 3
+)
+
+// recentReportLimit 按评论聚合时返回的最近举报条数
+const recentReportLimit = 10
+
+// Report 评论举报记录
+type Report struct {
+	// ID 举报唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// CommentID 被举报评论ID
+	CommentID int64 `gorm:"column:comment_id;type:bigint;not null;index:uk_comment_user,unique;index:idx_status_comment,priority:2"`
+
+	// UserID 举报用户
+	UserID string `gorm:"column:user_id;type:varchar(32);not null;index:uk_comment_user,unique"`
+
+	// Reason 举报原因
+	Reason int32 `gorm:"column:reason;type:tinyint;not null"`
+
+	// Detail 补充说明
+	Detail string `gorm:"column:detail;type:varchar(500);not null;default:''"`
+
+	// Status 处理状态
+	Status int32 `gorm:"column:status;type:tinyint;not null;default:0;index:idx_status_comment,priority:1"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+
+	// UpdateGmt 更新时间
+	UpdateGmt time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (r *Report) TableName() string {
+	return "comment_report"
+}
+
+// ReportedComment 被举报的评论及其举报汇总
+type ReportedComment struct {
+	Comment       *Comment
+	ReportCount   int64
+	ReasonCounts  map[int32]int64
+	LastReportGmt time.Time
+	RecentReports []*Report
+}
+
+// ReportFilter 按评论聚合查询举报的条件
+type ReportFilter struct {
+	Module   int32
+	Status   int32
+	Page     int32
+	PageSize int32
+}
+
+// ReportComment 举报评论，返回该评论当前待处理的举报数以及是否已被隐藏
+func (uc *CommentUsecase) ReportComment(ctx context.Context, report *Report) (int64, bool, error) {
+	log.Debug(ctx, "report comment.", "comment_id", report.CommentID, "user_id", report.UserID, "reason", report.Reason)
+
+	reportCount, hidden, err := uc.repo.ReportComment(ctx, report, uc.reportHideThreshold)
+	if stderrors.Is(err, ErrAlreadyReported) {
+		return 0, false, errors.Conflict(v1.ErrorReason_COMMENT_ALREADY_REPORTED.String(), "comment already reported.")
+	}
+	if err != nil {
+		log.Error(ctx, "report comment error.", "err", err)
+		return 0, false, errors.BadRequest(err.Error(), "report comment error.")
+	}
+	if hidden {
+		log.Info(ctx, "comment hidden by reports.", "comment_id", report.CommentID, "report_count", reportCount)
+	}
+	log.Info(ctx, "repo report comment successful.")
+	return reportCount, hidden, nil
+}
+
+// ListReportedComments 按评论聚合查询举报，按举报数降序
+func (uc *CommentUsecase) ListReportedComments(ctx context.Context, filter *ReportFilter) ([]*ReportedComment, error) {
+	log.Debug(ctx, "list reported comments.", "module", filter.Module, "status", filter.Status, "page", filter.Page, "page_size", filter.PageSize)
+	reported, err := uc.repo.ListReportedComments(ctx, filter, recentReportLimit)
+	if err != nil {
+		log.Error(ctx, "list reported comments error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "list reported comments error.")
+	}
+	log.Info(ctx, "repo list reported comments successful.")
+	return reported, nil
+}
+
+// ResolveReports 处理评论的全部待处理举报，返回处理的举报数
+func (uc *CommentUsecase) ResolveReports(ctx context.Context, commentID int64, action ReportAction) (int64, error) {
+	log.Debug(ctx, "resolve reports.", "comment_id", commentID, "action", action)
+
+	status, hidden := ReportAccepted, true
+	if action == ReportActionDismiss {
+		status, hidden = ReportDismissed, false
+	}
+	// 处理举报与删除评论在同一事务中执行，删除失败时举报保持待处理
+	var resolved int64
+	err := uc.transaction(ctx, func(ctx context.Context) error {
+		var err error
+		resolved, err = uc.repo.ResolveReports(ctx, commentID, status, hidden)
+		if err != nil {
+			log.Error(ctx, "resolve reports error.", "err", err)
+			return errors.BadRequest(err.Error(), "resolve reports error.")
+		}
+
+		// 举报成立且需要删除时，删除评论及其回复
+		if action == ReportActionDelete {
+			return uc.DeleteComment(ctx, commentID)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info(ctx, "repo resolve reports successful.", "resolved", resolved)
+	return resolved, nil
+}
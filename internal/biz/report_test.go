@@ -0,0 +1,68 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"context"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentUsecase_ReportComment(t *testing.T) {
+	c := &conf.Data{Report: &conf.Data_Report{HideThreshold: 3}}
+
+	t.Run("按配置的阈值隐藏评论", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		report := &Report{CommentID: 1, UserID: "u1", Reason: 1}
+		repo.On("ReportComment", mock.Anything, report, int64(3)).Return(int64(3), true, nil).Once()
+
+		count, hidden, err := NewCommentUsecase(c, repo, nil, nil, nil, nil, nil, nil, nil).ReportComment(context.Background(), report)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+		assert.True(t, hidden)
+	})
+
+	t.Run("默认阈值", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ReportComment", mock.Anything, mock.Anything, int64(defaultReportHideThreshold)).Return(int64(1), false, nil).Once()
+
+		_, _, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).ReportComment(context.Background(), &Report{CommentID: 1, UserID: "u1"})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("重复举报返回冲突", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ReportComment", mock.Anything, mock.Anything, int64(3)).Return(int64(0), false, ErrAlreadyReported).Once()
+
+		_, _, err := NewCommentUsecase(c, repo, nil, nil, nil, nil, nil, nil, nil).ReportComment(context.Background(), &Report{CommentID: 1, UserID: "u1"})
+		assert.Equal(t, v1.ErrorReason_COMMENT_ALREADY_REPORTED.String(), kerrors.Reason(err))
+		assert.Equal(t, 409, kerrors.Code(err))
+	})
+}
+
+func TestCommentUsecase_ResolveReports(t *testing.T) {
+	t.Run("驳回举报恢复显示", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ResolveReports", mock.Anything, int64(1), ReportDismissed, false).Return(int64(4), nil).Once()
+
+		resolved, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).ResolveReports(context.Background(), 1, ReportActionDismiss)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), resolved)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("举报成立并删除评论", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ResolveReports", mock.Anything, int64(1), ReportAccepted, true).Return(int64(2), nil).Once()
+		repo.On("Get", mock.Anything, int64(1)).Return(&Comment{ID: 1}, nil).Once()
+		repo.On("DeleteBatch", mock.Anything, int64(1)).Return(nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).ResolveReports(context.Background(), 1, ReportActionDelete)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"comment/pkg/log"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// SearchQuery 评论搜索条件
+type SearchQuery struct {
+	// Keyword 搜索关键词
+	Keyword string
+	// Module 按业务模块过滤，0 表示不过滤
+	Module int32
+	// ResourceID 按资源过滤，为空表示不过滤
+	ResourceID string
+	// UserID 按评论用户过滤，为空表示不过滤
+	UserID string
+	// StartTime、EndTime 按评论创建时间过滤，零值表示不限制
+	StartTime time.Time
+	EndTime   time.Time
+	// IncludeHidden 是否包含被隐藏或未审核通过的评论
+	IncludeHidden bool
+	Page          int32
+	PageSize      int32
+}
+
+// CommentSearcher 评论全文搜索引擎，默认基于 MySQL FULLTEXT 索引，可替换为其他搜索引擎
+type CommentSearcher interface {
+	// Search 按相关度降序返回一页匹配的评论及匹配总数
+	Search(ctx context.Context, q *SearchQuery) ([]*Comment, int64, error)
+}
+
+// SearchUsecase is a comment search usecase.
+type SearchUsecase struct {
+	searcher CommentSearcher
+}
+
+// NewSearchUsecase new a comment search usecase.
+func NewSearchUsecase(searcher CommentSearcher) *SearchUsecase {
+	return &SearchUsecase{searcher: searcher}
+}
+
+// SearchComments 按关键词搜索评论
+func (uc *SearchUsecase) SearchComments(ctx context.Context, q *SearchQuery) ([]*Comment, int64, error) {
+	log.Debug(ctx, "search comments.", "keyword", q.Keyword, "module", q.Module, "resource_id", q.ResourceID, "user_id", q.UserID,
+		"start_time", q.StartTime, "end_time", q.EndTime, "page", q.Page, "page_size", q.PageSize)
+
+	q.Keyword = strings.TrimSpace(q.Keyword)
+	if q.Keyword == "" {
+		return nil, 0, errors.BadRequest("INVALID_ARGUMENT", "keyword is required.")
+	}
+	if !q.StartTime.IsZero() && !q.EndTime.IsZero() && q.StartTime.After(q.EndTime) {
+		return nil, 0, errors.BadRequest("INVALID_ARGUMENT", "start time is after end time.")
+	}
+
+	comments, total, err := uc.searcher.Search(ctx, q)
+	if err != nil {
+		log.Error(ctx, "search comments error.", "err", err)
+		return nil, 0, errors.BadRequest(err.Error(), "search comments error.")
+	}
+	log.Info(ctx, "search comments successful.", "total", total)
+	return comments, total, nil
+}
@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type CommentSearcherMock struct {
+	mock.Mock
+}
+
+func (m *CommentSearcherMock) Search(ctx context.Context, q *SearchQuery) ([]*Comment, int64, error) {
+	args := m.Called(ctx, q)
+	comments, _ := args.Get(0).([]*Comment)
+	return comments, args.Get(1).(int64), args.Error(2)
+}
+
+func TestSearchUsecase_SearchComments(t *testing.T) {
+	t.Run("去除关键词首尾空白后搜索", func(t *testing.T) {
+		searcher := new(CommentSearcherMock)
+		searcher.On("Search", mock.Anything, mock.MatchedBy(func(q *SearchQuery) bool { return q.Keyword == "好看" })).
+			Return([]*Comment{{ID: 1}}, int64(1), nil).Once()
+
+		comments, total, err := NewSearchUsecase(searcher).SearchComments(context.Background(), &SearchQuery{Keyword: " 好看 ", Page: 1, PageSize: 10})
+		assert.NoError(t, err)
+		assert.Len(t, comments, 1)
+		assert.Equal(t, int64(1), total)
+		searcher.AssertExpectations(t)
+	})
+
+	t.Run("关键词为空", func(t *testing.T) {
+		searcher := new(CommentSearcherMock)
+		_, _, err := NewSearchUsecase(searcher).SearchComments(context.Background(), &SearchQuery{Keyword: "  "})
+		assert.Equal(t, "INVALID_ARGUMENT", kerrors.Reason(err))
+		searcher.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("时间范围无效", func(t *testing.T) {
+		searcher := new(CommentSearcherMock)
+		now := time.Now()
+		_, _, err := NewSearchUsecase(searcher).SearchComments(context.Background(), &SearchQuery{Keyword: "好看", StartTime: now, EndTime: now.Add(-time.Hour)})
+		assert.Equal(t, "INVALID_ARGUMENT", kerrors.Reason(err))
+		searcher.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+}
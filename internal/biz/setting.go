@@ -0,0 +1,138 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/pkg/log"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 资源评论状态，取值与 v1.ResourceCommentSettings_Status 一致
+const (
+	// ResourceCommentOpen 正常开放评论
+	ResourceCommentOpen int32 = 0
+	// ResourceCommentClosed 关闭评论，不展示评论，也不能发表评论和点赞
+	ResourceCommentClosed int32 = 1
+	// ResourceCommentReadOnly 只读，展示已有评论，但不能发表评论和点赞
+	ResourceCommentReadOnly int32 = 2
+)
+
+// ResourceSetting 资源评论设置，资源没有设置时按开放评论处理
+type ResourceSetting struct {
+	// ID 记录唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// Module 业务模块
+	Module int32 `gorm:"column:module;type:tinyint;not null;index:uk_module_resource,unique"`
+
+	// ResourceID 资源唯一标识
+	ResourceID string `gorm:"column:resource_id;type:varchar(32);not null;index:uk_module_resource,unique"`
+
+	// Status 评论状态
+	Status int32 `gorm:"column:status;type:tinyint;not null;default:0"`
+
+	// MaxReplyDepth 允许的最大回复层级，0 表示不限制
+	MaxReplyDepth int32 `gorm:"column:max_reply_depth;type:int;not null;default:0"`
+
+	// ModerationRequired 新评论是否需要审核，需要审核的评论审核通过前不展示
+	ModerationRequired bool `gorm:"column:moderation_required;type:tinyint(1);not null;default:0"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+
+	// UpdateGmt 更新时间
+	UpdateGmt time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (s *ResourceSetting) TableName() string {
+	return "comment_resource_setting"
+}
+
+// SettingRepo 资源评论设置仓储
+type SettingRepo interface {
+	// GetResourceSetting 获取资源评论设置，资源没有设置时返回 nil
+	GetResourceSetting(ctx context.Context, module int32, resourceID string) (*ResourceSetting, error)
+	// SaveResourceSetting 保存资源评论设置，已存在时覆盖
+	SaveResourceSetting(ctx context.Context, setting *ResourceSetting) error
+}
+
+// SetResourceSetting 设置资源评论
+func (uc *CommentUsecase) SetResourceSetting(ctx context.Context, setting *ResourceSetting) (*ResourceSetting, error) {
+	log.Debug(ctx, "set resource setting.", "module", setting.Module, "resource_id", setting.ResourceID, "status", setting.Status,
+		"max_reply_depth", setting.MaxReplyDepth, "moderation_required", setting.ModerationRequired)
+	setting.CreateGmt = time.Now().UTC()
+	setting.UpdateGmt = time.Now().UTC()
+	if err := uc.settings.SaveResourceSetting(ctx, setting); err != nil {
+		log.Error(ctx, "save resource setting error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "save resource setting error.")
+	}
+	log.Info(ctx, "repo save resource setting successful.")
+	return setting, nil
+}
+
+// GetResourceSetting 获取资源评论设置，资源没有设置时返回默认设置
+func (uc *CommentUsecase) GetResourceSetting(ctx context.Context, module int32, resourceID string) (*ResourceSetting, error) {
+	log.Debug(ctx, "get resource setting.", "module", module, "resource_id", resourceID)
+	setting, err := uc.resourceSetting(ctx, module, resourceID)
+	if err != nil {
+		log.Error(ctx, "get resource setting error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get resource setting error.")
+	}
+	log.Info(ctx, "repo get resource setting successful.")
+	return setting, nil
+}
+
+// resourceSetting 获取资源评论设置，资源没有设置时返回默认的开放设置
+func (uc *CommentUsecase) resourceSetting(ctx context.Context, module int32, resourceID string) (*ResourceSetting, error) {
+	defaultSetting := &ResourceSetting{Module: module, ResourceID: resourceID, Status: ResourceCommentOpen}
+	if uc.settings == nil {
+		return defaultSetting, nil
+	}
+	setting, err := uc.settings.GetResourceSetting(ctx, module, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return defaultSetting, nil
+	}
+	return setting, nil
+}
+
+// checkWritable 检查资源是否允许发表评论和点赞
+func (uc *CommentUsecase) checkWritable(ctx context.Context, setting *ResourceSetting) error {
+	switch setting.Status {
+	case ResourceCommentClosed:
+		log.Warn(ctx, "comments closed.", "module", setting.Module, "resource_id", setting.ResourceID)
+		return errors.Forbidden(v1.ErrorReason_COMMENTS_CLOSED.String(), "comments are closed.")
+	case ResourceCommentReadOnly:
+		log.Warn(ctx, "comments read only.", "module", setting.Module, "resource_id", setting.ResourceID)
+		return errors.Forbidden(v1.ErrorReason_COMMENTS_READ_ONLY.String(), "comments are read only.")
+	}
+	return nil
+}
+
+// applyResourceSetting 发表评论时应用资源评论设置：
+// 检查是否允许发表、回复层级是否超限，需要审核时评论进入待审核状态
+func (uc *CommentUsecase) applyResourceSetting(ctx context.Context, c *Comment, parent *Comment) error {
+	if uc.settings == nil {
+		return nil
+	}
+	setting, err := uc.resourceSetting(ctx, c.Module, c.ResourceID)
+	if err != nil {
+		log.Error(ctx, "get resource setting error.", "err", err)
+		return errors.BadRequest(err.Error(), "get resource setting error.")
+	}
+	if err := uc.checkWritable(ctx, setting); err != nil {
+		return err
+	}
+	if parent != nil && setting.MaxReplyDepth > 0 && parent.Level+1 > setting.MaxReplyDepth {
+		log.Warn(ctx, "reply depth exceeded.", "parent_comment_id", parent.ID, "max_reply_depth", setting.MaxReplyDepth)
+		return errors.BadRequest(v1.ErrorReason_REPLY_DEPTH_EXCEEDED.String(), "reply depth exceeded.")
+	}
+	if setting.ModerationRequired {
+		c.ModerationStatus = ModerationPending
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"context"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type SettingRepoMock struct {
+	mock.Mock
+}
+
+func (m *SettingRepoMock) GetResourceSetting(ctx context.Context, module int32, resourceID string) (*ResourceSetting, error) {
+	args := m.Called(ctx, module, resourceID)
+	setting, _ := args.Get(0).(*ResourceSetting)
+	return setting, args.Error(1)
+}
+
+func (m *SettingRepoMock) SaveResourceSetting(ctx context.Context, setting *ResourceSetting) error {
+	args := m.Called(ctx, setting)
+	return args.Error(0)
+}
+
+func TestCommentUsecase_GetResourceSetting(t *testing.T) {
+	settings := new(SettingRepoMock)
+	settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").Return(nil, nil).Once()
+
+	setting, err := NewCommentUsecase(nil, new(CommentRepoMock), nil, nil, nil, settings, nil, nil, nil).GetResourceSetting(context.Background(), 1, "r1")
+	assert.NoError(t, err)
+	assert.Equal(t, ResourceCommentOpen, setting.Status)
+	assert.Equal(t, "r1", setting.ResourceID)
+}
+
+func TestCommentUsecase_CreateComment_ResourceSetting(t *testing.T) {
+	t.Run("关闭评论", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		settings := new(SettingRepoMock)
+		settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").
+			Return(&ResourceSetting{Module: 1, ResourceID: "r1", Status: ResourceCommentClosed}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, settings, nil, nil, nil).CreateComment(context.Background(), &Comment{Module: 1, ResourceID: "r1", UserID: "u1", Content: "hi"}, "")
+		assert.Equal(t, v1.ErrorReason_COMMENTS_CLOSED.String(), kerrors.Reason(err))
+		assert.Equal(t, 403, kerrors.Code(err))
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("只读", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		settings := new(SettingRepoMock)
+		settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").
+			Return(&ResourceSetting{Module: 1, ResourceID: "r1", Status: ResourceCommentReadOnly}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, settings, nil, nil, nil).CreateComment(context.Background(), &Comment{Module: 1, ResourceID: "r1", UserID: "u1", Content: "hi"}, "")
+		assert.Equal(t, v1.ErrorReason_COMMENTS_READ_ONLY.String(), kerrors.Reason(err))
+	})
+
+	t.Run("回复层级超限", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		settings := new(SettingRepoMock)
+		repo.On("Get", mock.Anything, int64(2)).Return(&Comment{ID: 2, Module: 1, ResourceID: "r1", Level: 1}, nil).Once()
+		settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").
+			Return(&ResourceSetting{Module: 1, ResourceID: "r1", MaxReplyDepth: 1}, nil).Once()
+
+		_, err := NewCommentUsecase(nil, repo, nil, nil, nil, settings, nil, nil, nil).CreateComment(context.Background(), &Comment{
+			Module: 1, ResourceID: "r1", UserID: "u1", Content: "hi", ParentCommentID: 2, RootCommentID: 1,
+		}, "")
+		assert.Equal(t, v1.ErrorReason_REPLY_DEPTH_EXCEEDED.String(), kerrors.Reason(err))
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("需要审核的评论进入待审核", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		settings := new(SettingRepoMock)
+		settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").
+			Return(&ResourceSetting{Module: 1, ResourceID: "r1", ModerationRequired: true}, nil).Once()
+		repo.On("Save", mock.Anything, mock.MatchedBy(func(c *Comment) bool { return c.ModerationStatus == ModerationPending && !c.Hidden })).
+			Return(&Comment{ID: 1, Module: 1, ResourceID: "r1", ModerationStatus: ModerationPending}, nil).Once()
+
+		got, err := NewCommentUsecase(nil, repo, nil, nil, nil, settings, nil, nil, nil).CreateComment(context.Background(), &Comment{Module: 1, ResourceID: "r1", UserID: "u1", Content: "hi"}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, v1.Comment_PENDING, got.ModerationStatus)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestCommentUsecase_LikeComment_ReadOnly(t *testing.T) {
+	repo := new(CommentRepoMock)
+	settings := new(SettingRepoMock)
+	repo.On("Get", mock.Anything, int64(1)).Return(&Comment{ID: 1, Module: 1, ResourceID: "r1"}, nil).Once()
+	settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").
+		Return(&ResourceSetting{Module: 1, ResourceID: "r1", Status: ResourceCommentReadOnly}, nil).Once()
+
+	_, err := NewCommentUsecase(nil, repo, nil, nil, nil, settings, nil, nil, nil).LikeComment(context.Background(), 1, "u1")
+	assert.Equal(t, v1.ErrorReason_COMMENTS_READ_ONLY.String(), kerrors.Reason(err))
+	repo.AssertNotCalled(t, "LikeComment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCommentUsecase_GetComments_Closed(t *testing.T) {
+	repo := new(CommentRepoMock)
+	settings := new(SettingRepoMock)
+	settings.On("GetResourceSetting", mock.Anything, int32(1), "r1").
+		Return(&ResourceSetting{Module: 1, ResourceID: "r1", Status: ResourceCommentClosed}, nil).Once()
+
+	_, err := NewCommentUsecase(nil, repo, nil, nil, nil, settings, nil, nil, nil).GetComments(context.Background(), 1, "r1", 3, 1, 10, 0, "")
+	assert.Equal(t, v1.ErrorReason_COMMENTS_CLOSED.String(), kerrors.Reason(err))
+	repo.AssertNotCalled(t, "ListRootComments", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"comment/pkg/log"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// parentSnippetLength 父评论摘要的最大字符数
+const parentSnippetLength = 50
+
+// CommentCursor 按创建时间分页的游标，定位到上一页最后一条评论
+type CommentCursor struct {
+	CreateGmt time.Time
+	ID        int64
+}
+
+// UserCommentQuery 查询用户评论历史的条件
+type UserCommentQuery struct {
+	// UserID 评论用户
+	UserID string
+	// Module 按业务模块过滤，0 表示不过滤
+	Module int32
+	// After 游标，nil 表示从第一条开始
+	After *CommentCursor
+	// Ascending 是否按创建时间升序，默认降序
+	Ascending bool
+	// Limit 返回的最大条数
+	Limit int32
+}
+
+// UserComment 用户评论及其上下文
+type UserComment struct {
+	Comment *Comment
+	// Parent 被回复的评论，根评论或父评论已删除时为 nil
+	Parent *Comment
+}
+
+// ParentSnippet 父评论内容摘要
+func (c *UserComment) ParentSnippet() string {
+	if c.Parent == nil || !c.Parent.Visible() {
+		return ""
+	}
+	content := []rune(c.Parent.Content)
+	if len(content) <= parentSnippetLength {
+		return c.Parent.Content
+	}
+	return string(content[:parentSnippetLength]) + "…"
+}
+
+// ListUserComments 按创建时间游标分页查询用户的评论历史，返回本页评论和下一页游标，没有更多时游标为空
+func (uc *CommentUsecase) ListUserComments(ctx context.Context, userID string, module int32, cursor string, ascending bool, limit int32) ([]*UserComment, string, error) {
+	log.Debug(ctx, "list user comments.", "user_id", userID, "module", module, "cursor", cursor, "ascending", ascending, "limit", limit)
+
+	after, err := decodeCommentCursor(cursor)
+	if err != nil {
+		return nil, "", errors.BadRequest("INVALID_ARGUMENT", "invalid cursor.")
+	}
+
+	// 多取一条用于判断是否还有下一页
+	comments, err := uc.repo.ListUserComments(ctx, &UserCommentQuery{
+		UserID:    userID,
+		Module:    module,
+		After:     after,
+		Ascending: ascending,
+		Limit:     limit + 1,
+	})
+	if err != nil {
+		log.Error(ctx, "list user comments error.", "err", err)
+		return nil, "", errors.BadRequest(err.Error(), "list user comments error.")
+	}
+	var next string
+	if int32(len(comments)) > limit {
+		comments = comments[:limit]
+		last := comments[len(comments)-1]
+		next = encodeCommentCursor(&CommentCursor{CreateGmt: last.CreateGmt, ID: last.ID})
+	}
+
+	// 批量获取被回复的评论，用于展示上下文
+	var parentIDs []int64
+	for _, c := range comments {
+		if c.ParentCommentID > 0 {
+			parentIDs = append(parentIDs, c.ParentCommentID)
+		}
+	}
+	parents := make(map[int64]*Comment, len(parentIDs))
+	if len(parentIDs) > 0 {
+		list, err := uc.repo.ListByIDs(ctx, parentIDs)
+		if err != nil {
+			log.Error(ctx, "list parent comments error.", "err", err)
+			return nil, "", errors.BadRequest(err.Error(), "list parent comments error.")
+		}
+		for _, p := range list {
+			parents[p.ID] = p
+		}
+	}
+
+	userComments := make([]*UserComment, len(comments))
+	for i, c := range comments {
+		userComments[i] = &UserComment{Comment: c, Parent: parents[c.ParentCommentID]}
+	}
+
+	log.Info(ctx, "repo list user comments successful.")
+	return userComments, next, nil
+}
+
+// encodeCommentCursor 将游标编码为不透明的字符串
+func encodeCommentCursor(c *CommentCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d_%d", c.CreateGmt.UnixMicro(), c.ID)))
+}
+
+// decodeCommentCursor 解析游标，空字符串返回 nil
+func decodeCommentCursor(s string) (*CommentCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var micro, id int64
+	if _, err := fmt.Sscanf(string(raw), "%d_%d", &micro, &id); err != nil {
+		return nil, err
+	}
+	return &CommentCursor{CreateGmt: time.UnixMicro(micro).UTC(), ID: id}, nil
+}
@@ -0,0 +1,77 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentCursor(t *testing.T) {
+	c := &CommentCursor{CreateGmt: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), ID: 42}
+	got, err := decodeCommentCursor(encodeCommentCursor(c))
+	assert.NoError(t, err)
+	assert.Equal(t, c, got)
+
+	got, err = decodeCommentCursor("")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = decodeCommentCursor("not a cursor")
+	assert.Error(t, err)
+}
+
+func TestCommentUsecase_ListUserComments(t *testing.T) {
+	now := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("分页并返回父评论", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ListUserComments", mock.Anything, mock.MatchedBy(func(q *UserCommentQuery) bool {
+			return q.UserID == "u1" && q.Module == 1 && q.After == nil && !q.Ascending && q.Limit == 3
+		})).Return([]*Comment{
+			{ID: 5, UserID: "u1", ParentCommentID: 1, CreateGmt: now},
+			{ID: 4, UserID: "u1", CreateGmt: now},
+			{ID: 3, UserID: "u1", CreateGmt: now.Add(-time.Minute)},
+		}, nil).Once()
+		repo.On("ListByIDs", mock.Anything, []int64{1}).Return([]*Comment{{ID: 1, UserID: "u2", Content: "parent"}}, nil).Once()
+
+		comments, next, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).ListUserComments(context.Background(), "u1", 1, "", false, 2)
+		assert.NoError(t, err)
+		assert.Len(t, comments, 2)
+		assert.Equal(t, "parent", comments[0].ParentSnippet())
+		assert.Nil(t, comments[1].Parent)
+
+		after, err := decodeCommentCursor(next)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), after.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("最后一页游标为空", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		repo.On("ListUserComments", mock.Anything, mock.Anything).Return([]*Comment{{ID: 1, UserID: "u1"}}, nil).Once()
+
+		comments, next, err := NewCommentUsecase(nil, repo, nil, nil, nil, nil, nil, nil, nil).ListUserComments(context.Background(), "u1", 0, "", true, 10)
+		assert.NoError(t, err)
+		assert.Len(t, comments, 1)
+		assert.Empty(t, next)
+		repo.AssertNotCalled(t, "ListByIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("游标无效", func(t *testing.T) {
+		_, _, err := NewCommentUsecase(nil, new(CommentRepoMock), nil, nil, nil, nil, nil, nil, nil).ListUserComments(context.Background(), "u1", 0, "%%%", false, 10)
+		assert.Equal(t, "INVALID_ARGUMENT", kerrors.Reason(err))
+	})
+}
+
+func TestUserComment_ParentSnippet(t *testing.T) {
+	long := strings.Repeat("评", parentSnippetLength+5)
+	assert.Equal(t, strings.Repeat("评", parentSnippetLength)+"…", (&UserComment{Parent: &Comment{Content: long}}).ParentSnippet())
+	assert.Empty(t, (&UserComment{Parent: &Comment{Content: "x", Hidden: true}}).ParentSnippet())
+	assert.Empty(t, (&UserComment{Parent: &Comment{Content: "x", ModerationStatus: ModerationPending}}).ParentSnippet())
+	assert.Empty(t, (&UserComment{}).ParentSnippet())
+}
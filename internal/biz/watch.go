@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"sync"
+	"time"
+)
+
+// CommentChangeType 评论变更类型，取值与 v1.CommentChange_Type 一致
+type CommentChangeType int32
+
+const (
+	// CommentChangeCreated 新评论
+	CommentChangeCreated CommentChangeType = 1
+	// CommentChangeDeleted 评论及其回复被删除
+	CommentChangeDeleted CommentChangeType = 2
+	// CommentChangeLikeCount 点赞数变化
+	CommentChangeLikeCount CommentChangeType = 3
+)
+
+// CommentChange 资源下的一次评论变更，由领域事件转换而来
+type CommentChange struct {
+	Type              CommentChangeType
+	Module            int32
+	ResourceID        string
+	CommentID         int64
+	Comment           *Comment
+	DeletedCommentIDs []int64
+	LikeCount         int64
+	OccurredAt        time.Time
+}
+
+// WatchBroker 评论变更广播后端，多实例部署时负责在实例间转发变更
+type WatchBroker interface {
+	// Publish 广播一次变更
+	Publish(ctx context.Context, change *CommentChange) error
+	// Subscribe 接收所有实例广播的变更，阻塞直到 ctx 结束
+	Subscribe(ctx context.Context, handler func(change *CommentChange)) error
+}
+
+type watchKey struct {
+	module     int32
+	resourceID string
+}
+
+// WatchHub 进程内评论变更分发中心：从领域事件生成变更并经 WatchBroker 广播，
+// 再分发给本实例上订阅了对应资源的所有连接
+type WatchHub struct {
+	repo       CommentRepo
+	broker     WatchBroker
+	bufferSize int
+
+	mu       sync.RWMutex
+	watchers map[watchKey]map[chan *CommentChange]struct{}
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewWatchHub new a WatchHub.
+func NewWatchHub(c *conf.Data, repo CommentRepo, broker WatchBroker) *WatchHub {
+	h := &WatchHub{
+		repo:       repo,
+		broker:     broker,
+		bufferSize: 64,
+		watchers:   make(map[watchKey]map[chan *CommentChange]struct{}),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	if wc := c.GetWatch(); wc != nil && wc.BufferSize > 0 {
+		h.bufferSize = int(wc.BufferSize)
+	}
+	return h
+}
+
+// Start 订阅广播后端，阻塞直到 Stop 被调用
+func (h *WatchHub) Start(ctx context.Context) error {
+	defer close(h.done)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-h.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	log.Info(ctx, "watch hub started.")
+	return h.broker.Subscribe(ctx, h.broadcast)
+}
+
+// Stop 停止订阅并关闭所有连接
+func (h *WatchHub) Stop(ctx context.Context) error {
+	close(h.stop)
+	select {
+	case <-h.done:
+	case <-ctx.Done():
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, chs := range h.watchers {
+		for ch := range chs {
+			close(ch)
+		}
+		delete(h.watchers, key)
+	}
+	log.Info(ctx, "watch hub stopped.")
+	return nil
+}
+
+// Watch 订阅资源下的评论变更，返回变更通道和取消订阅函数
+func (h *WatchHub) Watch(module int32, resourceID string) (<-chan *CommentChange, func()) {
+	key := watchKey{module: module, resourceID: resourceID}
+	ch := make(chan *CommentChange, h.bufferSize)
+
+	h.mu.Lock()
+	if h.watchers[key] == nil {
+		h.watchers[key] = make(map[chan *CommentChange]struct{})
+	}
+	h.watchers[key][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if chs, ok := h.watchers[key]; ok {
+				if _, ok := chs[ch]; ok {
+					delete(chs, ch)
+					close(ch)
+				}
+				if len(chs) == 0 {
+					delete(h.watchers, key)
+				}
+			}
+		})
+	}
+}
+
+// Publish 实现 Publisher，将领域事件转换为评论变更并广播。
+// 实时推送尽力而为，广播失败只记录日志，不阻塞 outbox 投递
+func (h *WatchHub) Publish(ctx context.Context, e *Event) error {
+	// 事件在评论写入后立即投递，从库可能尚未同步，查询评论固定走主库
+	ctx = WithPrimary(ctx)
+	change := &CommentChange{
+		Module:     e.Module,
+		ResourceID: e.ResourceID,
+		CommentID:  e.CommentID,
+		OccurredAt: e.OccurredAt,
+	}
+	switch e.Type {
+	case EventCommentCreated, EventCommentApproved:
+		// 待审核的评论在审核通过时才作为新评论推送
+		comment, err := h.repo.Get(ctx, e.CommentID)
+		if err != nil {
+			// 评论可能已被删除，删除事件会随后推送
+			log.Warn(ctx, "get created comment error.", "comment_id", e.CommentID, "err", err)
+			return nil
+		}
+		if !comment.Visible() {
+			log.Debug(ctx, "skip invisible comment.", "comment_id", e.CommentID, "moderation_status", comment.ModerationStatus)
+			return nil
+		}
+		change.Type = CommentChangeCreated
+		change.Comment = comment
+	case EventCommentDeleted:
+		change.Type = CommentChangeDeleted
+		change.DeletedCommentIDs = e.DeletedCommentIDs
+	case EventCommentLiked, EventCommentUnliked:
+		change.Type = CommentChangeLikeCount
+		change.LikeCount = e.LikeCount
+	default:
+		return nil
+	}
+	if err := h.broker.Publish(ctx, change); err != nil {
+		log.Error(ctx, "publish comment change error.", "comment_id", e.CommentID, "err", err)
+	}
+	return nil
+}
+
+// broadcast 将变更分发给本实例上的订阅者，订阅者缓冲区已满时丢弃该变更，避免阻塞其他订阅者
+func (h *WatchHub) broadcast(change *CommentChange) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.watchers[watchKey{module: change.Module, resourceID: change.ResourceID}] {
+		select {
+		case ch <- change:
+		default:
+			log.Warn(nil, "watcher buffer full, drop change.", "module", change.Module, "resource_id", change.ResourceID, "comment_id", change.CommentID)
+		}
+	}
+}
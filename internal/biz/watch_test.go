@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"comment/internal/conf"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// watchBrokerStub 是WatchBroker接口的同步实现，Publish 直接回调订阅者
+type watchBrokerStub struct {
+	handler func(change *CommentChange)
+}
+
+func (b *watchBrokerStub) Publish(ctx context.Context, change *CommentChange) error {
+	if b.handler != nil {
+		b.handler(change)
+	}
+	return nil
+}
+
+func (b *watchBrokerStub) Subscribe(ctx context.Context, handler func(change *CommentChange)) error {
+	b.handler = handler
+	<-ctx.Done()
+	return nil
+}
+
+func newTestWatchHub(repo CommentRepo, bufferSize int32) *WatchHub {
+	broker := &watchBrokerStub{}
+	h := NewWatchHub(&conf.Data{Watch: &conf.Data_Watch{BufferSize: bufferSize}}, repo, broker)
+	broker.handler = h.broadcast
+	return h
+}
+
+func TestWatchHub_Publish(t *testing.T) {
+	t.Run("新评论推送给同一资源的订阅者", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		comment := &Comment{ID: 1, Module: 1, ResourceID: "r1", Content: "hello"}
+		repo.On("Get", mock.MatchedBy(UsePrimary), int64(1)).Return(comment, nil).Once()
+
+		h := newTestWatchHub(repo, 4)
+		same, cancelSame := h.Watch(1, "r1")
+		defer cancelSame()
+		other, cancelOther := h.Watch(1, "r2")
+		defer cancelOther()
+
+		err := h.Publish(context.Background(), &Event{Type: EventCommentCreated, Module: 1, ResourceID: "r1", CommentID: 1})
+		assert.NoError(t, err)
+
+		change := <-same
+		assert.Equal(t, CommentChangeCreated, change.Type)
+		assert.Equal(t, comment, change.Comment)
+		assert.Len(t, other, 0)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("待审核的评论在审核通过时推送", func(t *testing.T) {
+		repo := new(CommentRepoMock)
+		pending := &Comment{ID: 1, Module: 1, ResourceID: "r1", ModerationStatus: ModerationPending}
+		approved := &Comment{ID: 1, Module: 1, ResourceID: "r1"}
+		repo.On("Get", mock.MatchedBy(UsePrimary), int64(1)).Return(pending, nil).Once()
+		repo.On("Get", mock.MatchedBy(UsePrimary), int64(1)).Return(approved, nil).Once()
+
+		h := newTestWatchHub(repo, 4)
+		changes, cancel := h.Watch(1, "r1")
+		defer cancel()
+
+		assert.NoError(t, h.Publish(context.Background(), &Event{Type: EventCommentCreated, Module: 1, ResourceID: "r1", CommentID: 1}))
+		assert.Len(t, changes, 0)
+
+		assert.NoError(t, h.Publish(context.Background(), &Event{Type: EventCommentApproved, Module: 1, ResourceID: "r1", CommentID: 1}))
+		change := <-changes
+		assert.Equal(t, CommentChangeCreated, change.Type)
+		assert.Equal(t, approved, change.Comment)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("点赞和删除转换为对应变更", func(t *testing.T) {
+		h := newTestWatchHub(new(CommentRepoMock), 4)
+		changes, cancel := h.Watch(1, "r1")
+		defer cancel()
+
+		assert.NoError(t, h.Publish(context.Background(), &Event{Type: EventCommentUnliked, Module: 1, ResourceID: "r1", CommentID: 2, LikeCount: 3}))
+		assert.NoError(t, h.Publish(context.Background(), &Event{Type: EventCommentDeleted, Module: 1, ResourceID: "r1", CommentID: 2, DeletedCommentIDs: []int64{2, 5}}))
+		// 回复事件与创建事件重复，不单独推送
+		assert.NoError(t, h.Publish(context.Background(), &Event{Type: EventCommentReplied, Module: 1, ResourceID: "r1", CommentID: 6}))
+
+		liked := <-changes
+		assert.Equal(t, CommentChangeLikeCount, liked.Type)
+		assert.Equal(t, int64(3), liked.LikeCount)
+		deleted := <-changes
+		assert.Equal(t, CommentChangeDeleted, deleted.Type)
+		assert.Equal(t, []int64{2, 5}, deleted.DeletedCommentIDs)
+		assert.Len(t, changes, 0)
+	})
+
+	t.Run("订阅者缓冲区已满时丢弃变更", func(t *testing.T) {
+		h := newTestWatchHub(new(CommentRepoMock), 1)
+		changes, cancel := h.Watch(1, "r1")
+		defer cancel()
+
+		for i := int64(1); i <= 3; i++ {
+			assert.NoError(t, h.Publish(context.Background(), &Event{Type: EventCommentLiked, Module: 1, ResourceID: "r1", CommentID: i, LikeCount: i}))
+		}
+		assert.Equal(t, int64(1), (<-changes).LikeCount)
+		assert.Len(t, changes, 0)
+	})
+}
+
+func TestWatchHub_Watch(t *testing.T) {
+	h := newTestWatchHub(new(CommentRepoMock), 4)
+
+	changes, cancel := h.Watch(1, "r1")
+	cancel()
+	cancel()
+	_, ok := <-changes
+	assert.False(t, ok)
+	assert.Empty(t, h.watchers)
+
+	// 停止后所有订阅通道被关闭
+	changes, _ = h.Watch(1, "r1")
+	go func() { _ = h.Start(context.Background()) }()
+	ctx, stop := context.WithTimeout(context.Background(), time.Second)
+	defer stop()
+	assert.NoError(t, h.Stop(ctx))
+	_, ok = <-changes
+	assert.False(t, ok)
+}
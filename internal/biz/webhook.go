@@ -0,0 +1,479 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Webhook 订阅来源
+const (
+	WebhookSourceConfig = "config"
+	WebhookSourceAdmin  = "admin"
+)
+
+// Webhook 投递状态
+const (
+	WebhookDeliveryPending   int32 = 0 // 待投递或等待重试
+	WebhookDeliverySucceeded int32 = 1 // 投递成功
+	WebhookDeliveryDead      int32 = 2 // 超过最大重试次数，进入死信
+)
+
+// WebhookSubscription Webhook 订阅，按业务模块和事件类型匹配领域事件
+type WebhookSubscription struct {
+	// ID 订阅唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// Name 订阅名称，全局唯一
+	Name string `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uk_name"`
+
+	// Module 订阅的业务模块，0 表示所有模块
+	Module int32 `gorm:"column:module;type:tinyint;not null;default:0;index:idx_module"`
+
+	// EventTypes 订阅的事件类型，逗号分隔，为空表示所有类型
+	EventTypes string `gorm:"column:event_types;type:varchar(255);not null;default:''"`
+
+	// URL 回调地址
+	URL string `gorm:"column:url;type:varchar(512);not null"`
+
+	// Secret HMAC 签名密钥
+	Secret string `gorm:"column:secret;type:varchar(128);not null"`
+
+	// Enabled 是否启用
+	Enabled bool `gorm:"column:enabled;type:tinyint(1);not null;default:1"`
+
+	// Source 订阅来源：config、admin
+	Source string `gorm:"column:source;type:varchar(16);not null"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+
+	// UpdateGmt 更新时间
+	UpdateGmt time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (s *WebhookSubscription) TableName() string {
+	return "webhook_subscription"
+}
+
+// EventTypeList 订阅的事件类型列表
+func (s *WebhookSubscription) EventTypeList() []string {
+	if s.EventTypes == "" {
+		return nil
+	}
+	return strings.Split(s.EventTypes, ",")
+}
+
+// Match 判断订阅是否关注该事件
+func (s *WebhookSubscription) Match(e *Event) bool {
+	if !s.Enabled {
+		return false
+	}
+	if s.Module != 0 && s.Module != e.Module {
+		return false
+	}
+	types := s.EventTypeList()
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == string(e.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery Webhook 投递记录，同时作为投递队列、死信队列和投递日志
+type WebhookDelivery struct {
+	// ID 投递记录唯一标识
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+
+	// SubscriptionID 订阅唯一标识
+	SubscriptionID int64 `gorm:"column:subscription_id;type:bigint;not null;uniqueIndex:uk_subscription_event,priority:1"`
+
+	// EventID 事件唯一标识
+	EventID int64 `gorm:"column:event_id;type:bigint;not null;uniqueIndex:uk_subscription_event,priority:2"`
+
+	// EventType 事件类型
+	EventType string `gorm:"column:event_type;type:varchar(32);not null"`
+
+	// Module 业务模块
+	Module int32 `gorm:"column:module;type:tinyint;not null"`
+
+	// Payload 事件 JSON
+	Payload string `gorm:"column:payload;type:text;not null"`
+
+	// Status 投递状态
+	Status int32 `gorm:"column:status;type:tinyint;not null;default:0;index:idx_delivery_status_retry,priority:1"`
+
+	// Attempts 已投递次数
+	Attempts int32 `gorm:"column:attempts;type:int;not null;default:0"`
+
+	// LastStatusCode 最近一次响应的 HTTP 状态码
+	LastStatusCode int32 `gorm:"column:last_status_code;type:int;not null;default:0"`
+
+	// LastError 最近一次失败原因
+	LastError string `gorm:"column:last_error;type:varchar(255);not null;default:''"`
+
+	// NextRetryGmt 下次投递时间
+	NextRetryGmt time.Time `gorm:"column:next_retry_gmt;type:datetime;not null;index:idx_delivery_status_retry,priority:2"`
+
+	// CreateGmt 创建时间
+	CreateGmt time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+
+	// UpdateGmt 更新时间
+	UpdateGmt time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (d *WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
+
+// WebhookDeliveryFilter 投递记录查询条件
+type WebhookDeliveryFilter struct {
+	// SubscriptionID 订阅唯一标识，0 表示不过滤
+	SubscriptionID int64
+	// Status 投递状态，nil 表示不过滤
+	Status *int32
+	// Page 页码，从 1 开始
+	Page int32
+	// PageSize 每页数量
+	PageSize int32
+}
+
+// WebhookRepo Webhook 订阅与投递记录仓储
+type WebhookRepo interface {
+	// CreateSubscription 创建订阅
+	CreateSubscription(ctx context.Context, s *WebhookSubscription) (*WebhookSubscription, error)
+	// UpsertSubscription 按名称创建或更新订阅
+	UpsertSubscription(ctx context.Context, s *WebhookSubscription) error
+	// DeleteSubscription 删除订阅，并将其待投递记录转入死信
+	DeleteSubscription(ctx context.Context, id int64) error
+	// ListSubscriptions 获取订阅列表，module 为 0 时返回全部
+	ListSubscriptions(ctx context.Context, module int32) ([]*WebhookSubscription, error)
+	// ListSubscriptionsForModule 获取关注指定模块的订阅（包含订阅所有模块的）
+	ListSubscriptionsForModule(ctx context.Context, module int32) ([]*WebhookSubscription, error)
+	// GetSubscription 获取订阅
+	GetSubscription(ctx context.Context, id int64) (*WebhookSubscription, error)
+	// CreateDeliveries 创建投递记录，同一订阅和事件的记录已存在时忽略
+	CreateDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error
+	// ListDueDeliveries 获取到期待投递的记录
+	ListDueDeliveries(ctx context.Context, limit int) ([]*WebhookDelivery, error)
+	// GetDelivery 获取投递记录
+	GetDelivery(ctx context.Context, id int64) (*WebhookDelivery, error)
+	// UpdateDelivery 更新投递结果
+	UpdateDelivery(ctx context.Context, d *WebhookDelivery) error
+	// ListDeliveries 查询投递记录
+	ListDeliveries(ctx context.Context, filter *WebhookDeliveryFilter) ([]*WebhookDelivery, error)
+}
+
+// WebhookClient 发送 Webhook 回调
+type WebhookClient interface {
+	// Post 发送 POST 请求，返回响应状态码
+	Post(ctx context.Context, url string, header map[string]string, body []byte) (int, error)
+}
+
+// SignWebhook 计算 Webhook 签名：hex(HMAC-SHA256(secret, timestamp + "." + body))
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookUsecase 管理 Webhook 订阅，并将领域事件展开为待投递记录
+type WebhookUsecase struct {
+	repo WebhookRepo
+	c    *conf.Data_Webhook
+}
+
+// NewWebhookUsecase new a Webhook usecase.
+func NewWebhookUsecase(c *conf.Data, repo WebhookRepo) *WebhookUsecase {
+	return &WebhookUsecase{repo: repo, c: c.GetWebhook()}
+}
+
+// SyncConfigSubscriptions 将配置文件中的订阅同步到订阅表
+func (uc *WebhookUsecase) SyncConfigSubscriptions(ctx context.Context) error {
+	for _, sc := range uc.c.GetSubscriptions() {
+		s := &WebhookSubscription{
+			Name:       sc.Name,
+			Module:     sc.Module,
+			EventTypes: strings.Join(sc.EventTypes, ","),
+			URL:        sc.Url,
+			Secret:     sc.Secret,
+			Enabled:    true,
+			Source:     WebhookSourceConfig,
+			CreateGmt:  time.Now().UTC(),
+			UpdateGmt:  time.Now().UTC(),
+		}
+		if err := uc.repo.UpsertSubscription(ctx, s); err != nil {
+			log.Error(ctx, "upsert config webhook subscription error.", "name", sc.Name, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateSubscription 创建订阅
+func (uc *WebhookUsecase) CreateSubscription(ctx context.Context, s *WebhookSubscription) (*WebhookSubscription, error) {
+	log.Debug(ctx, "create webhook subscription.", "name", s.Name, "module", s.Module, "event_types", s.EventTypes)
+	s.Enabled = true
+	s.Source = WebhookSourceAdmin
+	subscription, err := uc.repo.CreateSubscription(ctx, s)
+	if err != nil {
+		log.Error(ctx, "create webhook subscription error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "create webhook subscription error.")
+	}
+	log.Info(ctx, "repo create webhook subscription successful.")
+	return subscription, nil
+}
+
+// DeleteSubscription 删除订阅，配置文件中的订阅不能删除
+func (uc *WebhookUsecase) DeleteSubscription(ctx context.Context, id int64) error {
+	log.Debug(ctx, "delete webhook subscription.", "id", id)
+	s, err := uc.repo.GetSubscription(ctx, id)
+	if err != nil {
+		log.Error(ctx, "get webhook subscription error.", "err", err)
+		return errors.BadRequest(err.Error(), "get webhook subscription error.")
+	}
+	if s.Source == WebhookSourceConfig {
+		return errors.BadRequest(v1.ErrorReason_CONFIG_SUBSCRIPTION.String(), "subscription is managed by config file.")
+	}
+	if err := uc.repo.DeleteSubscription(ctx, id); err != nil {
+		log.Error(ctx, "delete webhook subscription error.", "err", err)
+		return errors.BadRequest(err.Error(), "delete webhook subscription error.")
+	}
+	log.Info(ctx, "repo delete webhook subscription successful.")
+	return nil
+}
+
+// ListSubscriptions 获取订阅列表
+func (uc *WebhookUsecase) ListSubscriptions(ctx context.Context, module int32) ([]*WebhookSubscription, error) {
+	subscriptions, err := uc.repo.ListSubscriptions(ctx, module)
+	if err != nil {
+		log.Error(ctx, "list webhook subscriptions error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "list webhook subscriptions error.")
+	}
+	return subscriptions, nil
+}
+
+// ListDeliveries 查询投递记录
+func (uc *WebhookUsecase) ListDeliveries(ctx context.Context, filter *WebhookDeliveryFilter) ([]*WebhookDelivery, error) {
+	deliveries, err := uc.repo.ListDeliveries(ctx, filter)
+	if err != nil {
+		log.Error(ctx, "list webhook deliveries error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "list webhook deliveries error.")
+	}
+	return deliveries, nil
+}
+
+// RetryDelivery 将死信记录重新放回投递队列
+func (uc *WebhookUsecase) RetryDelivery(ctx context.Context, id int64) (*WebhookDelivery, error) {
+	log.Debug(ctx, "retry webhook delivery.", "id", id)
+	d, err := uc.repo.GetDelivery(ctx, id)
+	if err != nil {
+		log.Error(ctx, "get webhook delivery error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "get webhook delivery error.")
+	}
+	if d.Status == WebhookDeliverySucceeded {
+		return d, nil
+	}
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextRetryGmt = time.Now()
+	if err := uc.repo.UpdateDelivery(ctx, d); err != nil {
+		log.Error(ctx, "update webhook delivery error.", "err", err)
+		return nil, errors.BadRequest(err.Error(), "update webhook delivery error.")
+	}
+	return d, nil
+}
+
+// Publish 实现 Publisher，为每个匹配的订阅生成一条待投递记录
+func (uc *WebhookUsecase) Publish(ctx context.Context, e *Event) error {
+	subscriptions, err := uc.repo.ListSubscriptionsForModule(ctx, e.Module)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*WebhookDelivery
+	var payload []byte
+	for _, s := range subscriptions {
+		if !s.Match(e) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(e); err != nil {
+				return err
+			}
+		}
+		now := time.Now()
+		deliveries = append(deliveries, &WebhookDelivery{
+			SubscriptionID: s.ID,
+			EventID:        e.ID,
+			EventType:      string(e.Type),
+			Module:         e.Module,
+			Payload:        string(payload),
+			Status:         WebhookDeliveryPending,
+			NextRetryGmt:   now,
+			CreateGmt:      now,
+			UpdateGmt:      now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return uc.repo.CreateDeliveries(ctx, deliveries)
+}
+
+// WebhookWorker 轮询待投递记录，签名后发送回调，失败按指数退避重试，超过最大次数进入死信
+type WebhookWorker struct {
+	uc          *WebhookUsecase
+	client      WebhookClient
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int32
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+// NewWebhookWorker new a WebhookWorker.
+func NewWebhookWorker(c *conf.Data, uc *WebhookUsecase, client WebhookClient) *WebhookWorker {
+	w := &WebhookWorker{
+		uc:          uc,
+		client:      client,
+		interval:    time.Second,
+		batchSize:   100,
+		maxAttempts: 8,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	if wc := c.GetWebhook(); wc != nil {
+		if wc.PollInterval != nil && wc.PollInterval.AsDuration() > 0 {
+			w.interval = wc.PollInterval.AsDuration()
+		}
+		if wc.BatchSize > 0 {
+			w.batchSize = int(wc.BatchSize)
+		}
+		if wc.MaxAttempts > 0 {
+			w.maxAttempts = wc.MaxAttempts
+		}
+	}
+	return w
+}
+
+// Start 同步配置中的订阅并启动投递循环，阻塞直到 Stop 被调用
+func (w *WebhookWorker) Start(ctx context.Context) error {
+	defer close(w.done)
+	if err := w.uc.SyncConfigSubscriptions(ctx); err != nil {
+		return err
+	}
+	log.Info(ctx, "webhook worker started.", "interval", w.interval, "batch_size", w.batchSize)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.Deliver(ctx)
+		}
+	}
+}
+
+// Stop 停止投递循环
+func (w *WebhookWorker) Stop(ctx context.Context) error {
+	close(w.stop)
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+	}
+	log.Info(ctx, "webhook worker stopped.")
+	return nil
+}
+
+// Deliver 投递一批到期记录，返回投递成功的数量
+func (w *WebhookWorker) Deliver(ctx context.Context) int {
+	deliveries, err := w.uc.repo.ListDueDeliveries(ctx, w.batchSize)
+	if err != nil {
+		log.Error(ctx, "list due webhook deliveries error.", "err", err)
+		return 0
+	}
+
+	succeeded := 0
+	subscriptions := make(map[int64]*WebhookSubscription)
+	for _, d := range deliveries {
+		s, ok := subscriptions[d.SubscriptionID]
+		if !ok {
+			if s, err = w.uc.repo.GetSubscription(ctx, d.SubscriptionID); err != nil {
+				// 删除订阅时会同时将其待投递记录转入死信，这里的错误视为暂时性错误，下一轮再试
+				log.Error(ctx, "get webhook subscription error.", "subscription_id", d.SubscriptionID, "err", err)
+				continue
+			}
+			subscriptions[d.SubscriptionID] = s
+		}
+
+		if w.send(ctx, s, d) {
+			succeeded++
+		}
+		if err := w.uc.repo.UpdateDelivery(ctx, d); err != nil {
+			log.Error(ctx, "update webhook delivery error.", "id", d.ID, "err", err)
+		}
+	}
+	return succeeded
+}
+
+// send 发送一次回调并更新投递记录状态，返回是否成功
+func (w *WebhookWorker) send(ctx context.Context, s *WebhookSubscription, d *WebhookDelivery) bool {
+	d.Attempts++
+	d.UpdateGmt = time.Now()
+
+	timestamp := time.Now().Unix()
+	body := []byte(d.Payload)
+	header := map[string]string{
+		"Content-Type":        "application/json",
+		"X-Comment-Event":     d.EventType,
+		"X-Comment-Event-ID":  strconv.FormatInt(d.EventID, 10),
+		"X-Comment-Delivery":  strconv.FormatInt(d.ID, 10),
+		"X-Comment-Timestamp": strconv.FormatInt(timestamp, 10),
+		"X-Comment-Signature": "sha256=" + SignWebhook(s.Secret, timestamp, body),
+	}
+	code, err := w.client.Post(ctx, s.URL, header, body)
+	d.LastStatusCode = int32(code)
+	if err == nil && (code < 200 || code >= 300) {
+		err = fmt.Errorf("webhook responded with status %d", code)
+	}
+	if err == nil {
+		d.Status = WebhookDeliverySucceeded
+		d.LastError = ""
+		return true
+	}
+
+	d.LastError = err.Error()
+	if runes := []rune(d.LastError); len(runes) > 255 {
+		d.LastError = string(runes[:255])
+	}
+	if d.Attempts >= w.maxAttempts {
+		d.Status = WebhookDeliveryDead
+		log.Warn(ctx, "webhook delivery dead-lettered.", "id", d.ID, "subscription_id", s.ID, "attempts", d.Attempts, "err", err)
+		return false
+	}
+	d.NextRetryGmt = time.Now().Add(retryBackoff(d.Attempts))
+	log.Warn(ctx, "webhook delivery failed.", "id", d.ID, "subscription_id", s.ID, "attempts", d.Attempts, "err", err)
+	return false
+}
@@ -0,0 +1,190 @@
+package biz
+
+import (
+	v1 "comment/api/comment/v1"
+	"comment/internal/conf"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// WebhookRepoMock 是WebhookRepo接口的mock实现
+type WebhookRepoMock struct {
+	mock.Mock
+}
+
+func (m *WebhookRepoMock) CreateSubscription(ctx context.Context, s *WebhookSubscription) (*WebhookSubscription, error) {
+	args := m.Called(ctx, s)
+	return args.Get(0).(*WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookRepoMock) UpsertSubscription(ctx context.Context, s *WebhookSubscription) error {
+	args := m.Called(ctx, s)
+	return args.Error(0)
+}
+
+func (m *WebhookRepoMock) DeleteSubscription(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *WebhookRepoMock) ListSubscriptions(ctx context.Context, module int32) ([]*WebhookSubscription, error) {
+	args := m.Called(ctx, module)
+	return args.Get(0).([]*WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookRepoMock) ListSubscriptionsForModule(ctx context.Context, module int32) ([]*WebhookSubscription, error) {
+	args := m.Called(ctx, module)
+	return args.Get(0).([]*WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookRepoMock) GetSubscription(ctx context.Context, id int64) (*WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*WebhookSubscription), args.Error(1)
+}
+
+func (m *WebhookRepoMock) CreateDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *WebhookRepoMock) ListDueDeliveries(ctx context.Context, limit int) ([]*WebhookDelivery, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepoMock) GetDelivery(ctx context.Context, id int64) (*WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepoMock) UpdateDelivery(ctx context.Context, d *WebhookDelivery) error {
+	args := m.Called(ctx, d)
+	return args.Error(0)
+}
+
+func (m *WebhookRepoMock) ListDeliveries(ctx context.Context, filter *WebhookDeliveryFilter) ([]*WebhookDelivery, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*WebhookDelivery), args.Error(1)
+}
+
+// WebhookClientMock 是WebhookClient接口的mock实现
+type WebhookClientMock struct {
+	mock.Mock
+}
+
+func (m *WebhookClientMock) Post(ctx context.Context, url string, header map[string]string, body []byte) (int, error) {
+	args := m.Called(ctx, url, header, body)
+	return args.Int(0), args.Error(1)
+}
+
+func TestWebhookSubscription_Match(t *testing.T) {
+	e := &Event{Type: EventCommentLiked, Module: 2}
+	tests := []struct {
+		name string
+		s    *WebhookSubscription
+		want bool
+	}{
+		{name: "订阅所有模块和事件", s: &WebhookSubscription{Enabled: true}, want: true},
+		{name: "模块匹配且事件匹配", s: &WebhookSubscription{Enabled: true, Module: 2, EventTypes: "CommentCreated,CommentLiked"}, want: true},
+		{name: "模块不匹配", s: &WebhookSubscription{Enabled: true, Module: 1}, want: false},
+		{name: "事件不匹配", s: &WebhookSubscription{Enabled: true, Module: 2, EventTypes: "CommentDeleted"}, want: false},
+		{name: "订阅已停用", s: &WebhookSubscription{Enabled: false}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.s.Match(e))
+		})
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	body := []byte(`{"id":1}`)
+	// 与 openssl 结果一致：echo -n '1700000000.{"id":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "3dd1b9aef568d75f6790a84bd2e5dfa1f44409eef3cbdbd3f10b837376100c11", SignWebhook("secret", 1700000000, body))
+	assert.NotEqual(t, SignWebhook("secret", 1700000000, body), SignWebhook("secret", 1700000001, body))
+	assert.NotEqual(t, SignWebhook("secret", 1700000000, body), SignWebhook("other", 1700000000, body))
+}
+
+func TestWebhookUsecase_Publish(t *testing.T) {
+	repo := new(WebhookRepoMock)
+	uc := NewWebhookUsecase(&conf.Data{}, repo)
+	e := &Event{ID: 7, Type: EventCommentCreated, Module: 2}
+
+	repo.On("ListSubscriptionsForModule", mock.Anything, int32(2)).Return([]*WebhookSubscription{
+		{ID: 1, Enabled: true, Module: 2, EventTypes: "CommentCreated"},
+		{ID: 2, Enabled: true, Module: 0, EventTypes: "CommentDeleted"},
+		{ID: 3, Enabled: true},
+	}, nil).Once()
+	repo.On("CreateDeliveries", mock.Anything, mock.MatchedBy(func(ds []*WebhookDelivery) bool {
+		return len(ds) == 2 && ds[0].SubscriptionID == 1 && ds[1].SubscriptionID == 3 && ds[0].EventID == 7
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.Publish(context.Background(), e))
+	repo.AssertExpectations(t)
+}
+
+func TestWebhookUsecase_DeleteSubscription(t *testing.T) {
+	repo := new(WebhookRepoMock)
+	uc := NewWebhookUsecase(&conf.Data{}, repo)
+	repo.On("GetSubscription", mock.Anything, int64(1)).Return(&WebhookSubscription{ID: 1, Source: WebhookSourceConfig}, nil).Once()
+
+	// 配置文件管理的订阅不能通过接口删除
+	err := uc.DeleteSubscription(context.Background(), 1)
+	assert.Equal(t, v1.ErrorReason_CONFIG_SUBSCRIPTION.String(), kerrors.Reason(err))
+	repo.AssertNotCalled(t, "DeleteSubscription", mock.Anything, mock.Anything)
+}
+
+func TestWebhookWorker_Deliver(t *testing.T) {
+	c := &conf.Data{Webhook: &conf.Data_Webhook{BatchSize: 10, MaxAttempts: 2}}
+	subscription := &WebhookSubscription{ID: 1, URL: "http://example.com/hook", Secret: "0123456789abcdef", Enabled: true}
+
+	tests := []struct {
+		name       string
+		attempts   int32
+		code       int
+		err        error
+		wantStatus int32
+	}{
+		{name: "投递成功", attempts: 0, code: 200, wantStatus: WebhookDeliverySucceeded},
+		{name: "非2xx响应等待重试", attempts: 0, code: 500, wantStatus: WebhookDeliveryPending},
+		{name: "超过最大次数进入死信", attempts: 1, err: errors.New("connection refused"), wantStatus: WebhookDeliveryDead},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, client := new(WebhookRepoMock), new(WebhookClientMock)
+			d := &WebhookDelivery{ID: 9, SubscriptionID: 1, EventID: 7, Payload: `{"id":7}`, Attempts: tt.attempts}
+			repo.On("ListDueDeliveries", mock.Anything, 10).Return([]*WebhookDelivery{d}, nil).Once()
+			repo.On("GetSubscription", mock.Anything, int64(1)).Return(subscription, nil).Once()
+			client.On("Post", mock.Anything, subscription.URL, mock.MatchedBy(func(h map[string]string) bool {
+				return h["X-Comment-Signature"] == "sha256="+SignWebhook(subscription.Secret, mustParseInt(h["X-Comment-Timestamp"]), []byte(d.Payload))
+			}), []byte(d.Payload)).Return(tt.code, tt.err).Once()
+			repo.On("UpdateDelivery", mock.Anything, d).Return(nil).Once()
+
+			w := NewWebhookWorker(c, NewWebhookUsecase(c, repo), client)
+			w.Deliver(context.Background())
+
+			assert.Equal(t, tt.wantStatus, d.Status)
+			assert.Equal(t, tt.attempts+1, d.Attempts)
+			if tt.wantStatus == WebhookDeliveryPending {
+				assert.True(t, d.NextRetryGmt.After(time.Now()))
+			}
+			repo.AssertExpectations(t)
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func mustParseInt(s string) int64 {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
@@ -132,6 +132,21 @@ type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Event         *Data_Event            `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Webhook       *Data_Webhook          `protobuf:"bytes,4,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Watch         *Data_Watch            `protobuf:"bytes,5,opt,name=watch,proto3" json:"watch,omitempty"`
+	Idempotency   *Data_Idempotency      `protobuf:"bytes,6,opt,name=idempotency,proto3" json:"idempotency,omitempty"`
+	Duplicate     *Data_Duplicate        `protobuf:"bytes,7,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Report        *Data_Report           `protobuf:"bytes,8,opt,name=report,proto3" json:"report,omitempty"`
+	Modules       []*Data_Module         `protobuf:"bytes,9,rep,name=modules,proto3" json:"modules,omitempty"` // 业务模块注册表，为空时使用内置的 1（article）、2（video）
+	Attachment    *Data_Attachment       `protobuf:"bytes,10,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Markdown      *Data_Markdown         `protobuf:"bytes,11,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	Search        *Data_Search           `protobuf:"bytes,12,opt,name=search,proto3" json:"search,omitempty"`
+	BulkDelete    *Data_BulkDelete       `protobuf:"bytes,13,opt,name=bulk_delete,json=bulkDelete,proto3" json:"bulk_delete,omitempty"`
+	Sharding      *Data_Sharding         `protobuf:"bytes,14,opt,name=sharding,proto3" json:"sharding,omitempty"`
+	IdGenerator   *Data_IDGenerator      `protobuf:"bytes,15,opt,name=id_generator,json=idGenerator,proto3" json:"id_generator,omitempty"`
+	Reconcile     *Data_Reconcile        `protobuf:"bytes,16,opt,name=reconcile,proto3" json:"reconcile,omitempty"`
+	Archive       *Data_Archive          `protobuf:"bytes,17,opt,name=archive,proto3" json:"archive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -180,6 +195,111 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetEvent() *Data_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Data) GetWebhook() *Data_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *Data) GetWatch() *Data_Watch {
+	if x != nil {
+		return x.Watch
+	}
+	return nil
+}
+
+func (x *Data) GetIdempotency() *Data_Idempotency {
+	if x != nil {
+		return x.Idempotency
+	}
+	return nil
+}
+
+func (x *Data) GetDuplicate() *Data_Duplicate {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+func (x *Data) GetReport() *Data_Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *Data) GetModules() []*Data_Module {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *Data) GetAttachment() *Data_Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Data) GetMarkdown() *Data_Markdown {
+	if x != nil {
+		return x.Markdown
+	}
+	return nil
+}
+
+func (x *Data) GetSearch() *Data_Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *Data) GetBulkDelete() *Data_BulkDelete {
+	if x != nil {
+		return x.BulkDelete
+	}
+	return nil
+}
+
+func (x *Data) GetSharding() *Data_Sharding {
+	if x != nil {
+		return x.Sharding
+	}
+	return nil
+}
+
+func (x *Data) GetIdGenerator() *Data_IDGenerator {
+	if x != nil {
+		return x.IdGenerator
+	}
+	return nil
+}
+
+func (x *Data) GetReconcile() *Data_Reconcile {
+	if x != nil {
+		return x.Reconcile
+	}
+	return nil
+}
+
+func (x *Data) GetArchive() *Data_Archive {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -301,15 +421,17 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Data_Database struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Driver          string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	ConnMaxLifeTime *durationpb.Duration   `protobuf:"bytes,3,opt,name=ConnMaxLifeTime,proto3" json:"ConnMaxLifeTime,omitempty"`
-	ConnMaxIdleTime *durationpb.Duration   `protobuf:"bytes,4,opt,name=ConnMaxIdleTime,proto3" json:"ConnMaxIdleTime,omitempty"`
-	IdleConns       int32                  `protobuf:"varint,5,opt,name=IdleConns,proto3" json:"IdleConns,omitempty"`
-	MaxOpenConns    int32                  `protobuf:"varint,6,opt,name=MaxOpenConns,proto3" json:"MaxOpenConns,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	Driver              string                   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // 数据库驱动：mysql（默认）、postgres、sqlite
+	Source              string                   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // 连接串，sqlite 为数据库文件路径
+	ConnMaxLifeTime     *durationpb.Duration     `protobuf:"bytes,3,opt,name=ConnMaxLifeTime,proto3" json:"ConnMaxLifeTime,omitempty"`
+	ConnMaxIdleTime     *durationpb.Duration     `protobuf:"bytes,4,opt,name=ConnMaxIdleTime,proto3" json:"ConnMaxIdleTime,omitempty"`
+	IdleConns           int32                    `protobuf:"varint,5,opt,name=IdleConns,proto3" json:"IdleConns,omitempty"`
+	MaxOpenConns        int32                    `protobuf:"varint,6,opt,name=MaxOpenConns,proto3" json:"MaxOpenConns,omitempty"`
+	Replicas            []*Data_Database_Replica `protobuf:"bytes,7,rep,name=replicas,proto3" json:"replicas,omitempty"`                                                    // 只读从库，为空时所有查询走主库
+	HealthCheckInterval *durationpb.Duration     `protobuf:"bytes,8,opt,name=health_check_interval,json=healthCheckInterval,proto3" json:"health_check_interval,omitempty"` // 从库健康检查间隔，默认 5s
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Data_Database) Reset() {
@@ -384,6 +506,20 @@ func (x *Data_Database) GetMaxOpenConns() int32 {
 	return 0
 }
 
+func (x *Data_Database) GetReplicas() []*Data_Database_Replica {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *Data_Database) GetHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HealthCheckInterval
+	}
+	return nil
+}
+
 type Data_Redis struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -452,41 +588,1223 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
-var File_conf_conf_proto protoreflect.FileDescriptor
+// 领域事件 outbox 投递配置
+type Data_Event struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Publisher       string                 `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`                                    // 投递方式：memory（默认）、webhook
+	WebhookUrl      string                 `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`                // publisher 为 webhook 时的回调地址
+	WebhookTimeout  *durationpb.Duration   `protobuf:"bytes,3,opt,name=webhook_timeout,json=webhookTimeout,proto3" json:"webhook_timeout,omitempty"`    // webhook 请求超时时间
+	PollInterval    *durationpb.Duration   `protobuf:"bytes,4,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`          // 扫描 outbox 的间隔
+	BatchSize       int32                  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                  // 每次扫描投递的最大事件数
+	MaxAttempts     int32                  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`            // 最大投递次数，超过后不再重试
+	Retention       *durationpb.Duration   `protobuf:"bytes,7,opt,name=retention,proto3" json:"retention,omitempty"`                                    // 已投递事件在 outbox 中的保留时间，默认 7 天，负数表示不清理
+	CleanupInterval *durationpb.Duration   `protobuf:"bytes,8,opt,name=cleanup_interval,json=cleanupInterval,proto3" json:"cleanup_interval,omitempty"` // 清理已投递事件的间隔，默认 1 小时
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-const file_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\"]\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xb8\x02\n" +
-	"\x06Server\x12+\n" +
-	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
-	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
-	"\x04GRPC\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xd0\x04\n" +
-	"\x04Data\x125\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a\xac\x02\n" +
-	"\bDatabase\x12\x16\n" +
-	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x12M\n" +
-	"\x0fConnMaxLifeTime\x18\x03 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x0fConnMaxLifeTime\x12M\n" +
-	"\x0fConnMaxIdleTime\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x0fConnMaxIdleTime\x12%\n" +
-	"\tIdleConns\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\tIdleConns\x12+\n" +
-	"\fMaxOpenConns\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\fMaxOpenConns\x1a\xb3\x01\n" +
-	"\x05Redis\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
-	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeoutB\x1cZ\x1acomment/internal/conf;confb\x06proto3"
+func (x *Data_Event) Reset() {
+	*x = Data_Event{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Event) ProtoMessage() {}
+
+func (x *Data_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Event.ProtoReflect.Descriptor instead.
+func (*Data_Event) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Event) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *Data_Event) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *Data_Event) GetWebhookTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WebhookTimeout
+	}
+	return nil
+}
+
+func (x *Data_Event) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Data_Event) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Event) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Data_Event) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Data_Event) GetCleanupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CleanupInterval
+	}
+	return nil
+}
+
+// Webhook 订阅与投递配置
+type Data_Webhook struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Subscriptions []*Data_Webhook_Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Timeout       *durationpb.Duration         `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`                               // 单次回调超时时间
+	PollInterval  *durationpb.Duration         `protobuf:"bytes,3,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"` // 扫描待投递记录的间隔
+	BatchSize     int32                        `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`         // 每次扫描投递的最大记录数
+	MaxAttempts   int32                        `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`   // 最大投递次数，超过后进入死信
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Webhook) Reset() {
+	*x = Data_Webhook{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Webhook) ProtoMessage() {}
+
+func (x *Data_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Webhook.ProtoReflect.Descriptor instead.
+func (*Data_Webhook) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Webhook) GetSubscriptions() []*Data_Webhook_Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *Data_Webhook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Data_Webhook) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Data_Webhook) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Webhook) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+// 评论实时推送配置
+type Data_Watch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backend       string                 `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`                          // 广播后端：memory（默认，单实例）、redis（多实例，使用 Redis pub/sub）
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`                          // backend 为 redis 时的频道名，默认 comment:watch
+	BufferSize    int32                  `protobuf:"varint,3,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"` // 每个订阅者的缓冲区大小，消费过慢时丢弃新变更
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Watch) Reset() {
+	*x = Data_Watch{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Watch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Watch) ProtoMessage() {}
+
+func (x *Data_Watch) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Watch.ProtoReflect.Descriptor instead.
+func (*Data_Watch) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_Watch) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *Data_Watch) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Data_Watch) GetBufferSize() int32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+// CreateComment 幂等键配置
+type Data_Idempotency struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Ttl                *durationpb.Duration   `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`                                                         // 幂等键保留时间，默认 24h
+	ReservationTimeout *durationpb.Duration   `protobuf:"bytes,2,opt,name=reservation_timeout,json=reservationTimeout,proto3" json:"reservation_timeout,omitempty"` // 占用幂等键后评论仍未写入的最长时间，超过后视为首次请求已中断，允许重试接管，默认 60s
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Data_Idempotency) Reset() {
+	*x = Data_Idempotency{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Idempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Idempotency) ProtoMessage() {}
+
+func (x *Data_Idempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Idempotency.ProtoReflect.Descriptor instead.
+func (*Data_Idempotency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Idempotency) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Idempotency) GetReservationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReservationTimeout
+	}
+	return nil
+}
+
+// 重复内容检测配置
+type Data_Duplicate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Store           string                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`                                                                                                                    // 近期指纹存储：memory（默认，单实例）、redis（多实例）
+	Window          *durationpb.Duration   `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`                                                                                                                  // 检测窗口，默认 600s
+	MaxRecent       int32                  `protobuf:"varint,3,opt,name=max_recent,json=maxRecent,proto3" json:"max_recent,omitempty"`                                                                                          // 每个用户保留的近期指纹数，默认 50
+	SimhashDistance int32                  `protobuf:"varint,4,opt,name=simhash_distance,json=simhashDistance,proto3" json:"simhash_distance,omitempty"`                                                                        // SimHash 汉明距离不超过该值视为近似重复，默认 6
+	MinLength       int32                  `protobuf:"varint,5,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`                                                                                          // 归一化后短于该字符数的内容不检测，默认 8
+	Policy          string                 `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy,omitempty"`                                                                                                                  // 默认策略：off（默认）、flag（标记后放行）、reject（拒绝）
+	ModulePolicies  map[int32]string       `protobuf:"bytes,7,rep,name=module_policies,json=modulePolicies,proto3" json:"module_policies,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 按模块覆盖默认策略，取值同 policy
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Duplicate) Reset() {
+	*x = Data_Duplicate{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Duplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Duplicate) ProtoMessage() {}
+
+func (x *Data_Duplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Duplicate.ProtoReflect.Descriptor instead.
+func (*Data_Duplicate) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_Duplicate) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *Data_Duplicate) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Data_Duplicate) GetMaxRecent() int32 {
+	if x != nil {
+		return x.MaxRecent
+	}
+	return 0
+}
+
+func (x *Data_Duplicate) GetSimhashDistance() int32 {
+	if x != nil {
+		return x.SimhashDistance
+	}
+	return 0
+}
+
+func (x *Data_Duplicate) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Data_Duplicate) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *Data_Duplicate) GetModulePolicies() map[int32]string {
+	if x != nil {
+		return x.ModulePolicies
+	}
+	return nil
+}
+
+// 评论举报配置
+type Data_Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HideThreshold int32                  `protobuf:"varint,1,opt,name=hide_threshold,json=hideThreshold,proto3" json:"hide_threshold,omitempty"` // 待处理举报数达到该值时自动隐藏评论，默认 5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Report) Reset() {
+	*x = Data_Report{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Report) ProtoMessage() {}
+
+func (x *Data_Report) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Report.ProtoReflect.Descriptor instead.
+func (*Data_Report) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Data_Report) GetHideThreshold() int32 {
+	if x != nil {
+		return x.HideThreshold
+	}
+	return 0
+}
+
+// 评论附件配置
+type Data_Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowedHosts  []string               `protobuf:"bytes,1,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"` // 附件地址允许的域名，*.example.com 匹配所有子域名；为空表示不允许附件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Attachment) Reset() {
+	*x = Data_Attachment{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Attachment) ProtoMessage() {}
+
+func (x *Data_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Attachment.ProtoReflect.Descriptor instead.
+func (*Data_Attachment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *Data_Attachment) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+// 评论内容渲染配置
+type Data_Markdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"` // 是否在发表评论时将受限 Markdown 渲染为 content_html 和 content_text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Markdown) Reset() {
+	*x = Data_Markdown{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Markdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Markdown) ProtoMessage() {}
+
+func (x *Data_Markdown) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Markdown.ProtoReflect.Descriptor instead.
+func (*Data_Markdown) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 9}
+}
+
+func (x *Data_Markdown) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// 评论搜索配置
+type Data_Search struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Engine        string                 `protobuf:"bytes,1,opt,name=engine,proto3" json:"engine,omitempty"` // 搜索引擎：mysql（FULLTEXT ngram 索引）、like（LIKE 子串匹配，适用于所有驱动）；为空时 mysql 驱动使用 mysql，其他驱动使用 like
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Search) Reset() {
+	*x = Data_Search{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Search) ProtoMessage() {}
+
+func (x *Data_Search) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Search.ProtoReflect.Descriptor instead.
+func (*Data_Search) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 10}
+}
+
+func (x *Data_Search) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+// 管理员批量删除任务配置
+type Data_BulkDelete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChunkSize     int32                  `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`         // 每个事务处理的匹配评论数，默认 200
+	PollInterval  *durationpb.Duration   `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"` // 扫描待执行任务的间隔，默认 1s
+	Lease         *durationpb.Duration   `protobuf:"bytes,3,opt,name=lease,proto3" json:"lease,omitempty"`                                   // 执行中任务的心跳超过该时间未刷新时可被其他实例接管，默认 60s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_BulkDelete) Reset() {
+	*x = Data_BulkDelete{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_BulkDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_BulkDelete) ProtoMessage() {}
+
+func (x *Data_BulkDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_BulkDelete.ProtoReflect.Descriptor instead.
+func (*Data_BulkDelete) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 11}
+}
+
+func (x *Data_BulkDelete) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *Data_BulkDelete) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Data_BulkDelete) GetLease() *durationpb.Duration {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+// 评论表分片配置
+type Data_Sharding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shards        int32                  `protobuf:"varint,1,opt,name=shards,proto3" json:"shards,omitempty"` // 分片表数，按 (module, resource_id) 的分桶路由到 comment_0 ~ comment_{shards-1}，应能整除 256；0 或 1 表示不分片，使用 comment 表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Sharding) Reset() {
+	*x = Data_Sharding{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Sharding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Sharding) ProtoMessage() {}
+
+func (x *Data_Sharding) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Sharding.ProtoReflect.Descriptor instead.
+func (*Data_Sharding) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 12}
+}
+
+func (x *Data_Sharding) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+// 评论ID生成配置
+type Data_IDGenerator struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId         *int32                 `protobuf:"varint,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`                    // Snowflake 机器ID，多实例间必须唯一；未配置时取主机名末尾的数字（如 comment-3），主机名不以数字结尾时取主机名哈希
+	MaxClockBackward *durationpb.Duration   `protobuf:"bytes,2,opt,name=max_clock_backward,json=maxClockBackward,proto3" json:"max_clock_backward,omitempty"` // 容忍的时钟回拨，不超过该值时等待时钟追上，超过时拒绝生成，默认 10ms
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_IDGenerator) Reset() {
+	*x = Data_IDGenerator{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_IDGenerator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_IDGenerator) ProtoMessage() {}
+
+func (x *Data_IDGenerator) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_IDGenerator.ProtoReflect.Descriptor instead.
+func (*Data_IDGenerator) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 13}
+}
+
+func (x *Data_IDGenerator) GetWorkerId() int32 {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return 0
+}
+
+func (x *Data_IDGenerator) GetMaxClockBackward() *durationpb.Duration {
+	if x != nil {
+		return x.MaxClockBackward
+	}
+	return nil
+}
+
+// 冗余计数校对配置
+type Data_Reconcile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                     // 后台校对间隔，为空或 0 表示不在后台校对，仅通过 reconcile 命令执行
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批校对的评论数，默认 500
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`          // 只报告不修复
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Reconcile) Reset() {
+	*x = Data_Reconcile{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Reconcile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Reconcile) ProtoMessage() {}
+
+func (x *Data_Reconcile) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Reconcile.ProtoReflect.Descriptor instead.
+func (*Data_Reconcile) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 14}
+}
+
+func (x *Data_Reconcile) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_Reconcile) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Reconcile) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 冷评论归档配置
+type Data_Archive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InactiveAfter *durationpb.Duration   `protobuf:"bytes,1,opt,name=inactive_after,json=inactiveAfter,proto3" json:"inactive_after,omitempty"` // 资源最后一条评论早于该时间时归档，为空或 0 表示不归档
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                                // 扫描不活跃资源的间隔，默认 1h
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`            // 每批归档的资源数，默认 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Archive) Reset() {
+	*x = Data_Archive{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Archive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Archive) ProtoMessage() {}
+
+func (x *Data_Archive) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Archive.ProtoReflect.Descriptor instead.
+func (*Data_Archive) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 15}
+}
+
+func (x *Data_Archive) GetInactiveAfter() *durationpb.Duration {
+	if x != nil {
+		return x.InactiveAfter
+	}
+	return nil
+}
+
+func (x *Data_Archive) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_Archive) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// 业务模块配置
+type Data_Module struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 模块ID，对应评论的 module 字段
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                    // 模块名称，如 article、video
+	MaxContentLength int32                  `protobuf:"varint,3,opt,name=max_content_length,json=maxContentLength,proto3" json:"max_content_length,omitempty"` // 评论内容最大字符数，默认 2000
+	MaxLevel         int32                  `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`                           // 最大嵌套层级，0 表示不限制
+	Reactions        []string               `protobuf:"bytes,5,rep,name=reactions,proto3" json:"reactions,omitempty"`                                          // 允许的互动类型，目前支持 like，为空表示不允许互动
+	DefaultSort      string                 `protobuf:"bytes,6,opt,name=default_sort,json=defaultSort,proto3" json:"default_sort,omitempty"`                   // 未指定排序时的默认排序：like_count（默认）、create_time
+	Moderation       string                 `protobuf:"bytes,7,opt,name=moderation,proto3" json:"moderation,omitempty"`                                        // 审核策略：none（默认，直接展示）、pre（先审后发，新评论先隐藏）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_Module) Reset() {
+	*x = Data_Module{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Module) ProtoMessage() {}
+
+func (x *Data_Module) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Module.ProtoReflect.Descriptor instead.
+func (*Data_Module) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 16}
+}
+
+func (x *Data_Module) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Data_Module) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data_Module) GetMaxContentLength() int32 {
+	if x != nil {
+		return x.MaxContentLength
+	}
+	return 0
+}
+
+func (x *Data_Module) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *Data_Module) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Data_Module) GetDefaultSort() string {
+	if x != nil {
+		return x.DefaultSort
+	}
+	return ""
+}
+
+func (x *Data_Module) GetModeration() string {
+	if x != nil {
+		return x.Moderation
+	}
+	return ""
+}
+
+// 只读从库
+type Data_Database_Replica struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`  // 从库连接串，驱动与连接池配置同主库
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"` // 加权轮询的权重，默认 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database_Replica) Reset() {
+	*x = Data_Database_Replica{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database_Replica) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database_Replica) ProtoMessage() {}
+
+func (x *Data_Database_Replica) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database_Replica.ProtoReflect.Descriptor instead.
+func (*Data_Database_Replica) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
+func (x *Data_Database_Replica) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Data_Database_Replica) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Data_Webhook_Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // 订阅名称，全局唯一
+	Module        int32                  `protobuf:"varint,2,opt,name=module,proto3" json:"module,omitempty"`                          // 订阅的业务模块，0 表示所有模块
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // 订阅的事件类型，为空表示所有类型
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                 // 回调地址
+	Secret        string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`                           // HMAC 签名密钥
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Webhook_Subscription) Reset() {
+	*x = Data_Webhook_Subscription{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Webhook_Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Webhook_Subscription) ProtoMessage() {}
+
+func (x *Data_Webhook_Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Webhook_Subscription.ProtoReflect.Descriptor instead.
+func (*Data_Webhook_Subscription) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
+func (x *Data_Webhook_Subscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data_Webhook_Subscription) GetModule() int32 {
+	if x != nil {
+		return x.Module
+	}
+	return 0
+}
+
+func (x *Data_Webhook_Subscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Data_Webhook_Subscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Data_Webhook_Subscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+var File_conf_conf_proto protoreflect.FileDescriptor
+
+const file_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x0fconf/conf.proto\x12\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\x1a\x17validate/validate.proto\"]\n" +
+	"\tBootstrap\x12*\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xb8\x02\n" +
+	"\x06Server\x12+\n" +
+	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xe3 \n" +
+	"\x04Data\x125\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12,\n" +
+	"\x05event\x18\x03 \x01(\v2\x16.kratos.api.Data.EventR\x05event\x122\n" +
+	"\awebhook\x18\x04 \x01(\v2\x18.kratos.api.Data.WebhookR\awebhook\x12,\n" +
+	"\x05watch\x18\x05 \x01(\v2\x16.kratos.api.Data.WatchR\x05watch\x12>\n" +
+	"\vidempotency\x18\x06 \x01(\v2\x1c.kratos.api.Data.IdempotencyR\vidempotency\x128\n" +
+	"\tduplicate\x18\a \x01(\v2\x1a.kratos.api.Data.DuplicateR\tduplicate\x12/\n" +
+	"\x06report\x18\b \x01(\v2\x17.kratos.api.Data.ReportR\x06report\x121\n" +
+	"\amodules\x18\t \x03(\v2\x17.kratos.api.Data.ModuleR\amodules\x12;\n" +
+	"\n" +
+	"attachment\x18\n" +
+	" \x01(\v2\x1b.kratos.api.Data.AttachmentR\n" +
+	"attachment\x125\n" +
+	"\bmarkdown\x18\v \x01(\v2\x19.kratos.api.Data.MarkdownR\bmarkdown\x12/\n" +
+	"\x06search\x18\f \x01(\v2\x17.kratos.api.Data.SearchR\x06search\x12<\n" +
+	"\vbulk_delete\x18\r \x01(\v2\x1b.kratos.api.Data.BulkDeleteR\n" +
+	"bulkDelete\x125\n" +
+	"\bsharding\x18\x0e \x01(\v2\x19.kratos.api.Data.ShardingR\bsharding\x12?\n" +
+	"\fid_generator\x18\x0f \x01(\v2\x1c.kratos.api.Data.IDGeneratorR\vidGenerator\x128\n" +
+	"\treconcile\x18\x10 \x01(\v2\x1a.kratos.api.Data.ReconcileR\treconcile\x122\n" +
+	"\aarchive\x18\x11 \x01(\v2\x18.kratos.api.Data.ArchiveR\aarchive\x1a\xa9\x04\n" +
+	"\bDatabase\x128\n" +
+	"\x06driver\x18\x01 \x01(\tB \xfaB\x1dr\x1bR\x00R\x05mysqlR\bpostgresR\x06sqliteR\x06driver\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12M\n" +
+	"\x0fConnMaxLifeTime\x18\x03 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x0fConnMaxLifeTime\x12M\n" +
+	"\x0fConnMaxIdleTime\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x02*\x00R\x0fConnMaxIdleTime\x12%\n" +
+	"\tIdleConns\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\tIdleConns\x12+\n" +
+	"\fMaxOpenConns\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\fMaxOpenConns\x12=\n" +
+	"\breplicas\x18\a \x03(\v2!.kratos.api.Data.Database.ReplicaR\breplicas\x12M\n" +
+	"\x15health_check_interval\x18\b \x01(\v2\x19.google.protobuf.DurationR\x13healthCheckInterval\x1aK\n" +
+	"\aReplica\x12\x1f\n" +
+	"\x06source\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06source\x12\x1f\n" +
+	"\x06weight\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06weight\x1a\xb3\x01\n" +
+	"\x05Redis\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
+	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\x8b\x03\n" +
+	"\x05Event\x12\x1c\n" +
+	"\tpublisher\x18\x01 \x01(\tR\tpublisher\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12B\n" +
+	"\x0fwebhook_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0ewebhookTimeout\x12>\n" +
+	"\rpoll_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x06 \x01(\x05R\vmaxAttempts\x127\n" +
+	"\tretention\x18\a \x01(\v2\x19.google.protobuf.DurationR\tretention\x12D\n" +
+	"\x10cleanup_interval\x18\b \x01(\v2\x19.google.protobuf.DurationR\x0fcleanupInterval\x1a\x95\x03\n" +
+	"\aWebhook\x12K\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2%.kratos.api.Data.Webhook.SubscriptionR\rsubscriptions\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12>\n" +
+	"\rpoll_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05R\vmaxAttempts\x1a\x85\x01\n" +
+	"\fSubscription\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06module\x18\x02 \x01(\x05R\x06module\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x1a\\\n" +
+	"\x05Watch\x12\x18\n" +
+	"\abackend\x18\x01 \x01(\tR\abackend\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x1f\n" +
+	"\vbuffer_size\x18\x03 \x01(\x05R\n" +
+	"bufferSize\x1a\x86\x01\n" +
+	"\vIdempotency\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12J\n" +
+	"\x13reservation_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x12reservationTimeout\x1a\xa5\x03\n" +
+	"\tDuplicate\x12,\n" +
+	"\x05store\x18\x01 \x01(\tB\x16\xfaB\x13r\x11R\x00R\x06memoryR\x05redisR\x05store\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12\x1d\n" +
+	"\n" +
+	"max_recent\x18\x03 \x01(\x05R\tmaxRecent\x12)\n" +
+	"\x10simhash_distance\x18\x04 \x01(\x05R\x0fsimhashDistance\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x05 \x01(\x05R\tminLength\x122\n" +
+	"\x06policy\x18\x06 \x01(\tB\x1a\xfaB\x17r\x15R\x00R\x03offR\x04flagR\x06rejectR\x06policy\x12W\n" +
+	"\x0fmodule_policies\x18\a \x03(\v2..kratos.api.Data.Duplicate.ModulePoliciesEntryR\x0emodulePolicies\x1aA\n" +
+	"\x13ModulePoliciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a/\n" +
+	"\x06Report\x12%\n" +
+	"\x0ehide_threshold\x18\x01 \x01(\x05R\rhideThreshold\x1a1\n" +
+	"\n" +
+	"Attachment\x12#\n" +
+	"\rallowed_hosts\x18\x01 \x03(\tR\fallowedHosts\x1a$\n" +
+	"\bMarkdown\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x1a6\n" +
+	"\x06Search\x12,\n" +
+	"\x06engine\x18\x01 \x01(\tB\x14\xfaB\x11r\x0fR\x00R\x05mysqlR\x04likeR\x06engine\x1a\x9c\x01\n" +
+	"\n" +
+	"BulkDelete\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x01 \x01(\x05R\tchunkSize\x12>\n" +
+	"\rpoll_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12/\n" +
+	"\x05lease\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x05lease\x1a.\n" +
+	"\bSharding\x12\"\n" +
+	"\x06shards\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x80\x02(\x00R\x06shards\x1a\x92\x01\n" +
+	"\vIDGenerator\x12,\n" +
+	"\tworker_id\x18\x01 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xff\x01(\x00H\x00R\bworkerId\x88\x01\x01\x12G\n" +
+	"\x12max_clock_backward\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x10maxClockBackwardB\f\n" +
+	"\n" +
+	"_worker_id\x1az\n" +
+	"\tReconcile\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x1a\xa1\x01\n" +
+	"\aArchive\x12@\n" +
+	"\x0einactive_after\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rinactiveAfter\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x1a\xe1\x01\n" +
+	"\x06Module\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\x12max_content_length\x18\x03 \x01(\x05R\x10maxContentLength\x12\x1b\n" +
+	"\tmax_level\x18\x04 \x01(\x05R\bmaxLevel\x12\x1c\n" +
+	"\treactions\x18\x05 \x03(\tR\treactions\x12!\n" +
+	"\fdefault_sort\x18\x06 \x01(\tR\vdefaultSort\x12\x1e\n" +
+	"\n" +
+	"moderation\x18\a \x01(\tR\n" +
+	"moderationB\x1cZ\x1acomment/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -500,16 +1818,34 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Server_HTTP)(nil),         // 3: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 5: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 6: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(*Bootstrap)(nil),                 // 0: kratos.api.Bootstrap
+	(*Server)(nil),                    // 1: kratos.api.Server
+	(*Data)(nil),                      // 2: kratos.api.Data
+	(*Server_HTTP)(nil),               // 3: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),               // 4: kratos.api.Server.GRPC
+	(*Data_Database)(nil),             // 5: kratos.api.Data.Database
+	(*Data_Redis)(nil),                // 6: kratos.api.Data.Redis
+	(*Data_Event)(nil),                // 7: kratos.api.Data.Event
+	(*Data_Webhook)(nil),              // 8: kratos.api.Data.Webhook
+	(*Data_Watch)(nil),                // 9: kratos.api.Data.Watch
+	(*Data_Idempotency)(nil),          // 10: kratos.api.Data.Idempotency
+	(*Data_Duplicate)(nil),            // 11: kratos.api.Data.Duplicate
+	(*Data_Report)(nil),               // 12: kratos.api.Data.Report
+	(*Data_Attachment)(nil),           // 13: kratos.api.Data.Attachment
+	(*Data_Markdown)(nil),             // 14: kratos.api.Data.Markdown
+	(*Data_Search)(nil),               // 15: kratos.api.Data.Search
+	(*Data_BulkDelete)(nil),           // 16: kratos.api.Data.BulkDelete
+	(*Data_Sharding)(nil),             // 17: kratos.api.Data.Sharding
+	(*Data_IDGenerator)(nil),          // 18: kratos.api.Data.IDGenerator
+	(*Data_Reconcile)(nil),            // 19: kratos.api.Data.Reconcile
+	(*Data_Archive)(nil),              // 20: kratos.api.Data.Archive
+	(*Data_Module)(nil),               // 21: kratos.api.Data.Module
+	(*Data_Database_Replica)(nil),     // 22: kratos.api.Data.Database.Replica
+	(*Data_Webhook_Subscription)(nil), // 23: kratos.api.Data.Webhook.Subscription
+	nil,                               // 24: kratos.api.Data.Duplicate.ModulePoliciesEntry
+	(*durationpb.Duration)(nil),       // 25: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -518,17 +1854,51 @@ var file_conf_conf_proto_depIdxs = []int32{
 	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
 	5,  // 4: kratos.api.Data.database:type_name -> kratos.api.Data.Database
 	6,  // 5: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	7,  // 6: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7,  // 7: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	7,  // 8: kratos.api.Data.Database.ConnMaxLifeTime:type_name -> google.protobuf.Duration
-	7,  // 9: kratos.api.Data.Database.ConnMaxIdleTime:type_name -> google.protobuf.Duration
-	7,  // 10: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	7,  // 11: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	7,  // 6: kratos.api.Data.event:type_name -> kratos.api.Data.Event
+	8,  // 7: kratos.api.Data.webhook:type_name -> kratos.api.Data.Webhook
+	9,  // 8: kratos.api.Data.watch:type_name -> kratos.api.Data.Watch
+	10, // 9: kratos.api.Data.idempotency:type_name -> kratos.api.Data.Idempotency
+	11, // 10: kratos.api.Data.duplicate:type_name -> kratos.api.Data.Duplicate
+	12, // 11: kratos.api.Data.report:type_name -> kratos.api.Data.Report
+	21, // 12: kratos.api.Data.modules:type_name -> kratos.api.Data.Module
+	13, // 13: kratos.api.Data.attachment:type_name -> kratos.api.Data.Attachment
+	14, // 14: kratos.api.Data.markdown:type_name -> kratos.api.Data.Markdown
+	15, // 15: kratos.api.Data.search:type_name -> kratos.api.Data.Search
+	16, // 16: kratos.api.Data.bulk_delete:type_name -> kratos.api.Data.BulkDelete
+	17, // 17: kratos.api.Data.sharding:type_name -> kratos.api.Data.Sharding
+	18, // 18: kratos.api.Data.id_generator:type_name -> kratos.api.Data.IDGenerator
+	19, // 19: kratos.api.Data.reconcile:type_name -> kratos.api.Data.Reconcile
+	20, // 20: kratos.api.Data.archive:type_name -> kratos.api.Data.Archive
+	25, // 21: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	25, // 22: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	25, // 23: kratos.api.Data.Database.ConnMaxLifeTime:type_name -> google.protobuf.Duration
+	25, // 24: kratos.api.Data.Database.ConnMaxIdleTime:type_name -> google.protobuf.Duration
+	22, // 25: kratos.api.Data.Database.replicas:type_name -> kratos.api.Data.Database.Replica
+	25, // 26: kratos.api.Data.Database.health_check_interval:type_name -> google.protobuf.Duration
+	25, // 27: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	25, // 28: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	25, // 29: kratos.api.Data.Event.webhook_timeout:type_name -> google.protobuf.Duration
+	25, // 30: kratos.api.Data.Event.poll_interval:type_name -> google.protobuf.Duration
+	25, // 31: kratos.api.Data.Event.retention:type_name -> google.protobuf.Duration
+	25, // 32: kratos.api.Data.Event.cleanup_interval:type_name -> google.protobuf.Duration
+	23, // 33: kratos.api.Data.Webhook.subscriptions:type_name -> kratos.api.Data.Webhook.Subscription
+	25, // 34: kratos.api.Data.Webhook.timeout:type_name -> google.protobuf.Duration
+	25, // 35: kratos.api.Data.Webhook.poll_interval:type_name -> google.protobuf.Duration
+	25, // 36: kratos.api.Data.Idempotency.ttl:type_name -> google.protobuf.Duration
+	25, // 37: kratos.api.Data.Idempotency.reservation_timeout:type_name -> google.protobuf.Duration
+	25, // 38: kratos.api.Data.Duplicate.window:type_name -> google.protobuf.Duration
+	24, // 39: kratos.api.Data.Duplicate.module_policies:type_name -> kratos.api.Data.Duplicate.ModulePoliciesEntry
+	25, // 40: kratos.api.Data.BulkDelete.poll_interval:type_name -> google.protobuf.Duration
+	25, // 41: kratos.api.Data.BulkDelete.lease:type_name -> google.protobuf.Duration
+	25, // 42: kratos.api.Data.IDGenerator.max_clock_backward:type_name -> google.protobuf.Duration
+	25, // 43: kratos.api.Data.Reconcile.interval:type_name -> google.protobuf.Duration
+	25, // 44: kratos.api.Data.Archive.inactive_after:type_name -> google.protobuf.Duration
+	25, // 45: kratos.api.Data.Archive.interval:type_name -> google.protobuf.Duration
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -536,13 +1906,14 @@ func file_conf_conf_proto_init() {
 	if File_conf_conf_proto != nil {
 		return
 	}
+	file_conf_conf_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
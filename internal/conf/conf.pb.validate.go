@@ -427,19 +427,2836 @@ func (m *Data) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Watch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Watch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Watch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdempotency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Idempotency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Idempotency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdempotency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Idempotency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDuplicate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Duplicate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Duplicate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuplicate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Duplicate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetModules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataValidationError{
+						field:  fmt.Sprintf("Modules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataValidationError{
+						field:  fmt.Sprintf("Modules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataValidationError{
+					field:  fmt.Sprintf("Modules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMarkdown()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Markdown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Markdown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarkdown()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Markdown",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSearch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Search",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Search",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSearch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Search",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBulkDelete()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "BulkDelete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "BulkDelete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBulkDelete()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "BulkDelete",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSharding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Sharding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Sharding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSharding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Sharding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdGenerator()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "IdGenerator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "IdGenerator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdGenerator()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "IdGenerator",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReconcile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Reconcile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Reconcile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReconcile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Reconcile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetArchive()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Archive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Archive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArchive()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Archive",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataMultiError is an error wrapping multiple validation errors returned by
+// Data.ValidateAll() if the designated constraints aren't met.
+type DataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataMultiError) AllErrors() []error { return m }
+
+// DataValidationError is the validation error returned by Data.Validate if the
+// designated constraints aren't met.
+type DataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataValidationError) ErrorName() string { return "DataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataValidationError{}
+
+// Validate checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_HTTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_HTTPMultiError, or
+// nil if none found.
+func (m *Server_HTTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_HTTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_HTTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_HTTPMultiError is an error wrapping multiple validation errors
+// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
+type Server_HTTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_HTTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_HTTPMultiError) AllErrors() []error { return m }
+
+// Server_HTTPValidationError is the validation error returned by
+// Server_HTTP.Validate if the designated constraints aren't met.
+type Server_HTTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_HTTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_HTTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_HTTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_HTTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_HTTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_HTTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_HTTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_HTTPValidationError{}
+
+// Validate checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_GRPC) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_GRPCMultiError, or
+// nil if none found.
+func (m *Server_GRPC) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_GRPC) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_GRPCValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_GRPCMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_GRPCMultiError is an error wrapping multiple validation errors
+// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
+type Server_GRPCMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_GRPCMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_GRPCMultiError) AllErrors() []error { return m }
+
+// Server_GRPCValidationError is the validation error returned by
+// Server_GRPC.Validate if the designated constraints aren't met.
+type Server_GRPCValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_GRPCValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_GRPCValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_GRPCValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_GRPCValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_GRPCValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_GRPC.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_GRPCValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_GRPCValidationError{}
+
+// Validate checks the field values on Data_Database with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Database) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Database with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
+// nil if none found.
+func (m *Data_Database) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Database) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Data_Database_Driver_InLookup[m.GetDriver()]; !ok {
+		err := Data_DatabaseValidationError{
+			field:  "Driver",
+			reason: "value must be in list [ mysql postgres sqlite]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Source
+
+	if d := m.GetConnMaxLifeTime(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Data_DatabaseValidationError{
+				field:  "ConnMaxLifeTime",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Data_DatabaseValidationError{
+					field:  "ConnMaxLifeTime",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetConnMaxIdleTime(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Data_DatabaseValidationError{
+				field:  "ConnMaxIdleTime",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := Data_DatabaseValidationError{
+					field:  "ConnMaxIdleTime",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetIdleConns() <= 0 {
+		err := Data_DatabaseValidationError{
+			field:  "IdleConns",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxOpenConns() <= 0 {
+		err := Data_DatabaseValidationError{
+			field:  "MaxOpenConns",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetReplicas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Data_DatabaseValidationError{
+						field:  fmt.Sprintf("Replicas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Data_DatabaseValidationError{
+						field:  fmt.Sprintf("Replicas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Data_DatabaseValidationError{
+					field:  fmt.Sprintf("Replicas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthCheckInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "HealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_DatabaseValidationError{
+					field:  "HealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthCheckInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_DatabaseValidationError{
+				field:  "HealthCheckInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_DatabaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_DatabaseMultiError is an error wrapping multiple validation errors
+// returned by Data_Database.ValidateAll() if the designated constraints
+// aren't met.
+type Data_DatabaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_DatabaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+
+// Data_DatabaseValidationError is the validation error returned by
+// Data_Database.Validate if the designated constraints aren't met.
+type Data_DatabaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_DatabaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_DatabaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_DatabaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Database.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_DatabaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_DatabaseValidationError{}
+
+var _Data_Database_Driver_InLookup = map[string]struct{}{
+	"":         {},
+	"mysql":    {},
+	"postgres": {},
+	"sqlite":   {},
+}
+
+// Validate checks the field values on Data_Redis with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Redis) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Redis with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_RedisMultiError, or
+// nil if none found.
+func (m *Data_Redis) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Redis) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetReadTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "ReadTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "ReadTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_RedisValidationError{
+				field:  "ReadTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWriteTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "WriteTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "WriteTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWriteTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_RedisValidationError{
+				field:  "WriteTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_RedisMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_RedisMultiError is an error wrapping multiple validation errors
+// returned by Data_Redis.ValidateAll() if the designated constraints aren't met.
+type Data_RedisMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_RedisMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_RedisMultiError) AllErrors() []error { return m }
+
+// Data_RedisValidationError is the validation error returned by
+// Data_Redis.Validate if the designated constraints aren't met.
+type Data_RedisValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_RedisValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_RedisValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_RedisValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_RedisValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_RedisValidationError) ErrorName() string { return "Data_RedisValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_RedisValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Redis.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_RedisValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_RedisValidationError{}
+
+// Validate checks the field values on Data_Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Event with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_EventMultiError, or
+// nil if none found.
+func (m *Data_Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Publisher
+
+	// no validation rules for WebhookUrl
+
+	if all {
+		switch v := interface{}(m.GetWebhookTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "WebhookTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "WebhookTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhookTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_EventValidationError{
+				field:  "WebhookTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPollInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPollInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_EventValidationError{
+				field:  "PollInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BatchSize
+
+	// no validation rules for MaxAttempts
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_EventValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCleanupInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "CleanupInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_EventValidationError{
+					field:  "CleanupInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCleanupInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_EventValidationError{
+				field:  "CleanupInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_EventMultiError is an error wrapping multiple validation errors
+// returned by Data_Event.ValidateAll() if the designated constraints aren't met.
+type Data_EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_EventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_EventMultiError) AllErrors() []error { return m }
+
+// Data_EventValidationError is the validation error returned by
+// Data_Event.Validate if the designated constraints aren't met.
+type Data_EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_EventValidationError) ErrorName() string { return "Data_EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Event.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_EventValidationError{}
+
+// Validate checks the field values on Data_Webhook with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Webhook with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_WebhookMultiError, or
+// nil if none found.
+func (m *Data_Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubscriptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Data_WebhookValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Data_WebhookValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Data_WebhookValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_WebhookValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_WebhookValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_WebhookValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPollInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_WebhookValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_WebhookValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPollInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_WebhookValidationError{
+				field:  "PollInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BatchSize
+
+	// no validation rules for MaxAttempts
+
+	if len(errors) > 0 {
+		return Data_WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_WebhookMultiError is an error wrapping multiple validation errors
+// returned by Data_Webhook.ValidateAll() if the designated constraints aren't met.
+type Data_WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_WebhookMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_WebhookMultiError) AllErrors() []error { return m }
+
+// Data_WebhookValidationError is the validation error returned by
+// Data_Webhook.Validate if the designated constraints aren't met.
+type Data_WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_WebhookValidationError) ErrorName() string { return "Data_WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Webhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_WebhookValidationError{}
+
+// Validate checks the field values on Data_Watch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Watch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Watch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_WatchMultiError, or
+// nil if none found.
+func (m *Data_Watch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Watch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Backend
+
+	// no validation rules for Channel
+
+	// no validation rules for BufferSize
+
+	if len(errors) > 0 {
+		return Data_WatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_WatchMultiError is an error wrapping multiple validation errors
+// returned by Data_Watch.ValidateAll() if the designated constraints aren't met.
+type Data_WatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_WatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_WatchMultiError) AllErrors() []error { return m }
+
+// Data_WatchValidationError is the validation error returned by
+// Data_Watch.Validate if the designated constraints aren't met.
+type Data_WatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_WatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_WatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_WatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_WatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_WatchValidationError) ErrorName() string { return "Data_WatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_WatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Watch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_WatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_WatchValidationError{}
+
+// Validate checks the field values on Data_Idempotency with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Data_Idempotency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Idempotency with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_IdempotencyMultiError, or nil if none found.
+func (m *Data_Idempotency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Idempotency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_IdempotencyValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_IdempotencyValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_IdempotencyValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReservationTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_IdempotencyValidationError{
+					field:  "ReservationTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_IdempotencyValidationError{
+					field:  "ReservationTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReservationTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_IdempotencyValidationError{
+				field:  "ReservationTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_IdempotencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_IdempotencyMultiError is an error wrapping multiple validation errors
+// returned by Data_Idempotency.ValidateAll() if the designated constraints
+// aren't met.
+type Data_IdempotencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_IdempotencyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_IdempotencyMultiError) AllErrors() []error { return m }
+
+// Data_IdempotencyValidationError is the validation error returned by
+// Data_Idempotency.Validate if the designated constraints aren't met.
+type Data_IdempotencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_IdempotencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_IdempotencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_IdempotencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_IdempotencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_IdempotencyValidationError) ErrorName() string { return "Data_IdempotencyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_IdempotencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Idempotency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_IdempotencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_IdempotencyValidationError{}
+
+// Validate checks the field values on Data_Duplicate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Duplicate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Duplicate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_DuplicateMultiError,
+// or nil if none found.
+func (m *Data_Duplicate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Duplicate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Data_Duplicate_Store_InLookup[m.GetStore()]; !ok {
+		err := Data_DuplicateValidationError{
+			field:  "Store",
+			reason: "value must be in list [ memory redis]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_DuplicateValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_DuplicateValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_DuplicateValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxRecent
+
+	// no validation rules for SimhashDistance
+
+	// no validation rules for MinLength
+
+	if _, ok := _Data_Duplicate_Policy_InLookup[m.GetPolicy()]; !ok {
+		err := Data_DuplicateValidationError{
+			field:  "Policy",
+			reason: "value must be in list [ off flag reject]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ModulePolicies
+
+	if len(errors) > 0 {
+		return Data_DuplicateMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_DuplicateMultiError is an error wrapping multiple validation errors
+// returned by Data_Duplicate.ValidateAll() if the designated constraints
+// aren't met.
+type Data_DuplicateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_DuplicateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_DuplicateMultiError) AllErrors() []error { return m }
+
+// Data_DuplicateValidationError is the validation error returned by
+// Data_Duplicate.Validate if the designated constraints aren't met.
+type Data_DuplicateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_DuplicateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_DuplicateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_DuplicateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_DuplicateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_DuplicateValidationError) ErrorName() string { return "Data_DuplicateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_DuplicateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Duplicate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_DuplicateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_DuplicateValidationError{}
+
+var _Data_Duplicate_Store_InLookup = map[string]struct{}{
+	"":       {},
+	"memory": {},
+	"redis":  {},
+}
+
+var _Data_Duplicate_Policy_InLookup = map[string]struct{}{
+	"":       {},
+	"off":    {},
+	"flag":   {},
+	"reject": {},
+}
+
+// Validate checks the field values on Data_Report with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Report with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_ReportMultiError, or
+// nil if none found.
+func (m *Data_Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HideThreshold
+
+	if len(errors) > 0 {
+		return Data_ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_ReportMultiError is an error wrapping multiple validation errors
+// returned by Data_Report.ValidateAll() if the designated constraints aren't met.
+type Data_ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_ReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_ReportMultiError) AllErrors() []error { return m }
+
+// Data_ReportValidationError is the validation error returned by
+// Data_Report.Validate if the designated constraints aren't met.
+type Data_ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_ReportValidationError) ErrorName() string { return "Data_ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Report.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_ReportValidationError{}
+
+// Validate checks the field values on Data_Attachment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Data_Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Attachment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_AttachmentMultiError, or nil if none found.
+func (m *Data_Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return Data_AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Data_Attachment.ValidateAll() if the designated constraints
+// aren't met.
+type Data_AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_AttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_AttachmentMultiError) AllErrors() []error { return m }
+
+// Data_AttachmentValidationError is the validation error returned by
+// Data_Attachment.Validate if the designated constraints aren't met.
+type Data_AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_AttachmentValidationError) ErrorName() string { return "Data_AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Attachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_AttachmentValidationError{}
+
+// Validate checks the field values on Data_Markdown with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Markdown) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Markdown with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_MarkdownMultiError, or
+// nil if none found.
+func (m *Data_Markdown) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Markdown) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return Data_MarkdownMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_MarkdownMultiError is an error wrapping multiple validation errors
+// returned by Data_Markdown.ValidateAll() if the designated constraints
+// aren't met.
+type Data_MarkdownMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_MarkdownMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_MarkdownMultiError) AllErrors() []error { return m }
+
+// Data_MarkdownValidationError is the validation error returned by
+// Data_Markdown.Validate if the designated constraints aren't met.
+type Data_MarkdownValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_MarkdownValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_MarkdownValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_MarkdownValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_MarkdownValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_MarkdownValidationError) ErrorName() string { return "Data_MarkdownValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_MarkdownValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Markdown.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_MarkdownValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_MarkdownValidationError{}
+
+// Validate checks the field values on Data_Search with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Search) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Search with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_SearchMultiError, or
+// nil if none found.
+func (m *Data_Search) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Search) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Data_Search_Engine_InLookup[m.GetEngine()]; !ok {
+		err := Data_SearchValidationError{
+			field:  "Engine",
+			reason: "value must be in list [ mysql like]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Data_SearchMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_SearchMultiError is an error wrapping multiple validation errors
+// returned by Data_Search.ValidateAll() if the designated constraints aren't met.
+type Data_SearchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_SearchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_SearchMultiError) AllErrors() []error { return m }
+
+// Data_SearchValidationError is the validation error returned by
+// Data_Search.Validate if the designated constraints aren't met.
+type Data_SearchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_SearchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_SearchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_SearchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_SearchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_SearchValidationError) ErrorName() string { return "Data_SearchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_SearchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Search.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_SearchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_SearchValidationError{}
+
+var _Data_Search_Engine_InLookup = map[string]struct{}{
+	"":      {},
+	"mysql": {},
+	"like":  {},
+}
+
+// Validate checks the field values on Data_BulkDelete with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Data_BulkDelete) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_BulkDelete with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_BulkDeleteMultiError, or nil if none found.
+func (m *Data_BulkDelete) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_BulkDelete) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChunkSize
+
+	if all {
+		switch v := interface{}(m.GetPollInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_BulkDeleteValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_BulkDeleteValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPollInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_BulkDeleteValidationError{
+				field:  "PollInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLease()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_BulkDeleteValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_BulkDeleteValidationError{
+					field:  "Lease",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLease()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_BulkDeleteValidationError{
+				field:  "Lease",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_BulkDeleteMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_BulkDeleteMultiError is an error wrapping multiple validation errors
+// returned by Data_BulkDelete.ValidateAll() if the designated constraints
+// aren't met.
+type Data_BulkDeleteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_BulkDeleteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_BulkDeleteMultiError) AllErrors() []error { return m }
+
+// Data_BulkDeleteValidationError is the validation error returned by
+// Data_BulkDelete.Validate if the designated constraints aren't met.
+type Data_BulkDeleteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_BulkDeleteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_BulkDeleteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_BulkDeleteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_BulkDeleteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_BulkDeleteValidationError) ErrorName() string { return "Data_BulkDeleteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_BulkDeleteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_BulkDelete.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_BulkDeleteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_BulkDeleteValidationError{}
+
+// Validate checks the field values on Data_Sharding with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Sharding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Sharding with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_ShardingMultiError, or
+// nil if none found.
+func (m *Data_Sharding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Sharding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetShards(); val < 0 || val > 256 {
+		err := Data_ShardingValidationError{
+			field:  "Shards",
+			reason: "value must be inside range [0, 256]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Data_ShardingMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_ShardingMultiError is an error wrapping multiple validation errors
+// returned by Data_Sharding.ValidateAll() if the designated constraints
+// aren't met.
+type Data_ShardingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_ShardingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_ShardingMultiError) AllErrors() []error { return m }
+
+// Data_ShardingValidationError is the validation error returned by
+// Data_Sharding.Validate if the designated constraints aren't met.
+type Data_ShardingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_ShardingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_ShardingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_ShardingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_ShardingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_ShardingValidationError) ErrorName() string { return "Data_ShardingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_ShardingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Sharding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_ShardingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_ShardingValidationError{}
+
+// Validate checks the field values on Data_IDGenerator with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Data_IDGenerator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_IDGenerator with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_IDGeneratorMultiError, or nil if none found.
+func (m *Data_IDGenerator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_IDGenerator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMaxClockBackward()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_IDGeneratorValidationError{
+					field:  "MaxClockBackward",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_IDGeneratorValidationError{
+					field:  "MaxClockBackward",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxClockBackward()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_IDGeneratorValidationError{
+				field:  "MaxClockBackward",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.WorkerId != nil {
+
+		if val := m.GetWorkerId(); val < 0 || val > 255 {
+			err := Data_IDGeneratorValidationError{
+				field:  "WorkerId",
+				reason: "value must be inside range [0, 255]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DataMultiError(errors)
+		return Data_IDGeneratorMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataMultiError is an error wrapping multiple validation errors returned by
-// Data.ValidateAll() if the designated constraints aren't met.
-type DataMultiError []error
+// Data_IDGeneratorMultiError is an error wrapping multiple validation errors
+// returned by Data_IDGenerator.ValidateAll() if the designated constraints
+// aren't met.
+type Data_IDGeneratorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataMultiError) Error() string {
+func (m Data_IDGeneratorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -448,11 +3265,11 @@ func (m DataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataMultiError) AllErrors() []error { return m }
+func (m Data_IDGeneratorMultiError) AllErrors() []error { return m }
 
-// DataValidationError is the validation error returned by Data.Validate if the
-// designated constraints aren't met.
-type DataValidationError struct {
+// Data_IDGeneratorValidationError is the validation error returned by
+// Data_IDGenerator.Validate if the designated constraints aren't met.
+type Data_IDGeneratorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -460,22 +3277,22 @@ type DataValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataValidationError) Field() string { return e.field }
+func (e Data_IDGeneratorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataValidationError) Reason() string { return e.reason }
+func (e Data_IDGeneratorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataValidationError) Cause() error { return e.cause }
+func (e Data_IDGeneratorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataValidationError) Key() bool { return e.key }
+func (e Data_IDGeneratorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataValidationError) ErrorName() string { return "DataValidationError" }
+func (e Data_IDGeneratorValidationError) ErrorName() string { return "Data_IDGeneratorValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DataValidationError) Error() string {
+func (e Data_IDGeneratorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -487,14 +3304,14 @@ func (e DataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData.%s: %s%s",
+		"invalid %sData_IDGenerator.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataValidationError{}
+var _ error = Data_IDGeneratorValidationError{}
 
 var _ interface {
 	Field() string
@@ -502,76 +3319,236 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataValidationError{}
+} = Data_IDGeneratorValidationError{}
 
-// Validate checks the field values on Server_HTTP with the rules defined in
+// Validate checks the field values on Data_Reconcile with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Server_HTTP) Validate() error {
+func (m *Data_Reconcile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Server_HTTP with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Server_HTTPMultiError, or
-// nil if none found.
-func (m *Server_HTTP) ValidateAll() error {
+// ValidateAll checks the field values on Data_Reconcile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_ReconcileMultiError,
+// or nil if none found.
+func (m *Data_Reconcile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Server_HTTP) validate(all bool) error {
+func (m *Data_Reconcile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
+	if all {
+		switch v := interface{}(m.GetInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_ReconcileValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_ReconcileValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_ReconcileValidationError{
+				field:  "Interval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Addr
+	// no validation rules for BatchSize
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return Data_ReconcileMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_ReconcileMultiError is an error wrapping multiple validation errors
+// returned by Data_Reconcile.ValidateAll() if the designated constraints
+// aren't met.
+type Data_ReconcileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_ReconcileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_ReconcileMultiError) AllErrors() []error { return m }
+
+// Data_ReconcileValidationError is the validation error returned by
+// Data_Reconcile.Validate if the designated constraints aren't met.
+type Data_ReconcileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_ReconcileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_ReconcileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_ReconcileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_ReconcileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_ReconcileValidationError) ErrorName() string { return "Data_ReconcileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_ReconcileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Reconcile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_ReconcileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_ReconcileValidationError{}
+
+// Validate checks the field values on Data_Archive with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Archive) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Archive with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_ArchiveMultiError, or
+// nil if none found.
+func (m *Data_Archive) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Archive) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
+		switch v := interface{}(m.GetInactiveAfter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Timeout",
+				errors = append(errors, Data_ArchiveValidationError{
+					field:  "InactiveAfter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Timeout",
+				errors = append(errors, Data_ArchiveValidationError{
+					field:  "InactiveAfter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInactiveAfter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Timeout",
+			return Data_ArchiveValidationError{
+				field:  "InactiveAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_ArchiveValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_ArchiveValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_ArchiveValidationError{
+				field:  "Interval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for BatchSize
+
 	if len(errors) > 0 {
-		return Server_HTTPMultiError(errors)
+		return Data_ArchiveMultiError(errors)
 	}
 
 	return nil
 }
 
-// Server_HTTPMultiError is an error wrapping multiple validation errors
-// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
-type Server_HTTPMultiError []error
+// Data_ArchiveMultiError is an error wrapping multiple validation errors
+// returned by Data_Archive.ValidateAll() if the designated constraints aren't met.
+type Data_ArchiveMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Server_HTTPMultiError) Error() string {
+func (m Data_ArchiveMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -580,11 +3557,11 @@ func (m Server_HTTPMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Server_HTTPMultiError) AllErrors() []error { return m }
+func (m Data_ArchiveMultiError) AllErrors() []error { return m }
 
-// Server_HTTPValidationError is the validation error returned by
-// Server_HTTP.Validate if the designated constraints aren't met.
-type Server_HTTPValidationError struct {
+// Data_ArchiveValidationError is the validation error returned by
+// Data_Archive.Validate if the designated constraints aren't met.
+type Data_ArchiveValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -592,22 +3569,22 @@ type Server_HTTPValidationError struct {
 }
 
 // Field function returns field value.
-func (e Server_HTTPValidationError) Field() string { return e.field }
+func (e Data_ArchiveValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Server_HTTPValidationError) Reason() string { return e.reason }
+func (e Data_ArchiveValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Server_HTTPValidationError) Cause() error { return e.cause }
+func (e Data_ArchiveValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Server_HTTPValidationError) Key() bool { return e.key }
+func (e Data_ArchiveValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+func (e Data_ArchiveValidationError) ErrorName() string { return "Data_ArchiveValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Server_HTTPValidationError) Error() string {
+func (e Data_ArchiveValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -619,14 +3596,14 @@ func (e Server_HTTPValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServer_HTTP.%s: %s%s",
+		"invalid %sData_Archive.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Server_HTTPValidationError{}
+var _ error = Data_ArchiveValidationError{}
 
 var _ interface {
 	Field() string
@@ -634,76 +3611,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Server_HTTPValidationError{}
+} = Data_ArchiveValidationError{}
 
-// Validate checks the field values on Server_GRPC with the rules defined in
+// Validate checks the field values on Data_Module with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Server_GRPC) Validate() error {
+func (m *Data_Module) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Server_GRPC with the rules defined in
+// ValidateAll checks the field values on Data_Module with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Server_GRPCMultiError, or
+// result is a list of violation errors wrapped in Data_ModuleMultiError, or
 // nil if none found.
-func (m *Server_GRPC) ValidateAll() error {
+func (m *Data_Module) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Server_GRPC) validate(all bool) error {
+func (m *Data_Module) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
-
-	// no validation rules for Addr
-
-	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetId() <= 0 {
+		err := Data_ModuleValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_GRPCValidationError{
-				field:  "Timeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Name
+
+	// no validation rules for MaxContentLength
+
+	// no validation rules for MaxLevel
+
+	// no validation rules for DefaultSort
+
+	// no validation rules for Moderation
+
 	if len(errors) > 0 {
-		return Server_GRPCMultiError(errors)
+		return Data_ModuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// Server_GRPCMultiError is an error wrapping multiple validation errors
-// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
-type Server_GRPCMultiError []error
+// Data_ModuleMultiError is an error wrapping multiple validation errors
+// returned by Data_Module.ValidateAll() if the designated constraints aren't met.
+type Data_ModuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Server_GRPCMultiError) Error() string {
+func (m Data_ModuleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -712,11 +3677,11 @@ func (m Server_GRPCMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Server_GRPCMultiError) AllErrors() []error { return m }
+func (m Data_ModuleMultiError) AllErrors() []error { return m }
 
-// Server_GRPCValidationError is the validation error returned by
-// Server_GRPC.Validate if the designated constraints aren't met.
-type Server_GRPCValidationError struct {
+// Data_ModuleValidationError is the validation error returned by
+// Data_Module.Validate if the designated constraints aren't met.
+type Data_ModuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -724,22 +3689,22 @@ type Server_GRPCValidationError struct {
 }
 
 // Field function returns field value.
-func (e Server_GRPCValidationError) Field() string { return e.field }
+func (e Data_ModuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Server_GRPCValidationError) Reason() string { return e.reason }
+func (e Data_ModuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Server_GRPCValidationError) Cause() error { return e.cause }
+func (e Data_ModuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Server_GRPCValidationError) Key() bool { return e.key }
+func (e Data_ModuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+func (e Data_ModuleValidationError) ErrorName() string { return "Data_ModuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Server_GRPCValidationError) Error() string {
+func (e Data_ModuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -751,14 +3716,14 @@ func (e Server_GRPCValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServer_GRPC.%s: %s%s",
+		"invalid %sData_Module.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Server_GRPCValidationError{}
+var _ error = Data_ModuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -766,98 +3731,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Server_GRPCValidationError{}
+} = Data_ModuleValidationError{}
 
-// Validate checks the field values on Data_Database with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Data_Database) Validate() error {
+// Validate checks the field values on Data_Database_Replica with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Data_Database_Replica) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Database with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
-// nil if none found.
-func (m *Data_Database) ValidateAll() error {
+// ValidateAll checks the field values on Data_Database_Replica with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_Database_ReplicaMultiError, or nil if none found.
+func (m *Data_Database_Replica) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Database) validate(all bool) error {
+func (m *Data_Database_Replica) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Driver
-
-	// no validation rules for Source
-
-	if d := m.GetConnMaxLifeTime(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = Data_DatabaseValidationError{
-				field:  "ConnMaxLifeTime",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur <= gt {
-				err := Data_DatabaseValidationError{
-					field:  "ConnMaxLifeTime",
-					reason: "value must be greater than 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
-	if d := m.GetConnMaxIdleTime(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = Data_DatabaseValidationError{
-				field:  "ConnMaxIdleTime",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur <= gt {
-				err := Data_DatabaseValidationError{
-					field:  "ConnMaxIdleTime",
-					reason: "value must be greater than 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
-	if m.GetIdleConns() <= 0 {
-		err := Data_DatabaseValidationError{
-			field:  "IdleConns",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetSource()) < 1 {
+		err := Data_Database_ReplicaValidationError{
+			field:  "Source",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -865,10 +3766,10 @@ func (m *Data_Database) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMaxOpenConns() <= 0 {
-		err := Data_DatabaseValidationError{
-			field:  "MaxOpenConns",
-			reason: "value must be greater than 0",
+	if m.GetWeight() < 0 {
+		err := Data_Database_ReplicaValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -877,19 +3778,19 @@ func (m *Data_Database) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return Data_DatabaseMultiError(errors)
+		return Data_Database_ReplicaMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_DatabaseMultiError is an error wrapping multiple validation errors
-// returned by Data_Database.ValidateAll() if the designated constraints
-// aren't met.
-type Data_DatabaseMultiError []error
+// Data_Database_ReplicaMultiError is an error wrapping multiple validation
+// errors returned by Data_Database_Replica.ValidateAll() if the designated
+// constraints aren't met.
+type Data_Database_ReplicaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_DatabaseMultiError) Error() string {
+func (m Data_Database_ReplicaMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -898,11 +3799,11 @@ func (m Data_DatabaseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+func (m Data_Database_ReplicaMultiError) AllErrors() []error { return m }
 
-// Data_DatabaseValidationError is the validation error returned by
-// Data_Database.Validate if the designated constraints aren't met.
-type Data_DatabaseValidationError struct {
+// Data_Database_ReplicaValidationError is the validation error returned by
+// Data_Database_Replica.Validate if the designated constraints aren't met.
+type Data_Database_ReplicaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -910,22 +3811,24 @@ type Data_DatabaseValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_DatabaseValidationError) Field() string { return e.field }
+func (e Data_Database_ReplicaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+func (e Data_Database_ReplicaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+func (e Data_Database_ReplicaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_DatabaseValidationError) Key() bool { return e.key }
+func (e Data_Database_ReplicaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+func (e Data_Database_ReplicaValidationError) ErrorName() string {
+	return "Data_Database_ReplicaValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Data_DatabaseValidationError) Error() string {
+func (e Data_Database_ReplicaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -937,14 +3840,14 @@ func (e Data_DatabaseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Database.%s: %s%s",
+		"invalid %sData_Database_Replica.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_DatabaseValidationError{}
+var _ error = Data_Database_ReplicaValidationError{}
 
 var _ interface {
 	Field() string
@@ -952,105 +3855,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_DatabaseValidationError{}
+} = Data_Database_ReplicaValidationError{}
 
-// Validate checks the field values on Data_Redis with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Data_Redis) Validate() error {
+// Validate checks the field values on Data_Webhook_Subscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Data_Webhook_Subscription) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Redis with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Data_RedisMultiError, or
-// nil if none found.
-func (m *Data_Redis) ValidateAll() error {
+// ValidateAll checks the field values on Data_Webhook_Subscription with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_Webhook_SubscriptionMultiError, or nil if none found.
+func (m *Data_Webhook_Subscription) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Redis) validate(all bool) error {
+func (m *Data_Webhook_Subscription) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
+	// no validation rules for Name
 
-	// no validation rules for Addr
+	// no validation rules for Module
 
-	if all {
-		switch v := interface{}(m.GetReadTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "ReadTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "ReadTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetReadTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_RedisValidationError{
-				field:  "ReadTimeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Url
 
-	if all {
-		switch v := interface{}(m.GetWriteTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "WriteTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "WriteTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWriteTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_RedisValidationError{
-				field:  "WriteTimeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Secret
 
 	if len(errors) > 0 {
-		return Data_RedisMultiError(errors)
+		return Data_Webhook_SubscriptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_RedisMultiError is an error wrapping multiple validation errors
-// returned by Data_Redis.ValidateAll() if the designated constraints aren't met.
-type Data_RedisMultiError []error
+// Data_Webhook_SubscriptionMultiError is an error wrapping multiple validation
+// errors returned by Data_Webhook_Subscription.ValidateAll() if the
+// designated constraints aren't met.
+type Data_Webhook_SubscriptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_RedisMultiError) Error() string {
+func (m Data_Webhook_SubscriptionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1059,11 +3909,11 @@ func (m Data_RedisMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_RedisMultiError) AllErrors() []error { return m }
+func (m Data_Webhook_SubscriptionMultiError) AllErrors() []error { return m }
 
-// Data_RedisValidationError is the validation error returned by
-// Data_Redis.Validate if the designated constraints aren't met.
-type Data_RedisValidationError struct {
+// Data_Webhook_SubscriptionValidationError is the validation error returned by
+// Data_Webhook_Subscription.Validate if the designated constraints aren't met.
+type Data_Webhook_SubscriptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1071,22 +3921,24 @@ type Data_RedisValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_RedisValidationError) Field() string { return e.field }
+func (e Data_Webhook_SubscriptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_RedisValidationError) Reason() string { return e.reason }
+func (e Data_Webhook_SubscriptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_RedisValidationError) Cause() error { return e.cause }
+func (e Data_Webhook_SubscriptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_RedisValidationError) Key() bool { return e.key }
+func (e Data_Webhook_SubscriptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_RedisValidationError) ErrorName() string { return "Data_RedisValidationError" }
+func (e Data_Webhook_SubscriptionValidationError) ErrorName() string {
+	return "Data_Webhook_SubscriptionValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Data_RedisValidationError) Error() string {
+func (e Data_Webhook_SubscriptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1098,14 +3950,14 @@ func (e Data_RedisValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Redis.%s: %s%s",
+		"invalid %sData_Webhook_Subscription.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_RedisValidationError{}
+var _ error = Data_Webhook_SubscriptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1113,4 +3965,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_RedisValidationError{}
+} = Data_Webhook_SubscriptionValidationError{}
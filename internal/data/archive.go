@@ -0,0 +1,141 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// archiveTable 冷评论归档表，结构与 comment 表一致，不分片
+const archiveTable = "comment_archive"
+
+// ArchivedResource 已归档的资源，资源的评论都在 comment_archive 表中
+type ArchivedResource struct {
+	Module         int32     `gorm:"column:module;type:tinyint;primaryKey;autoIncrement:false"`
+	ResourceID     string    `gorm:"column:resource_id;type:varchar(32);primaryKey"`
+	LastCommentGmt time.Time `gorm:"column:last_comment_gmt;type:datetime;not null"`
+	ArchivedGmt    time.Time `gorm:"column:archived_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (a *ArchivedResource) TableName() string {
+	return "comment_archived_resource"
+}
+
+type archiveRepo struct {
+	data *Data
+}
+
+// NewArchiveRepo .
+func NewArchiveRepo(data *Data) biz.ArchiveRepo {
+	return &archiveRepo{
+		data: data,
+	}
+}
+
+// commentTables 所有评论表，包括归档表，跨资源查询时依次访问
+func (d *Data) commentTables() []string {
+	return append(d.shards.tables(), archiveTable)
+}
+
+// resourceTables 资源的评论所在的表：资源已归档时同时查询归档表，
+// 归档后又有新评论但尚未恢复时两张表中都有该资源的评论
+func (d *Data) resourceTables(db *gorm.DB, module int32, resourceID string) ([]string, error) {
+	table := d.shards.table(module, resourceID)
+	var count int64
+	if err := db.Model(&ArchivedResource{}).Where("module = ? AND resource_id = ?", module, resourceID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return []string{table}, nil
+	}
+	return []string{table, archiveTable}, nil
+}
+
+// restoreArchived 资源已归档时把评论从归档表移回资源所在的表，在资源有新评论时调用
+func (d *Data) restoreArchived(tx *gorm.DB, module int32, resourceID string) error {
+	result := tx.Where("module = ? AND resource_id = ?", module, resourceID).Delete(&ArchivedResource{})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return result.Error
+	}
+	return moveComments(tx, archiveTable, d.shards.table(module, resourceID), "module = ? AND resource_id = ?", module, resourceID)
+}
+
+// moveComments 把 from 表中满足条件的评论移动到 to 表
+func moveComments(tx *gorm.DB, from, to string, where string, args ...any) error {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(&biz.Comment{}); err != nil {
+		return err
+	}
+	// 只移动存储的列，相关度等只读字段不在表中
+	var columns []string
+	for _, name := range stmt.Schema.DBNames {
+		if stmt.Schema.FieldsByDBName[name].Creatable {
+			columns = append(columns, tx.Statement.Quote(name))
+		}
+	}
+	list := strings.Join(columns, ", ")
+	insert := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s WHERE %s", tx.Statement.Quote(to), list, list, tx.Statement.Quote(from), where)
+	if err := tx.Exec(insert, args...).Error; err != nil {
+		return err
+	}
+	return tx.Table(from).Where(where, args...).Delete(&biz.Comment{}).Error
+}
+
+// ListInactiveResources 查询最后一条评论早于 before 且尚未归档的资源，按分片表依次查询；
+// 需要按资源分组扫描整张表，应在业务低峰期执行
+func (r *archiveRepo) ListInactiveResources(ctx context.Context, before time.Time, limit int) ([]*biz.InactiveResource, error) {
+	db := r.data.DB(ctx)
+	var resources []*biz.InactiveResource
+	for _, table := range r.data.shards.tables() {
+		var batch []*biz.InactiveResource
+		if err := db.Table(table).Select("module, resource_id").
+			Group("module, resource_id").Having("MAX(create_gmt) < ?", before).
+			Limit(limit - len(resources)).Scan(&batch).Error; err != nil {
+			return nil, err
+		}
+		resources = append(resources, batch...)
+		if len(resources) >= limit {
+			break
+		}
+	}
+	return resources, nil
+}
+
+// ArchiveResource 在事务中把资源的评论移到归档表并记录已归档，资源在 before 之后有新评论时不归档；
+// 提及、附件、点赞等子表按评论ID关联，不随评论移动
+func (r *archiveRepo) ArchiveResource(ctx context.Context, res *biz.InactiveResource, before time.Time) (int64, error) {
+	var moved int64
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		table := r.data.shards.table(res.Module, res.ResourceID)
+		var lastCommentGmts []time.Time
+		if err := tx.Table(table).Where("module = ? AND resource_id = ?", res.Module, res.ResourceID).
+			Order("create_gmt DESC").Limit(1).Pluck("create_gmt", &lastCommentGmts).Error; err != nil {
+			return err
+		}
+		if len(lastCommentGmts) == 0 || !lastCommentGmts[0].Before(before) {
+			return nil
+		}
+		if err := tx.Table(table).Where("module = ? AND resource_id = ?", res.Module, res.ResourceID).Count(&moved).Error; err != nil {
+			return err
+		}
+
+		// 归档后又有新评论时资源会被恢复，再次归档时更新归档时间
+		archived := &ArchivedResource{Module: res.Module, ResourceID: res.ResourceID, LastCommentGmt: lastCommentGmts[0], ArchivedGmt: time.Now()}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "module"}, {Name: "resource_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_comment_gmt", "archived_gmt"}),
+		}).Create(archived).Error; err != nil {
+			return err
+		}
+		return moveComments(tx, table, archiveTable, "module = ? AND resource_id = ?", res.Module, res.ResourceID)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return moved, nil
+}
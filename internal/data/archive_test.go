@@ -0,0 +1,95 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveRepo(t *testing.T) {
+	data := newTestData(t)
+	repo, archives := newTestCommentRepo(t, data), NewArchiveRepo(data)
+	ctx := context.Background()
+
+	old := time.Now().Add(-48 * time.Hour)
+	root := saveComment(t, repo, &biz.Comment{Content: "root", CreateGmt: old})
+	reply := saveComment(t, repo, &biz.Comment{Content: "reply", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1, CreateGmt: old.Add(time.Minute)})
+	_, err := repo.Save(ctx, &biz.Comment{Module: 1, ResourceID: "r2", UserID: "u1", Content: "recent"})
+	assert.NoError(t, err)
+	before := time.Now().Add(-24 * time.Hour)
+
+	t.Run("查询不活跃的资源", func(t *testing.T) {
+		resources, err := archives.ListInactiveResources(ctx, before, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, resources, 1) {
+			assert.Equal(t, "r1", resources[0].ResourceID)
+		}
+	})
+
+	t.Run("资源有新评论时不归档", func(t *testing.T) {
+		moved, err := archives.ArchiveResource(ctx, &biz.InactiveResource{Module: 1, ResourceID: "r2"}, before)
+		assert.NoError(t, err)
+		assert.Zero(t, moved)
+	})
+
+	t.Run("归档资源的评论", func(t *testing.T) {
+		moved, err := archives.ArchiveResource(ctx, &biz.InactiveResource{Module: 1, ResourceID: "r1"}, before)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), moved)
+
+		var live, archived int64
+		data.db.Table(commentTable).Where("resource_id = ?", "r1").Count(&live)
+		data.db.Table(archiveTable).Where("resource_id = ?", "r1").Count(&archived)
+		assert.Zero(t, live)
+		assert.Equal(t, int64(2), archived)
+
+		resources, err := archives.ListInactiveResources(ctx, before, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, resources)
+	})
+
+	t.Run("已归档资源的评论透明读取", func(t *testing.T) {
+		roots, err := repo.ListRootComments(ctx, 1, "r1", 1, 10, 0, nil)
+		assert.NoError(t, err)
+		if assert.Len(t, roots, 1) {
+			assert.Equal(t, root.ID, roots[0].ID)
+			assert.Equal(t, int64(1), roots[0].ReplyCount)
+		}
+
+		replies, err := repo.ListReplyComments(ctx, 1, "r1", []int64{root.ID}, 10, 0, nil)
+		assert.NoError(t, err)
+		assert.Len(t, replies, 1)
+
+		got, err := repo.Get(ctx, reply.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "reply", got.Content)
+
+		history, err := repo.ListUserComments(ctx, &biz.UserCommentQuery{UserID: "u1", Limit: 10})
+		assert.NoError(t, err)
+		assert.Len(t, history, 3)
+
+		count, err := repo.LikeComment(ctx, root.ID, "u2")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("已归档资源有新评论时恢复", func(t *testing.T) {
+		saveComment(t, repo, &biz.Comment{Content: "again", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+
+		var live, archived, markers int64
+		data.db.Table(commentTable).Where("resource_id = ?", "r1").Count(&live)
+		data.db.Table(archiveTable).Where("resource_id = ?", "r1").Count(&archived)
+		data.db.Model(&ArchivedResource{}).Count(&markers)
+		assert.Equal(t, int64(3), live)
+		assert.Zero(t, archived)
+		assert.Zero(t, markers)
+
+		got, err := repo.Get(ctx, root.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), got.ReplyCount)
+		assert.Equal(t, int64(1), got.LikeCount)
+	})
+}
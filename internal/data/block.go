@@ -0,0 +1,57 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type blockRepo struct {
+	data *Data
+}
+
+// NewBlockRepo .
+func NewBlockRepo(data *Data) biz.BlockRepo {
+	return &blockRepo{
+		data: data,
+	}
+}
+
+// Block 拉黑用户，依赖唯一索引忽略重复拉黑
+func (r *blockRepo) Block(ctx context.Context, userID, blockedUserID string) error {
+	return r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&biz.UserBlock{
+		UserID:        userID,
+		BlockedUserID: blockedUserID,
+		CreateGmt:     time.Now(),
+	}).Error
+}
+
+func (r *blockRepo) Unblock(ctx context.Context, userID, blockedUserID string) error {
+	return r.data.DB(ctx).
+		Where("user_id = ? AND blocked_user_id = ?", userID, blockedUserID).
+		Delete(&biz.UserBlock{}).Error
+}
+
+func (r *blockRepo) IsBlocked(ctx context.Context, userID, blockedUserID string) (bool, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&biz.UserBlock{}).
+		Where("user_id = ? AND blocked_user_id = ?", userID, blockedUserID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *blockRepo) ListBlockedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	var blockedUserIDs []string
+	err := r.data.DB(ctx).Model(&biz.UserBlock{}).
+		Where("user_id = ?", userID).
+		Pluck("blocked_user_id", &blockedUserIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return blockedUserIDs, nil
+}
@@ -0,0 +1,263 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type bulkDeleteRepo struct {
+	data *Data
+}
+
+// NewBulkDeleteRepo .
+func NewBulkDeleteRepo(data *Data) biz.BulkDeleteRepo {
+	return &bulkDeleteRepo{
+		data: data,
+	}
+}
+
+// bulkDeleteTables 任务匹配的评论所在的表：按资源时在资源所在的表中，按用户时在所有评论表中，都包括归档表
+func (r *bulkDeleteRepo) bulkDeleteTables(db *gorm.DB, job *biz.BulkDeleteJob) ([]string, error) {
+	if job.UserID != "" {
+		return r.data.commentTables(), nil
+	}
+	return r.data.resourceTables(db, job.Module, job.ResourceID)
+}
+
+// bulkDeleteTargets 任务匹配的评论：按用户或按资源，软删除时跳过已软删除的评论
+func bulkDeleteTargets(job *biz.BulkDeleteJob) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if job.UserID != "" {
+			db = db.Where("user_id = ?", job.UserID)
+		} else {
+			db = db.Where("module = ? AND resource_id = ?", job.Module, job.ResourceID)
+		}
+		if job.Mode == biz.BulkDeleteSoft {
+			db = db.Where("deleted = ?", false)
+		}
+		return db
+	}
+}
+
+func (r *bulkDeleteRepo) CreateBulkDeleteJob(ctx context.Context, job *biz.BulkDeleteJob) (*biz.BulkDeleteJob, error) {
+	db := r.data.DB(ctx)
+	job.Total = 0
+	tables, err := r.bulkDeleteTables(db, job)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		var count int64
+		if err := db.Table(table).Scopes(bulkDeleteTargets(job)).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		job.Total += count
+	}
+	if err := db.Create(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *bulkDeleteRepo) GetBulkDeleteJob(ctx context.Context, id int64) (*biz.BulkDeleteJob, error) {
+	var job biz.BulkDeleteJob
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ClaimBulkDeleteJob 按状态和更新时间做条件更新领取任务，多个实例同时领取时只有一个成功
+func (r *bulkDeleteRepo) ClaimBulkDeleteJob(ctx context.Context, lease time.Duration) (*biz.BulkDeleteJob, error) {
+	db := r.data.DB(ctx)
+	now := time.Now()
+
+	var job biz.BulkDeleteJob
+	err := db.Where("status = ? OR (status = ? AND update_gmt < ?)", biz.BulkDeletePending, biz.BulkDeleteRunning, now.Add(-lease)).
+		Order("id ASC").First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := db.Model(&biz.BulkDeleteJob{}).Where("id = ? AND status = ? AND update_gmt = ?", job.ID, job.Status, job.UpdateGmt).
+		Updates(map[string]interface{}{
+			"status":     biz.BulkDeleteRunning,
+			"update_gmt": now,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	job.Status = biz.BulkDeleteRunning
+	job.UpdateGmt = now
+	return &job, nil
+}
+
+// DeleteChunk 删除一批匹配的评论，重新计算受影响父评论的回复数，并在同一事务中写入删除事件和任务进度
+func (r *bulkDeleteRepo) DeleteChunk(ctx context.Context, job *biz.BulkDeleteJob, chunkSize int) (int, error) {
+	var processed, affectedCount int
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		// 按ID顺序从第一张还有匹配评论的表中取一批，已处理的评论被删除或标记后不会再次匹配；
+		// 子树和父评论与匹配的评论在同一张表中
+		tables, err := r.bulkDeleteTables(tx, job)
+		if err != nil {
+			return err
+		}
+		var table string
+		var targets []*biz.Comment
+		for _, table = range tables {
+			if err := tx.Table(table).Scopes(bulkDeleteTargets(job)).Order("id ASC").Limit(chunkSize).Find(&targets).Error; err != nil {
+				return err
+			}
+			if len(targets) > 0 {
+				break
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+
+		targetIDs := make([]int64, len(targets))
+		deletedIDs := make(map[int64][]int64, len(targets))
+		for i, t := range targets {
+			targetIDs[i] = t.ID
+			deletedIDs[t.ID] = []int64{t.ID}
+		}
+
+		// 受影响的评论：软删除只标记匹配的评论，物理删除与 DeleteBatch 一致，同时删除每条评论的整棵子树
+		affected := targets
+		if job.Mode == biz.BulkDeleteSoft {
+			if err := tx.Table(table).Where("id IN ?", targetIDs).Updates(map[string]interface{}{
+				"deleted":    true,
+				"hidden":     true,
+				"update_gmt": time.Now(),
+			}).Error; err != nil {
+				return err
+			}
+		} else {
+			comments, err := subtreeComments(tx, table, targetIDs)
+			if err != nil {
+				return err
+			}
+			affected = comments
+			parents := make(map[int64]int64, len(affected))
+			for _, c := range affected {
+				parents[c.ID] = c.ParentCommentID
+			}
+			affectedIDs := make([]int64, len(affected))
+			for i, c := range affected {
+				affectedIDs[i] = c.ID
+				// 非匹配的回复归入所在子树最上层的匹配评论的删除事件
+				if _, ok := deletedIDs[c.ID]; !ok {
+					owner := subtreeOwner(c, parents, deletedIDs)
+					deletedIDs[owner] = append(deletedIDs[owner], c.ID)
+				}
+			}
+
+			// 删除所有相关的点赞记录
+			if err := tx.Where("comment_id IN ?", affectedIDs).Delete(&CommentLike{}).Error; err != nil {
+				return err
+			}
+
+			// 删除所有相关的提及记录
+			if err := tx.Where("comment_id IN ?", affectedIDs).Delete(&biz.Mention{}).Error; err != nil {
+				return err
+			}
+
+			// 删除所有相关的附件记录
+			if err := tx.Where("comment_id IN ?", affectedIDs).Delete(&biz.Attachment{}).Error; err != nil {
+				return err
+			}
+
+			// 删除评论
+			if err := tx.Table(table).Where("id IN ?", affectedIDs).Delete(&biz.Comment{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// 重新计算受影响父评论的回复数，父评论本身也被删除时跳过
+		removed := make(map[int64]bool, len(affected))
+		if job.Mode == biz.BulkDeleteHard {
+			for _, c := range affected {
+				removed[c.ID] = true
+			}
+		}
+		recounted := make(map[int64]bool)
+		for _, c := range affected {
+			parentID := c.ParentCommentID
+			if parentID <= 0 || removed[parentID] || recounted[parentID] {
+				continue
+			}
+			recounted[parentID] = true
+
+			var replyCount int64
+			if err := tx.Table(table).Where("parent_id = ? AND deleted = ?", parentID, false).Count(&replyCount).Error; err != nil {
+				return err
+			}
+			if err := tx.Table(table).Where("id = ?", parentID).UpdateColumn("reply_count", replyCount).Error; err != nil {
+				return err
+			}
+		}
+
+		// 写入删除事件，每条匹配的评论一个事件
+		events := make([]*biz.Event, len(targets))
+		for i, t := range targets {
+			events[i] = biz.NewCommentEvent(biz.EventCommentDeleted, t)
+			events[i].DeletedCommentIDs = deletedIDs[t.ID]
+		}
+		if err := writeEvents(tx, events...); err != nil {
+			return err
+		}
+
+		// 更新任务进度，同时刷新心跳
+		if err := tx.Model(&biz.BulkDeleteJob{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+			"processed":  gorm.Expr("processed + ?", len(targets)),
+			"affected":   gorm.Expr("affected + ?", len(affected)),
+			"update_gmt": time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+		processed, affectedCount = len(targets), len(affected)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	job.Processed += int64(processed)
+	job.Affected += int64(affectedCount)
+	return processed, nil
+}
+
+func (r *bulkDeleteRepo) FinishBulkDeleteJob(ctx context.Context, job *biz.BulkDeleteJob) error {
+	return r.data.DB(ctx).Model(&biz.BulkDeleteJob{}).Where("id = ?", job.ID).
+		Updates(map[string]interface{}{
+			"status":     job.Status,
+			"error":      job.Error,
+			"update_gmt": time.Now(),
+		}).Error
+}
+
+// subtreeOwner 沿 parent_id 向上找到回复所属的最上层匹配评论，parents 为子树内评论到父评论的映射
+func subtreeOwner(c *biz.Comment, parents map[int64]int64, targets map[int64][]int64) int64 {
+	owner := c.ID
+	for id := c.ParentCommentID; id > 0; {
+		if _, matched := targets[id]; matched {
+			owner = id
+		}
+		parent, ok := parents[id]
+		if !ok {
+			break
+		}
+		id = parent
+	}
+	return owner
+}
@@ -3,6 +3,10 @@ package data
 import (
 	"comment/internal/biz"
 	"context"
+	"errors"
+	"sort"
+
+	"gorm.io/gorm"
 )
 
 type commentRepo struct {
@@ -17,40 +21,84 @@ func NewCommentRepo(data *Data) biz.CommentRepo {
 }
 
 func (r *commentRepo) Save(ctx context.Context, c *biz.Comment) (*biz.Comment, error) {
-	// 开启事务
-	tx := r.data.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return nil, tx.Error
-	}
-	defer func() {
-		if tx.Error != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
-
-	// 创建评论
-	if err := tx.Model(&biz.Comment{}).Create(c).Error; err != nil {
-		tx.Rollback()
-		return nil, err
-	}
+	// 同一资源的评论在同一张表中，父评论、被提及用户的查询都在该表内完成
+	table := r.data.shards.table(c.Module, c.ResourceID)
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		// 资源已归档时先把评论移回评论表，父评论和回复数的更新都在评论表中完成
+		if err := r.data.restoreArchived(tx, c.Module, c.ResourceID); err != nil {
+			return err
+		}
 
-	// 如果是回复评论，更新父评论的回复数
-	if c.ParentCommentID > 0 {
-		if err := tx.Model(&biz.Comment{}).Where("id = ?", c.ParentCommentID).
-			UpdateColumn("reply_count", tx.Model(&biz.Comment{}).Select("reply_count + ?", 1).Where("id = ?", c.ParentCommentID)).Error; err != nil {
-			tx.Rollback()
-			return nil, err
+		// 解析被提及用户ID：按评论者本人以及同一资源下评论过的用户的用户名或用户ID匹配，
+		// 匹配不到的提及 UserID 留空，不会出现在被提及用户的提及列表中
+		for _, m := range c.Mentions {
+			if m.Name == c.Username || m.Name == c.UserID {
+				m.UserID = c.UserID
+				continue
+			}
+			var userIDs []string
+			if err := tx.Table(table).Where("module = ? AND resource_id = ? AND (username = ? OR user_id = ?)", c.Module, c.ResourceID, m.Name, m.Name).
+				Limit(1).Pluck("user_id", &userIDs).Error; err != nil {
+				return err
+			}
+			if len(userIDs) > 0 {
+				m.UserID = userIDs[0]
+			}
 		}
-	}
 
+		// 物化路径：父评论路径加自身ID，父评论尚未回填路径时先沿祖先补齐
+		parentPath := ""
+		if c.ParentCommentID > 0 {
+			var err error
+			if parentPath, err = ensureCommentPath(tx, table, c.ParentCommentID, c.RootCommentID); err != nil {
+				return err
+			}
+		}
+		c.Path = biz.CommentPath(parentPath, c.ID)
+
+		// 创建评论，提及记录随评论一起写入
+		if err := tx.Table(table).Create(c).Error; err != nil {
+			return err
+		}
+
+		// 如果是回复评论，更新父评论的回复数
+		if c.ParentCommentID > 0 {
+			if err := tx.Table(table).Where("id = ?", c.ParentCommentID).
+				UpdateColumn("reply_count", gorm.Expr("reply_count + ?", 1)).Error; err != nil {
+				return err
+			}
+		}
+
+		// 写入领域事件，与评论在同一事务中提交
+		events := []*biz.Event{biz.NewCommentEvent(biz.EventCommentCreated, c)}
+		if c.ParentCommentID > 0 {
+			var parentUserIDs []string
+			if err := tx.Table(table).Where("id = ?", c.ParentCommentID).Pluck("user_id", &parentUserIDs).Error; err != nil {
+				return err
+			}
+			replied := biz.NewCommentEvent(biz.EventCommentReplied, c)
+			if len(parentUserIDs) > 0 {
+				replied.TargetUserID = parentUserIDs[0]
+			}
+			events = append(events, replied)
+		}
+		return writeEvents(tx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
 func (r *commentRepo) Get(ctx context.Context, id int64) (*biz.Comment, error) {
+	db := r.data.ReadDB(ctx)
+	table, err := r.data.locateComment(db, id)
+	if err != nil {
+		return nil, err
+	}
+
 	var comment biz.Comment
-	err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&comment).Error
+	err = db.Table(table).Scopes(withRelations).Where("id = ?", id).First(&comment).Error
 	if err != nil {
 		return nil, err
 	}
@@ -58,117 +106,278 @@ func (r *commentRepo) Get(ctx context.Context, id int64) (*biz.Comment, error) {
 }
 
 func (r *commentRepo) Delete(ctx context.Context, id int64) error {
-	return r.data.db.WithContext(ctx).Where("id = ?", id).Delete(&biz.Comment{}).Error
+	db := r.data.DB(ctx)
+	table, err := r.data.locateComment(db, id)
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	return db.Table(table).Where("id = ?", id).Delete(&biz.Comment{}).Error
 }
 
-// DeleteBatch 删除指定评论ID的所有相关评论（包括该评论本身及其所有回复）
+// DeleteBatch 删除指定评论及其整棵子树（包括回复的回复）
 func (r *commentRepo) DeleteBatch(ctx context.Context, id int64) error {
-	// 开启事务
-	tx := r.data.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return tx.Error
-	}
-	defer func() {
-		if tx.Error != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
-
-	// 找出所有要删除的评论ID
-	var commentIDs []int64
-	if err := tx.Model(&biz.Comment{}).Where("id = ? OR root_id = ?", id, id).Pluck("id", &commentIDs).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	// 如果有要删除的评论
-	if len(commentIDs) > 0 {
-		// 删除所有相关的点赞记录
-		if err := tx.Where("comment_id IN ?", commentIDs).Delete(&CommentLike{}).Error; err != nil {
-			tx.Rollback()
+	return r.data.transaction(ctx, func(tx *gorm.DB) error {
+		// 查询被删除的评论，用于生成删除事件；整棵子树与被删除的评论在同一张表中
+		table, err := r.data.locateComment(tx, id)
+		if err != nil {
 			return err
 		}
-
-		// 找出所有这些评论的父评论ID
-		var parentIDs []int64
-		if err := tx.Model(&biz.Comment{}).Where("id IN ? AND parent_id > 0", commentIDs).Pluck("parent_id", &parentIDs).Error; err != nil {
-			tx.Rollback()
+		var target biz.Comment
+		if err := tx.Table(table).Where("id = ?", id).First(&target).Error; err != nil {
 			return err
 		}
 
-		// 删除所有指定的评论
-		if err := tx.Where("id IN ?", commentIDs).Delete(&biz.Comment{}).Error; err != nil {
-			tx.Rollback()
+		// 找出所有要删除的评论ID
+		subtree, err := subtreeComments(tx, table, []int64{id})
+		if err != nil {
 			return err
 		}
+		commentIDs := make([]int64, len(subtree))
+		for i, c := range subtree {
+			commentIDs[i] = c.ID
+		}
 
-		// 更新所有父评论的回复数
-		for _, parentID := range parentIDs {
-			// 重新计算父评论的回复数
-			var replyCount int64
-			if err := tx.Model(&biz.Comment{}).Where("parent_id = ?", parentID).Count(&replyCount).Error; err != nil {
-				tx.Rollback()
+		// 如果有要删除的评论
+		if len(commentIDs) > 0 {
+			// 删除所有相关的点赞记录
+			if err := tx.Where("comment_id IN ?", commentIDs).Delete(&CommentLike{}).Error; err != nil {
 				return err
 			}
 
-			// 更新父评论的回复数
-			if err := tx.Model(&biz.Comment{}).Where("id = ?", parentID).UpdateColumn("reply_count", replyCount).Error; err != nil {
-				tx.Rollback()
+			// 删除所有相关的提及记录
+			if err := tx.Where("comment_id IN ?", commentIDs).Delete(&biz.Mention{}).Error; err != nil {
 				return err
 			}
-		}
-	}
 
-	return nil
-}
+			// 删除所有相关的附件记录
+			if err := tx.Where("comment_id IN ?", commentIDs).Delete(&biz.Attachment{}).Error; err != nil {
+				return err
+			}
 
-// ListRootComments 获取根评论列表
-func (r *commentRepo) ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize int32, sortType int32) ([]*biz.Comment, error) {
-	var comments []*biz.Comment
+			// 找出所有这些评论的父评论ID
+			var parentIDs []int64
+			if err := tx.Table(table).Where("id IN ? AND parent_id > 0", commentIDs).Pluck("parent_id", &parentIDs).Error; err != nil {
+				return err
+			}
 
-	// 计算偏移量
-	offset := (page - 1) * pageSize
+			// 删除所有指定的评论
+			if err := tx.Table(table).Where("id IN ?", commentIDs).Delete(&biz.Comment{}).Error; err != nil {
+				return err
+			}
+
+			// 更新所有父评论的回复数
+			for _, parentID := range parentIDs {
+				// 重新计算父评论的回复数，软删除的回复不计入
+				var replyCount int64
+				if err := tx.Table(table).Where("parent_id = ? AND deleted = ?", parentID, false).Count(&replyCount).Error; err != nil {
+					return err
+				}
+
+				// 更新父评论的回复数
+				if err := tx.Table(table).Where("id = ?", parentID).UpdateColumn("reply_count", replyCount).Error; err != nil {
+					return err
+				}
+			}
 
-	query := r.data.db.WithContext(ctx).Model(&biz.Comment{}).
-		Where("module = ? AND resource_id = ? AND level = 0", module, resourceID)
+			// 写入删除事件
+			deleted := biz.NewCommentEvent(biz.EventCommentDeleted, &target)
+			deleted.DeletedCommentIDs = commentIDs
+			if err := writeEvents(tx, deleted); err != nil {
+				return err
+			}
+		}
 
-	// 根据排序类型添加排序条件
+		return nil
+	})
+}
+
+// listOrder 评论列表的排序
+func listOrder(sortType int32) commentOrder {
 	switch sortType {
 	case 1: // CREATE_TIME_DESC 按创建时间降序
-		query = query.Order("create_gmt DESC")
+		return createDesc
 	default: // LIKE_COUNT_DESC 按点赞数降序（默认）
-		query = query.Order("like_count DESC, create_gmt DESC")
+		return likeDesc
 	}
+}
 
-	err := query.Limit(int(pageSize)).Offset(int(offset)).Find(&comments).Error
+// ListRootComments 获取根评论列表，资源已归档时从归档表读取
+func (r *commentRepo) ListRootComments(ctx context.Context, module int32, resourceID string, page, pageSize int32, sortType int32, excludeUserIDs []string) ([]*biz.Comment, error) {
+	// 计算偏移量
+	offset := (page - 1) * pageSize
+
+	db := r.data.ReadDB(ctx)
+	tables, err := r.data.resourceTables(db, module, resourceID)
 	if err != nil {
 		return nil, err
 	}
+	return r.data.findComments(db, tables, func(db *gorm.DB) *gorm.DB {
+		query := db.Scopes(withRelations).
+			Where("module = ? AND resource_id = ? AND level = 0 AND hidden = ? AND moderation_status = ?", module, resourceID, false, biz.ModerationApproved)
+		if len(excludeUserIDs) > 0 {
+			query = query.Where("user_id NOT IN ?", excludeUserIDs)
+		}
+		return query
+	}, listOrder(sortType), int(offset), int(pageSize))
+}
 
+// ListReplyComments 获取回复评论列表，根评论都属于同一资源，资源已归档时从归档表读取
+func (r *commentRepo) ListReplyComments(ctx context.Context, module int32, resourceID string, rootIDs []int64, replyLimit int32, sortType int32, excludeUserIDs []string) ([]*biz.Comment, error) {
+	db := r.data.ReadDB(ctx)
+	tables, err := r.data.resourceTables(db, module, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := listOrder(sortType)
+	var comments []*biz.Comment
+	for _, table := range tables {
+		query := db.Table(table).Scopes(withRelations).Where("root_id IN ? AND hidden = ? AND moderation_status = ?", rootIDs, false, biz.ModerationApproved)
+		if len(excludeUserIDs) > 0 {
+			query = query.Where("user_id NOT IN ?", excludeUserIDs)
+		}
+		var batch []*biz.Comment
+		if err := query.Order(order.order).Find(&batch).Error; err != nil {
+			return nil, err
+		}
+		comments = append(comments, batch...)
+	}
+	if len(tables) > 1 {
+		sort.SliceStable(comments, func(i, j int) bool { return order.less(comments[i], comments[j]) })
+	}
 	return comments, nil
 }
 
-// ListReplyComments 获取回复评论列表
-func (r *commentRepo) ListReplyComments(ctx context.Context, rootIDs []int64, replyLimit int32, sortType int32) ([]*biz.Comment, error) {
-	var comments []*biz.Comment
+// ListMentions 获取提及指定用户的评论列表，按评论创建时间降序；在所有评论表和归档表中查询并归并
+func (r *commentRepo) ListMentions(ctx context.Context, userID string, page, pageSize int32) ([]*biz.Comment, error) {
+	// 计算偏移量
+	offset := (page - 1) * pageSize
 
-	query := r.data.db.WithContext(ctx).Model(&biz.Comment{}).
-		Where("root_id IN ?", rootIDs)
+	db := r.data.DB(ctx)
+	mentioned := db.Model(&biz.Mention{}).Select("comment_id").Where("user_id = ?", userID)
+	return r.data.findComments(db, r.data.commentTables(), func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(withRelations).Where("id IN (?) AND hidden = ? AND moderation_status = ?", mentioned, false, biz.ModerationApproved)
+	}, createDesc, int(offset), int(pageSize))
+}
 
-	// 根据排序类型添加排序条件
-	switch sortType {
-	case 1: // CREATE_TIME_DESC 按创建时间降序
-		query = query.Order("create_gmt DESC")
-	default: // LIKE_COUNT_DESC 按点赞数降序（默认）
-		query = query.Order("like_count DESC, create_gmt DESC")
+// ListUserComments 按 (create_gmt, id) 游标获取用户发表的评论，使用 idx_user_create 索引；在所有评论表和归档表中查询并归并
+func (r *commentRepo) ListUserComments(ctx context.Context, q *biz.UserCommentQuery) ([]*biz.Comment, error) {
+	order := createDesc
+	if q.Ascending {
+		order = createAsc
 	}
+	return r.data.findComments(r.data.DB(ctx), r.data.commentTables(), func(db *gorm.DB) *gorm.DB {
+		query := db.Scopes(withRelations).Where("user_id = ? AND hidden = ? AND moderation_status = ?", q.UserID, false, biz.ModerationApproved)
+		if q.Module > 0 {
+			query = query.Where("module = ?", q.Module)
+		}
 
-	err := query.Find(&comments).Error
-	if err != nil {
+		// 游标之后的评论，创建时间相同时按ID区分先后
+		if q.After != nil && q.Ascending {
+			query = query.Where("(create_gmt > ? OR (create_gmt = ? AND id > ?))", q.After.CreateGmt, q.After.CreateGmt, q.After.ID)
+		} else if q.After != nil {
+			query = query.Where("(create_gmt < ? OR (create_gmt = ? AND id < ?))", q.After.CreateGmt, q.After.CreateGmt, q.After.ID)
+		}
+		return query
+	}, order, 0, int(q.Limit))
+}
+
+func (r *commentRepo) ListByIDs(ctx context.Context, ids []int64) ([]*biz.Comment, error) {
+	return r.data.listCommentsByIDs(r.data.DB(ctx), ids)
+}
+
+// subtreeComments 查询评论及其整棵子树：已有路径的评论按 path 前缀一次选出子树，
+// 路径尚未回填的回复再按 parent_id 逐层补充
+func subtreeComments(tx *gorm.DB, table string, ids []int64) ([]*biz.Comment, error) {
+	var roots []*biz.Comment
+	if err := tx.Table(table).Where("id IN ?", ids).Find(&roots).Error; err != nil {
 		return nil, err
 	}
 
+	var comments []*biz.Comment
+	seen := make(map[int64]bool, len(roots))
+	add := func(cs []*biz.Comment) []int64 {
+		var added []int64
+		for _, c := range cs {
+			if !seen[c.ID] {
+				seen[c.ID] = true
+				comments = append(comments, c)
+				added = append(added, c.ID)
+			}
+		}
+		return added
+	}
+
+	// 路径只包含数字和分隔符，前缀无需转义
+	query := tx.Table(table).Where("1 = 0")
+	prefixed := false
+	for _, c := range roots {
+		if c.Path != "" {
+			query = query.Or("path LIKE ?", c.Path+"%")
+			prefixed = true
+		}
+	}
+	if prefixed {
+		var descendants []*biz.Comment
+		if err := query.Find(&descendants).Error; err != nil {
+			return nil, err
+		}
+		add(descendants)
+	}
+
+	// 有路径的评论的祖先路径都已补齐，路径为空的评论只会挂在已选出的评论下
+	frontier := add(roots)
+	for _, c := range comments {
+		if c.Path != "" {
+			frontier = append(frontier, c.ID)
+		}
+	}
+	for len(frontier) > 0 {
+		var children []*biz.Comment
+		if err := tx.Table(table).Where("parent_id IN ? AND path = ?", frontier, "").Find(&children).Error; err != nil {
+			return nil, err
+		}
+		frontier = add(children)
+	}
 	return comments, nil
 }
+
+// ensureCommentPath 返回评论的物化路径，路径尚未回填时沿 parent_id 向上找到已有路径的祖先，再向下补齐并写回。
+// 与回填任务一致，父评论已被删除的孤儿回复挂到根评论下，根评论也不存在时作为根路径；评论不存在时按 rootID 查找
+func ensureCommentPath(tx *gorm.DB, table string, id, rootID int64) (string, error) {
+	var chain []*biz.Comment
+	path := ""
+	for cur := id; cur > 0; {
+		var c biz.Comment
+		err := tx.Table(table).Select("id", "root_id", "parent_id", "path").Where("id = ?", cur).Take(&c).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if rootID > 0 && cur != rootID {
+				cur = rootID
+				continue
+			}
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if c.Path != "" {
+			path = c.Path
+			break
+		}
+		chain = append(chain, &c)
+		cur, rootID = c.ParentCommentID, c.RootCommentID
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		path = biz.CommentPath(path, chain[i].ID)
+		if err := tx.Table(table).Where("id = ? AND path = ?", chain[i].ID, "").UpdateColumn("path", path).Error; err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+// orderAttachments 按附件在评论中的顺序预加载
+func orderAttachments(db *gorm.DB) *gorm.DB {
+	return db.Order("sort")
+}
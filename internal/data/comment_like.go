@@ -3,12 +3,15 @@ package data
 import (
 	"comment/internal/biz"
 	"context"
+	"errors"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // CommentLike 评论点赞记录模型
 type CommentLike struct {
-	ID         int64     `gorm:"column:id;type:bigint;primaryKey;autoIncrement"`
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement"`
 	CommentID  int64     `gorm:"column:comment_id;type:bigint;not null;index:idx_comment_user,unique"`
 	UserID     string    `gorm:"column:user_id;type:varchar(32);not null;index:idx_comment_user,unique"`
 	CreateTime time.Time `gorm:"column:create_time;type:datetime;not null;default:CURRENT_TIMESTAMP"`
@@ -20,94 +23,98 @@ func (cl *CommentLike) TableName() string {
 
 // LikeComment 点赞评论
 func (r *commentRepo) LikeComment(ctx context.Context, commentID int64, userID string) (int64, error) {
-	// 开启事务
-	tx := r.data.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return 0, tx.Error
-	}
-	defer func() {
-		if tx.Error != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
+	var likeCount int64
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		table, err := r.data.locateComment(tx, commentID)
+		if err != nil {
+			return err
 		}
-	}()
 
-	// 检查是否已经点赞
-	var existingLike CommentLike
-	error := tx.Where("comment_id = ? AND user_id = ?", commentID, userID).First(&existingLike).Error
-	if error == nil {
-		// 已经点赞过，直接返回当前点赞数
-		var likeCount int64
-		if err := tx.Model(&biz.Comment{}).Where("id = ?", commentID).Select("like_count").Scan(&likeCount).Error; err != nil {
-			tx.Rollback()
-			return 0, err
+		// 检查是否已经点赞
+		var existingLike CommentLike
+		err = tx.Where("comment_id = ? AND user_id = ?", commentID, userID).First(&existingLike).Error
+		if err == nil {
+			// 已经点赞过，直接返回当前点赞数
+			return tx.Table(table).Where("id = ?", commentID).Select("like_count").Scan(&likeCount).Error
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
 		}
-		return likeCount, nil
-	}
 
-	// 添加点赞记录
-	like := &CommentLike{
-		CommentID:  commentID,
-		UserID:     userID,
-		CreateTime: time.Now(),
-	}
-	if err := tx.Create(like).Error; err != nil {
-		tx.Rollback()
-		return 0, err
-	}
+		// 添加点赞记录
+		like := &CommentLike{
+			CommentID:  commentID,
+			UserID:     userID,
+			CreateTime: time.Now(),
+		}
+		if err := tx.Create(like).Error; err != nil {
+			return err
+		}
 
-	// 更新评论的点赞数
-	var likeCount int64
-	if err := tx.Model(&biz.Comment{}).Where("id = ?", commentID).
-		UpdateColumn("like_count", tx.Model(&biz.Comment{}).Select("like_count + ?", 1).Where("id = ?", commentID)).
-		Select("like_count").Scan(&likeCount).Error; err != nil {
-		tx.Rollback()
+		// 更新评论的点赞数
+		if err := tx.Table(table).Where("id = ?", commentID).UpdateColumn("like_count", gorm.Expr("like_count + ?", 1)).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(table).Where("id = ?", commentID).Select("like_count").Scan(&likeCount).Error; err != nil {
+			return err
+		}
+
+		// 写入点赞事件，与点赞记录在同一事务中提交
+		var comment biz.Comment
+		if err := tx.Table(table).Where("id = ?", commentID).First(&comment).Error; err != nil {
+			return err
+		}
+		liked := biz.NewCommentEvent(biz.EventCommentLiked, &comment)
+		liked.UserID = userID
+		liked.TargetUserID = comment.UserID
+		return writeEvents(tx, liked)
+	})
+	if err != nil {
 		return 0, err
 	}
-
 	return likeCount, nil
 }
 
 // UnlikeComment 取消点赞评论
 func (r *commentRepo) UnlikeComment(ctx context.Context, commentID int64, userID string) (int64, error) {
-	// 开启事务
-	tx := r.data.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return 0, tx.Error
-	}
-	defer func() {
-		if tx.Error != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
+	var likeCount int64
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		table, err := r.data.locateComment(tx, commentID)
+		if err != nil {
+			return err
 		}
-	}()
 
-	// 删除点赞记录
-	if err := tx.Where("comment_id = ? AND user_id = ?", commentID, userID).Delete(&CommentLike{}).Error; err != nil {
-		tx.Rollback()
-		return 0, err
-	}
+		// 删除点赞记录
+		result := tx.Where("comment_id = ? AND user_id = ?", commentID, userID).Delete(&CommentLike{})
+		if result.Error != nil {
+			return result.Error
+		}
 
-	// 如果没有删除任何记录，说明用户没有点赞过，直接返回当前点赞数
-	if tx.RowsAffected == 0 {
-		var likeCount int64
-		if err := tx.Model(&biz.Comment{}).Where("id = ?", commentID).Select("like_count").Scan(&likeCount).Error; err != nil {
-			tx.Rollback()
-			return 0, err
+		// 如果没有删除任何记录，说明用户没有点赞过，直接返回当前点赞数
+		if result.RowsAffected == 0 {
+			return tx.Table(table).Where("id = ?", commentID).Select("like_count").Scan(&likeCount).Error
 		}
-		return likeCount, nil
-	}
 
-	// 更新评论的点赞数
-	var likeCount int64
-	if err := tx.Model(&biz.Comment{}).Where("id = ?", commentID).
-		UpdateColumn("like_count", tx.Model(&biz.Comment{}).Select("like_count - ?", 1).Where("id = ?", commentID)).
-		Select("like_count").Scan(&likeCount).Error; err != nil {
-		tx.Rollback()
+		// 更新评论的点赞数
+		if err := tx.Table(table).Where("id = ?", commentID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(table).Where("id = ?", commentID).Select("like_count").Scan(&likeCount).Error; err != nil {
+			return err
+		}
+
+		// 写入取消点赞事件，与删除点赞记录在同一事务中提交
+		var comment biz.Comment
+		if err := tx.Table(table).Where("id = ?", commentID).First(&comment).Error; err != nil {
+			return err
+		}
+		unliked := biz.NewCommentEvent(biz.EventCommentUnliked, &comment)
+		unliked.UserID = userID
+		unliked.TargetUserID = comment.UserID
+		return writeEvents(tx, unliked)
+	})
+	if err != nil {
 		return 0, err
 	}
-
 	return likeCount, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,178 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReportComment 记录举报，待处理举报数达到阈值时隐藏评论
+func (r *commentRepo) ReportComment(ctx context.Context, report *biz.Report, hideThreshold int64) (int64, bool, error) {
+	var reportCount int64
+	var hidden bool
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		// 锁定被举报评论，保证并发举报时计数和隐藏判断串行执行
+		table, err := r.data.locateComment(tx, report.CommentID)
+		if err != nil {
+			return err
+		}
+		var comment biz.Comment
+		if err := tx.Table(table).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", report.CommentID).First(&comment).Error; err != nil {
+			return err
+		}
+
+		// 添加举报记录，依赖唯一索引保证同一用户只能举报一次
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrAlreadyReported
+		}
+
+		// 统计待处理的举报数
+		if err := tx.Model(&biz.Report{}).Where("comment_id = ? AND status = ?", report.CommentID, biz.ReportPending).
+			Count(&reportCount).Error; err != nil {
+			return err
+		}
+
+		// 达到阈值时隐藏评论
+		hidden = comment.Hidden
+		if !hidden && reportCount >= hideThreshold {
+			if err := tx.Table(table).Where("id = ?", report.CommentID).UpdateColumn("hidden", true).Error; err != nil {
+				return err
+			}
+			hidden = true
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return reportCount, hidden, nil
+}
+
+// ListReportedComments 按评论聚合查询举报，按举报数和最近举报时间降序
+func (r *commentRepo) ListReportedComments(ctx context.Context, filter *biz.ReportFilter, recentLimit int) ([]*biz.ReportedComment, error) {
+	// 计算偏移量
+	offset := (filter.Page - 1) * filter.PageSize
+
+	db := r.data.ReadDB(ctx)
+	query := db.Model(&biz.Report{}).
+		Select("comment_id, COUNT(*) AS report_count, MAX(create_gmt) AS last_report_gmt").
+		Where("status = ?", filter.Status)
+	if filter.Module > 0 {
+		// 模块的评论分布在所有评论表和归档表中
+		inModule := db
+		for i, table := range r.data.commentTables() {
+			sub := db.Table(table).Select("id").Where("module = ?", filter.Module)
+			if i == 0 {
+				inModule = inModule.Where("comment_id IN (?)", sub)
+			} else {
+				inModule = inModule.Or("comment_id IN (?)", sub)
+			}
+		}
+		query = query.Where(inModule)
+	}
+
+	var groups []struct {
+		CommentID     int64
+		ReportCount   int64
+		LastReportGmt time.Time
+	}
+	if err := query.Group("comment_id").Order("report_count DESC, last_report_gmt DESC").
+		Limit(int(filter.PageSize)).Offset(int(offset)).Scan(&groups).Error; err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	commentIDs := make([]int64, len(groups))
+	for i, g := range groups {
+		commentIDs[i] = g.CommentID
+	}
+
+	// 查询被举报的评论，已删除的评论不再返回
+	comments, err := r.data.listCommentsByIDs(db, commentIDs, withRelations)
+	if err != nil {
+		return nil, err
+	}
+	commentMap := make(map[int64]*biz.Comment, len(comments))
+	for _, c := range comments {
+		commentMap[c.ID] = c
+	}
+
+	// 按原因统计举报数
+	var reasonCounts []struct {
+		CommentID int64
+		Reason    int32
+		Count     int64
+	}
+	if err := db.Model(&biz.Report{}).Select("comment_id, reason, COUNT(*) AS count").
+		Where("comment_id IN ? AND status = ?", commentIDs, filter.Status).
+		Group("comment_id, reason").Scan(&reasonCounts).Error; err != nil {
+		return nil, err
+	}
+
+	reported := make([]*biz.ReportedComment, len(groups))
+	reportedMap := make(map[int64]*biz.ReportedComment, len(groups))
+	for i, g := range groups {
+		reported[i] = &biz.ReportedComment{
+			Comment:       commentMap[g.CommentID],
+			ReportCount:   g.ReportCount,
+			ReasonCounts:  make(map[int32]int64),
+			LastReportGmt: g.LastReportGmt,
+		}
+		reportedMap[g.CommentID] = reported[i]
+	}
+	for _, rc := range reasonCounts {
+		reportedMap[rc.CommentID].ReasonCounts[rc.Reason] = rc.Count
+	}
+
+	// 查询每条评论最近的举报
+	for i, g := range groups {
+		if err := db.Where("comment_id = ? AND status = ?", g.CommentID, filter.Status).
+			Order("id DESC").Limit(recentLimit).Find(&reported[i].RecentReports).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return reported, nil
+}
+
+// ResolveReports 处理评论的待处理举报，并设置评论的隐藏状态；取消隐藏时只恢复举报造成的隐藏
+func (r *commentRepo) ResolveReports(ctx context.Context, commentID int64, status int32, hidden bool) (int64, error) {
+	var resolved int64
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		result := tx.Model(&biz.Report{}).Where("comment_id = ? AND status = ?", commentID, biz.ReportPending).
+			Updates(map[string]interface{}{
+				"status":     status,
+				"update_gmt": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		resolved = result.RowsAffected
+
+		// 评论已被删除时只处理举报；软删除的评论保持隐藏
+		table, err := r.data.locateComment(tx, commentID)
+		if err != nil {
+			return ignoreNotFound(err)
+		}
+		query := tx.Table(table).Where("id = ? AND deleted = ?", commentID, false)
+		if !hidden {
+			// 驳回只恢复本次举报造成的隐藏，此前已成立的举报仍使评论保持隐藏
+			query = query.Where("NOT EXISTS (?)", tx.Model(&biz.Report{}).Select("1").
+				Where("comment_id = ? AND status = ?", commentID, biz.ReportAccepted))
+		}
+		return query.UpdateColumn("hidden", hidden).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resolved, nil
+}
@@ -0,0 +1,305 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// saveComment 保存评论，失败时终止测试
+func saveComment(t *testing.T, repo biz.CommentRepo, c *biz.Comment) *biz.Comment {
+	t.Helper()
+	c.Module, c.ResourceID = 1, "r1"
+	if c.UserID == "" {
+		c.UserID = "u1"
+	}
+	saved, err := repo.Save(context.Background(), c)
+	if err != nil {
+		t.Fatalf("save comment: %v", err)
+	}
+	return saved
+}
+
+func TestCommentRepo_Save(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+
+	root := saveComment(t, repo, &biz.Comment{Content: "root"})
+	reply := saveComment(t, repo, &biz.Comment{Content: "reply", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+
+	assert.Equal(t, biz.CommentPath("", root.ID), root.Path)
+	assert.Equal(t, biz.CommentPath(root.Path, reply.ID), reply.Path)
+
+	got, err := repo.Get(ctx, root.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), got.ReplyCount)
+
+	// 创建与回复事件和评论在同一事务中写入
+	var events int64
+	data.db.Model(&CommentEvent{}).Count(&events)
+	assert.Equal(t, int64(3), events)
+}
+
+func TestCommentRepo_LikeComment(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	c := saveComment(t, repo, &biz.Comment{Content: "like me"})
+
+	count, err := repo.LikeComment(ctx, c.ID, "u2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// 重复点赞不增加点赞数
+	count, err = repo.LikeComment(ctx, c.ID, "u2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = repo.LikeComment(ctx, c.ID, "u3")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = repo.UnlikeComment(ctx, c.ID, "u2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// 未点赞时取消点赞不减少点赞数
+	count, err = repo.UnlikeComment(ctx, c.ID, "u2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestCommentRepo_SaveMentions(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	saveComment(t, repo, &biz.Comment{UserID: "u2", Username: "jerry", Content: "first"})
+
+	c := saveComment(t, repo, &biz.Comment{UserID: "u1", Username: "tom", Content: "@jerry @u2 @nobody", Mentions: []*biz.Mention{
+		{Name: "jerry", Offset: 0, Length: 6},
+		{Name: "u2", Offset: 7, Length: 3},
+		{Name: "nobody", Offset: 11, Length: 7},
+	}})
+
+	// 用户名和用户ID都解析为评论过该资源的用户，无法解析的提及 UserID 为空
+	got, err := repo.Get(ctx, c.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, got.Mentions, 3) {
+		assert.Equal(t, "u2", got.Mentions[0].UserID)
+		assert.Equal(t, "u2", got.Mentions[1].UserID)
+		assert.Empty(t, got.Mentions[2].UserID)
+	}
+
+	mentioned, err := repo.ListMentions(ctx, "u2", 1, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, mentioned, 1) {
+		assert.Equal(t, c.ID, mentioned[0].ID)
+	}
+
+	// 原始文本不会作为用户ID出现在提及列表中
+	mentioned, err = repo.ListMentions(ctx, "nobody", 1, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, mentioned)
+}
+
+func TestCommentRepo_DeleteBatch(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+
+	root := saveComment(t, repo, &biz.Comment{Content: "root"})
+	reply := saveComment(t, repo, &biz.Comment{Content: "reply", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+	nested := saveComment(t, repo, &biz.Comment{Content: "nested", ParentCommentID: reply.ID, RootCommentID: root.ID, Level: 2})
+	sibling := saveComment(t, repo, &biz.Comment{Content: "sibling", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+	if _, err := repo.LikeComment(ctx, nested.ID, "u2"); err != nil {
+		t.Fatalf("like comment: %v", err)
+	}
+
+	// 删除回复时一并删除回复的回复及其点赞，兄弟回复保留
+	assert.NoError(t, repo.DeleteBatch(ctx, reply.ID))
+
+	_, err := repo.Get(ctx, nested.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = repo.Get(ctx, sibling.ID)
+	assert.NoError(t, err)
+
+	var likes int64
+	data.db.Model(&CommentLike{}).Count(&likes)
+	assert.Equal(t, int64(0), likes)
+
+	got, err := repo.Get(ctx, root.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), got.ReplyCount)
+}
+
+func TestCommentRepo_UnbackfilledPath(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+
+	// 模拟路径尚未回填的历史评论
+	root := saveComment(t, repo, &biz.Comment{Content: "root"})
+	reply := saveComment(t, repo, &biz.Comment{Content: "reply", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+	sibling := saveComment(t, repo, &biz.Comment{Content: "sibling", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+	data.db.Model(&biz.Comment{}).Where("1 = 1").UpdateColumn("path", "")
+
+	t.Run("回复时补齐祖先的路径", func(t *testing.T) {
+		nested := saveComment(t, repo, &biz.Comment{Content: "nested", ParentCommentID: reply.ID, RootCommentID: root.ID, Level: 2})
+		assert.Equal(t, biz.CommentPath(biz.CommentPath(biz.CommentPath("", root.ID), reply.ID), nested.ID), nested.Path)
+
+		got, err := repo.Get(ctx, reply.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, biz.CommentPath(biz.CommentPath("", root.ID), reply.ID), got.Path)
+	})
+
+	t.Run("部分回填时路径前缀与父评论共同选取子树", func(t *testing.T) {
+		nested := saveComment(t, repo, &biz.Comment{Content: "nested", ParentCommentID: reply.ID, RootCommentID: root.ID, Level: 2})
+		deeper := saveComment(t, repo, &biz.Comment{Content: "deeper", ParentCommentID: nested.ID, RootCommentID: root.ID, Level: 3})
+		other := saveComment(t, repo, &biz.Comment{Content: "other", ParentCommentID: sibling.ID, RootCommentID: root.ID, Level: 2})
+		data.db.Model(&biz.Comment{}).Where("id = ?", deeper.ID).UpdateColumn("path", "")
+		assert.NoError(t, repo.DeleteBatch(ctx, nested.ID))
+
+		var left []int64
+		data.db.Model(&biz.Comment{}).Where("parent_id <> ?", 0).Order("id").Pluck("id", &left)
+		assert.NotContains(t, left, nested.ID)
+		assert.NotContains(t, left, deeper.ID)
+		assert.Contains(t, left, other.ID)
+	})
+
+	t.Run("删除时按父评论选取子树", func(t *testing.T) {
+		data.db.Model(&biz.Comment{}).Where("1 = 1").UpdateColumn("path", "")
+		assert.NoError(t, repo.DeleteBatch(ctx, reply.ID))
+
+		var left []int64
+		data.db.Model(&biz.Comment{}).Where("level < ?", 2).Order("id").Pluck("id", &left)
+		assert.Equal(t, []int64{root.ID, sibling.ID}, left)
+	})
+}
+
+func TestCommentRepo_ModerateComment(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	pending := saveComment(t, repo, &biz.Comment{Content: "pending", ModerationStatus: biz.ModerationPending})
+
+	roots, err := repo.ListRootComments(ctx, 1, "r1", 1, 10, 1, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, roots)
+
+	got, err := repo.ModerateComment(ctx, pending.ID, biz.ModerationApproved)
+	assert.NoError(t, err)
+	assert.Equal(t, biz.ModerationApproved, got.ModerationStatus)
+	roots, err = repo.ListRootComments(ctx, 1, "r1", 1, 10, 1, nil)
+	assert.NoError(t, err)
+	assert.Len(t, roots, 1)
+
+	// 重复审核通过不再写入事件
+	_, err = repo.ModerateComment(ctx, pending.ID, biz.ModerationApproved)
+	assert.NoError(t, err)
+	var approved int64
+	data.db.Model(&CommentEvent{}).Where("event_type = ?", biz.EventCommentApproved).Count(&approved)
+	assert.Equal(t, int64(1), approved)
+}
+
+func TestCommentRepo_ResolveReports(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	c := saveComment(t, repo, &biz.Comment{Content: "reported"})
+
+	report := func(userID string) {
+		t.Helper()
+		_, _, err := repo.ReportComment(ctx, &biz.Report{CommentID: c.ID, UserID: userID, Reason: 1}, 1)
+		assert.NoError(t, err)
+	}
+	hidden := func() bool {
+		got, err := repo.Get(ctx, c.ID)
+		assert.NoError(t, err)
+		return got.Hidden
+	}
+
+	// 举报造成的隐藏在驳回后恢复
+	report("u2")
+	assert.True(t, hidden())
+	_, err := repo.ResolveReports(ctx, c.ID, biz.ReportDismissed, false)
+	assert.NoError(t, err)
+	assert.False(t, hidden())
+
+	// 举报成立后，驳回新的举报不恢复显示
+	report("u3")
+	_, err = repo.ResolveReports(ctx, c.ID, biz.ReportAccepted, true)
+	assert.NoError(t, err)
+	report("u4")
+	_, err = repo.ResolveReports(ctx, c.ID, biz.ReportDismissed, false)
+	assert.NoError(t, err)
+	assert.True(t, hidden())
+}
+
+func TestCommentSearcher_Like(t *testing.T) {
+	data := newTestData(t)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	searcher := &likeSearcher{data: data}
+
+	saveComment(t, repo, &biz.Comment{Content: "打折 100% 真的"})
+	saveComment(t, repo, &biz.Comment{Content: "打折 1000 真的"})
+
+	// 通配符按字面量匹配
+	got, total, err := searcher.Search(ctx, &biz.SearchQuery{Keyword: "100%", Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "打折 100% 真的", got[0].Content)
+	}
+
+	_, total, err = searcher.Search(ctx, &biz.SearchQuery{Keyword: "打折", Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
+
+func TestSearch_MergeByRelevance(t *testing.T) {
+	data := newTestShardedData(t, 3)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	r1, r2 := resourcesOnDistinctShards(data.shards)
+
+	// 相关度越高的评论越早发表，按创建时间归并时顺序相反
+	scores := []struct {
+		resourceID string
+		count      int
+	}{{r1, 5}, {r2, 4}, {r2, 3}, {r1, 2}, {r1, 1}}
+	for i, s := range scores {
+		_, err := repo.Save(ctx, &biz.Comment{Module: 1, ResourceID: s.resourceID, UserID: "u1",
+			Content: strings.Repeat("好评", s.count), CreateGmt: time.Now().Add(time.Duration(i) * time.Minute)})
+		assert.NoError(t, err)
+	}
+
+	// SQLite 没有全文索引，以关键词出现次数作为相关度
+	q := &biz.SearchQuery{Keyword: "好评", PageSize: 2}
+	match := func(db *gorm.DB) *gorm.DB {
+		return db.Where("content LIKE ?", "%"+q.Keyword+"%").Scopes(searchFilters(q))
+	}
+	occurrences := withRelevance("(LENGTH(content) - LENGTH(REPLACE(content, ?, ''))) / LENGTH(?)", q.Keyword, q.Keyword)
+	page := func(n int32) []int {
+		q.Page = n
+		comments, total, err := search(data, data.db, q, match, relevanceDesc, occurrences)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), total)
+		counts := make([]int, len(comments))
+		for i, c := range comments {
+			counts[i] = strings.Count(c.Content, q.Keyword)
+			assert.Equal(t, float64(counts[i]), c.Relevance)
+		}
+		return counts
+	}
+
+	assert.Equal(t, []int{5, 4}, page(1))
+	assert.Equal(t, []int{3, 2}, page(2))
+	assert.Equal(t, []int{1}, page(3))
+}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"fmt"
+	"sort"
+)
+
+type counterRepo struct {
+	data *Data
+}
+
+// NewCounterRepo .
+func NewCounterRepo(data *Data) biz.CounterRepo {
+	return &counterRepo{
+		data: data,
+	}
+}
+
+// counterRow 按评论ID分组的统计结果
+type counterRow struct {
+	ID    int64
+	Count int64
+}
+
+// CheckCounters 重新统计一批评论的点赞数和回复数；按ID顺序从所有评论表和归档表中取一批，
+// 回复与被回复的评论在同一张表中
+func (r *counterRepo) CheckCounters(ctx context.Context, afterID int64, limit int) (*biz.CounterBatch, error) {
+	db := r.data.DB(ctx)
+
+	var comments []*biz.Comment
+	tables := make(map[int64]string)
+	for _, table := range r.data.commentTables() {
+		var batch []*biz.Comment
+		if err := db.Table(table).Select("id", "like_count", "reply_count").Where("id > ?", afterID).
+			Order("id ASC").Limit(limit).Find(&batch).Error; err != nil {
+			return nil, err
+		}
+		for _, c := range batch {
+			tables[c.ID] = table
+		}
+		comments = append(comments, batch...)
+	}
+	if len(comments) == 0 {
+		return &biz.CounterBatch{}, nil
+	}
+	sort.Slice(comments, func(i, j int) bool { return comments[i].ID < comments[j].ID })
+	if len(comments) > limit {
+		comments = comments[:limit]
+	}
+
+	ids := make([]int64, len(comments))
+	byTable := make(map[string][]int64)
+	for i, c := range comments {
+		ids[i] = c.ID
+		byTable[tables[c.ID]] = append(byTable[tables[c.ID]], c.ID)
+	}
+
+	// 点赞数：comment_like 中的点赞记录数
+	var likeRows []counterRow
+	if err := db.Model(&CommentLike{}).Select("comment_id AS id, COUNT(*) AS count").
+		Where("comment_id IN ?", ids).Group("comment_id").Scan(&likeRows).Error; err != nil {
+		return nil, err
+	}
+	likes := make(map[int64]int64, len(likeRows))
+	for _, row := range likeRows {
+		likes[row.ID] = row.Count
+	}
+
+	// 回复数：未软删除的直接回复数，与删除评论后重新计算回复数的口径一致
+	replies := make(map[int64]int64)
+	for table, tableIDs := range byTable {
+		var replyRows []counterRow
+		if err := db.Table(table).Select("parent_id AS id, COUNT(*) AS count").
+			Where("parent_id IN ? AND deleted = ?", tableIDs, false).Group("parent_id").Scan(&replyRows).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range replyRows {
+			replies[row.ID] = row.Count
+		}
+	}
+
+	batch := &biz.CounterBatch{LastID: comments[len(comments)-1].ID, Scanned: len(comments)}
+	for _, c := range comments {
+		if actual := likes[c.ID]; actual != c.LikeCount {
+			batch.Mismatches = append(batch.Mismatches, &biz.CounterMismatch{CommentID: c.ID, Counter: biz.CounterLikeCount, Stored: c.LikeCount, Actual: actual})
+		}
+		if actual := replies[c.ID]; actual != c.ReplyCount {
+			batch.Mismatches = append(batch.Mismatches, &biz.CounterMismatch{CommentID: c.ID, Counter: biz.CounterReplyCount, Stored: c.ReplyCount, Actual: actual})
+		}
+	}
+	return batch, nil
+}
+
+// RepairCounter 以存储的值为条件更新计数，统计之后点赞、回复等并发更新过计数时不覆盖
+func (r *counterRepo) RepairCounter(ctx context.Context, m *biz.CounterMismatch) (bool, error) {
+	if m.Counter != biz.CounterLikeCount && m.Counter != biz.CounterReplyCount {
+		return false, fmt.Errorf("unknown counter %q", m.Counter)
+	}
+	db := r.data.DB(ctx)
+	table, err := r.data.locateComment(db, m.CommentID)
+	if err != nil {
+		return false, ignoreNotFound(err)
+	}
+	result := db.Table(table).Where("id = ? AND "+m.Counter+" = ?", m.CommentID, m.Stored).UpdateColumn(m.Counter, m.Actual)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterRepo(t *testing.T) {
+	data := newTestData(t)
+	repo, counters := newTestCommentRepo(t, data), NewCounterRepo(data)
+	ctx := context.Background()
+
+	root := saveComment(t, repo, &biz.Comment{Content: "root"})
+	saveComment(t, repo, &biz.Comment{Content: "reply", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1})
+	other := saveComment(t, repo, &biz.Comment{Content: "other"})
+	_, err := repo.LikeComment(ctx, root.ID, "u2")
+	assert.NoError(t, err)
+
+	// 制造计数漂移：root 的点赞数和回复数、other 的回复数
+	assert.NoError(t, data.db.Table(commentTable).Where("id = ?", root.ID).Updates(map[string]any{"like_count": 3, "reply_count": 0}).Error)
+	assert.NoError(t, data.db.Table(commentTable).Where("id = ?", other.ID).UpdateColumn("reply_count", 2).Error)
+
+	t.Run("分批统计不一致的计数", func(t *testing.T) {
+		first, err := counters.CheckCounters(ctx, 0, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, first.Scanned)
+		assert.ElementsMatch(t, []*biz.CounterMismatch{
+			{CommentID: root.ID, Counter: biz.CounterLikeCount, Stored: 3, Actual: 1},
+			{CommentID: root.ID, Counter: biz.CounterReplyCount, Stored: 0, Actual: 1},
+		}, first.Mismatches)
+
+		second, err := counters.CheckCounters(ctx, first.LastID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, other.ID, second.LastID)
+		assert.Equal(t, []*biz.CounterMismatch{{CommentID: other.ID, Counter: biz.CounterReplyCount, Stored: 2, Actual: 0}}, second.Mismatches)
+
+		last, err := counters.CheckCounters(ctx, second.LastID, 2)
+		assert.NoError(t, err)
+		assert.Zero(t, last.LastID)
+	})
+
+	t.Run("按存储的值修复计数", func(t *testing.T) {
+		repaired, err := counters.RepairCounter(ctx, &biz.CounterMismatch{CommentID: root.ID, Counter: biz.CounterLikeCount, Stored: 3, Actual: 1})
+		assert.NoError(t, err)
+		assert.True(t, repaired)
+
+		// 存储的值已变化时不覆盖
+		repaired, err = counters.RepairCounter(ctx, &biz.CounterMismatch{CommentID: root.ID, Counter: biz.CounterReplyCount, Stored: 5, Actual: 1})
+		assert.NoError(t, err)
+		assert.False(t, repaired)
+
+		got, err := repo.Get(ctx, root.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), got.LikeCount)
+		assert.Equal(t, int64(0), got.ReplyCount)
+
+		_, err = counters.RepairCounter(ctx, &biz.CounterMismatch{CommentID: root.ID, Counter: "id", Stored: root.ID, Actual: 1})
+		assert.Error(t, err)
+	})
+}
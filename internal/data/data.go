@@ -6,15 +6,22 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewCommentRepo)
+var ProviderSet = wire.NewSet(NewData, NewCommentRepo, NewEventRepo, NewIdempotencyRepo, NewPublisher, NewWebhookRepo, NewWebhookClient, NewWatchBroker, NewFingerprintStore, NewBlockRepo, NewSettingRepo, NewCommentSearcher, NewBulkDeleteRepo, NewCommentPathRepo, NewCounterRepo, NewArchiveRepo, NewExportRepo, NewTxnManager)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
 	db *gorm.DB
+	// replicas 未配置从库时为 nil
+	replicas *replicaPool
+	// shards 评论表分片，nil 表示不分片
+	shards *commentShards
+	// rdb 未配置 redis 地址时为 nil
+	rdb *redis.Client
 }
 
 // NewData .
@@ -27,22 +34,42 @@ func NewData(c *conf.Data) (*Data, func(), error) {
 	}
 	log.Info(nil, "validate conf.Data successful.")
 
+	data := &Data{shards: newCommentShards(c.Sharding)}
 	cleanup := func() {
 		log.Info(nil, "closing the data resources")
+		if data.replicas != nil {
+			data.replicas.close()
+		}
+		if data.rdb != nil {
+			if err := data.rdb.Close(); err != nil {
+				log.Error(nil, "close redis error.", "err", err)
+			}
+		}
 	}
 
-	data := &Data{}
-	if c.Database.Driver == "mysql" || c.Database.Driver == "" {
-		// 使用 mysql
-		db, err := NewDB(c.Database)
+	// 按 driver 使用 mysql、postgres 或 sqlite
+	db, err := NewDB(c.Database)
+	if err != nil {
+		log.Fatal(nil, "new db error.", "driver", c.Database.Driver, "err", err)
+		return nil, nil, err
+	}
+	data.db = db
+	log.Info(nil, "new db successful.")
+
+	// 只读从库
+	if len(c.Database.Replicas) > 0 {
+		replicas, err := newReplicaPool(c.Database)
 		if err != nil {
-			log.Fatal(nil, "new db error.", "err", err)
+			log.Fatal(nil, "new replica db error.", "err", err)
 			return nil, nil, err
 		}
-		data.db = db
-		log.Info(nil, "new db successful.")
-	} else {
-		log.Fatal(nil, "database driver error.", "driver", c.Database.Driver)
+		data.replicas = replicas
+		log.Info(nil, "new replica db successful.", "count", len(c.Database.Replicas))
+	}
+
+	if c.Redis.GetAddr() != "" {
+		data.rdb = NewRedis(c.Redis)
+		log.Info(nil, "new redis successful.")
 	}
 
 	return data, cleanup, nil
@@ -0,0 +1,103 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestData 创建基于临时 SQLite 文件的 Data，表结构由模型迁移生成，归档表以评论表为模板创建，用于无需数据库服务的仓储集成测试
+func newTestData(t *testing.T) *Data {
+	t.Helper()
+	db, err := NewDB(&conf.Data_Database{
+		Driver:       DriverSQLite,
+		Source:       filepath.Join(t.TempDir(), "comment.db") + "?_pragma=busy_timeout(5000)",
+		MaxOpenConns: 1,
+	})
+	if err != nil {
+		t.Fatalf("new sqlite db: %v", err)
+	}
+	if err := db.AutoMigrate(&biz.Comment{}, &biz.Mention{}, &biz.Attachment{}, &CommentLike{}, &CommentEvent{}, &biz.Report{}, &biz.ResourceSetting{}, &ArchivedResource{}); err != nil {
+		t.Fatalf("migrate sqlite db: %v", err)
+	}
+	if _, err := (&Migrator{db: db}).CreateCommentTables(context.Background()); err != nil {
+		t.Fatalf("create comment tables: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return &Data{db: db}
+}
+
+// testCommentRepo 像 CommentUsecase 一样在 Save 前分配评论ID
+type testCommentRepo struct {
+	biz.CommentRepo
+	ids biz.IDGenerator
+}
+
+func (r *testCommentRepo) Save(ctx context.Context, c *biz.Comment) (*biz.Comment, error) {
+	if c.ID == 0 {
+		id, err := r.ids.NextID(biz.ResourceBucket(c.Module, c.ResourceID))
+		if err != nil {
+			return nil, err
+		}
+		c.ID = id
+	}
+	return r.CommentRepo.Save(ctx, c)
+}
+
+// newTestCommentRepo 创建测试用的评论仓储，Save 时使用 Snowflake 分配评论ID
+func newTestCommentRepo(t *testing.T, data *Data) biz.CommentRepo {
+	t.Helper()
+	ids, err := biz.NewSnowflake(1, 0)
+	if err != nil {
+		t.Fatalf("new snowflake: %v", err)
+	}
+	return &testCommentRepo{CommentRepo: NewCommentRepo(data), ids: ids}
+}
+
+func TestNewDB_UnsupportedDriver(t *testing.T) {
+	_, err := NewDB(&conf.Data_Database{Driver: "oracle"})
+	assert.Error(t, err)
+}
+
+func TestTxnManager_Txn(t *testing.T) {
+	data := newTestData(t)
+	repo, txn := newTestCommentRepo(t, data), NewTxnManager(data)
+	ctx := context.Background()
+
+	t.Run("返回错误时回滚事务中的所有写入", func(t *testing.T) {
+		err := txn.Txn(ctx, func(ctx context.Context) error {
+			if _, err := repo.Save(ctx, &biz.Comment{Module: 1, ResourceID: "r1", UserID: "u1", Content: "rollback"}); err != nil {
+				return err
+			}
+			return errors.New("abort")
+		})
+		assert.EqualError(t, err, "abort")
+
+		var count int64
+		data.db.Model(&biz.Comment{}).Where("content = ?", "rollback").Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("成功时提交事务", func(t *testing.T) {
+		var saved *biz.Comment
+		err := txn.Txn(ctx, func(ctx context.Context) error {
+			var err error
+			saved, err = repo.Save(ctx, &biz.Comment{Module: 1, ResourceID: "r1", UserID: "u1", Content: "commit"})
+			return err
+		})
+		assert.NoError(t, err)
+
+		got, err := repo.Get(ctx, saved.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "commit", got.Content)
+	})
+}
@@ -0,0 +1,146 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// outbox 事件状态
+const (
+	eventStatusPending   int32 = 0 // 待投递
+	eventStatusDelivered int32 = 1 // 已投递
+	eventStatusDead      int32 = 2 // 超过最大投递次数，不再重试
+)
+
+// CommentEvent 领域事件 outbox 记录模型，与业务数据在同一事务中写入
+type CommentEvent struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	EventType    string    `gorm:"column:event_type;type:varchar(32);not null"`
+	CommentID    int64     `gorm:"column:comment_id;type:bigint;not null"`
+	Payload      string    `gorm:"column:payload;type:text;not null"`
+	Status       int32     `gorm:"column:status;type:tinyint;not null;default:0;index:idx_status_retry,priority:1"`
+	Attempts     int32     `gorm:"column:attempts;type:int;not null;default:0"`
+	LastError    string    `gorm:"column:last_error;type:varchar(255);not null;default:''"`
+	DeliveredTo  string    `gorm:"column:delivered_to;type:varchar(255);not null;default:''"` // 已投递成功的发布者，逗号分隔
+	NextRetryGmt time.Time `gorm:"column:next_retry_gmt;type:datetime;not null;index:idx_status_retry,priority:2"`
+	CreateGmt    time.Time `gorm:"column:create_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	UpdateGmt    time.Time `gorm:"column:update_gmt;type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (e *CommentEvent) TableName() string {
+	return "comment_event_outbox"
+}
+
+// writeEvents 在给定事务中写入领域事件
+func writeEvents(tx *gorm.DB, events ...*biz.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	records := make([]*CommentEvent, len(events))
+	for i, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		records[i] = &CommentEvent{
+			EventType:    string(e.Type),
+			CommentID:    e.CommentID,
+			Payload:      string(payload),
+			Status:       eventStatusPending,
+			NextRetryGmt: now,
+			CreateGmt:    now,
+			UpdateGmt:    now,
+		}
+	}
+	return tx.Create(&records).Error
+}
+
+type eventRepo struct {
+	data *Data
+}
+
+// NewEventRepo .
+func NewEventRepo(data *Data) biz.EventRepo {
+	return &eventRepo{
+		data: data,
+	}
+}
+
+// ListPendingEvents 获取到期待投递的事件
+func (r *eventRepo) ListPendingEvents(ctx context.Context, limit int) ([]*biz.Event, error) {
+	var records []*CommentEvent
+	err := r.data.DB(ctx).
+		Where("status = ? AND next_retry_gmt <= ?", eventStatusPending, time.Now()).
+		Order("id ASC").Limit(limit).Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*biz.Event, 0, len(records))
+	for _, record := range records {
+		var e biz.Event
+		if err := json.Unmarshal([]byte(record.Payload), &e); err != nil {
+			return nil, err
+		}
+		// 写入时尚未生成记录ID，以 outbox 记录ID作为事件ID
+		e.ID = record.ID
+		e.Attempts = record.Attempts
+		if record.DeliveredTo != "" {
+			e.DeliveredTo = strings.Split(record.DeliveredTo, ",")
+		}
+		events = append(events, &e)
+	}
+	return events, nil
+}
+
+// MarkEventDelivered 标记事件投递成功
+func (r *eventRepo) MarkEventDelivered(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Model(&CommentEvent{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     eventStatusDelivered,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"update_gmt": time.Now(),
+		}).Error
+}
+
+// MarkEventFailed 记录一次投递失败及已投递成功的发布者
+func (r *eventRepo) MarkEventFailed(ctx context.Context, id int64, nextRetry time.Time, reason string, dead bool, deliveredTo []string) error {
+	status := eventStatusPending
+	if dead {
+		status = eventStatusDead
+	}
+	if runes := []rune(reason); len(runes) > 255 {
+		reason = string(runes[:255])
+	}
+	return r.data.DB(ctx).Model(&CommentEvent{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":         status,
+			"attempts":       gorm.Expr("attempts + 1"),
+			"last_error":     reason,
+			"delivered_to":   strings.Join(deliveredTo, ","),
+			"next_retry_gmt": nextRetry,
+			"update_gmt":     time.Now(),
+		}).Error
+}
+
+// DeleteDeliveredEvents 先按ID取一批再删除，MySQL 不支持 IN 子查询中的 LIMIT，PostgreSQL 不支持 DELETE ... LIMIT
+func (r *eventRepo) DeleteDeliveredEvents(ctx context.Context, before time.Time, limit int) (int64, error) {
+	db := r.data.DB(ctx)
+	var ids []int64
+	if err := db.Model(&CommentEvent{}).Where("status = ? AND update_gmt < ?", eventStatusDelivered, before).
+		Order("id ASC").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := db.Where("id IN ?", ids).Delete(&CommentEvent{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,42 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventRepo_DeleteDeliveredEvents(t *testing.T) {
+	data := newTestData(t)
+	repo := NewEventRepo(data)
+	ctx := context.Background()
+
+	old, recent := time.Now().Add(-2*time.Hour), time.Now()
+	events := []*CommentEvent{
+		{EventType: "CommentCreated", Status: eventStatusDelivered, UpdateGmt: old},
+		{EventType: "CommentCreated", Status: eventStatusDelivered, UpdateGmt: old},
+		{EventType: "CommentCreated", Status: eventStatusDelivered, UpdateGmt: recent},
+		{EventType: "CommentCreated", Status: eventStatusPending, UpdateGmt: old},
+		{EventType: "CommentCreated", Status: eventStatusDead, UpdateGmt: old},
+	}
+	for _, e := range events {
+		e.NextRetryGmt, e.CreateGmt = e.UpdateGmt, e.UpdateGmt
+		if err := data.db.Create(e).Error; err != nil {
+			t.Fatalf("create event: %v", err)
+		}
+	}
+
+	// 只删除超过保留时间的已投递事件，按批次大小分批
+	deleted, err := repo.DeleteDeliveredEvents(ctx, time.Now().Add(-time.Hour), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	deleted, err = repo.DeleteDeliveredEvents(ctx, time.Now().Add(-time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	var left []int64
+	data.db.Model(&CommentEvent{}).Order("id").Pluck("id", &left)
+	assert.Equal(t, []int64{events[2].ID, events[3].ID, events[4].ID}, left)
+}
@@ -0,0 +1,40 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type exportRepo struct {
+	data *Data
+}
+
+// NewExportRepo .
+func NewExportRepo(data *Data) biz.ExportRepo {
+	return &exportRepo{
+		data: data,
+	}
+}
+
+// ListExportComments 按ID游标分批读取，导出资源时查询资源所在的表和归档表，导出用户时查询所有评论表和归档表；
+// 导出是大量读取，走从库
+func (r *exportRepo) ListExportComments(ctx context.Context, q *biz.ExportQuery, afterID int64, limit int) ([]*biz.Comment, error) {
+	db := r.data.ReadDB(ctx)
+	tables := r.data.commentTables()
+	if q.UserID == "" {
+		var err error
+		if tables, err = r.data.resourceTables(db, q.Module, q.ResourceID); err != nil {
+			return nil, err
+		}
+	}
+	return r.data.findComments(db, tables, func(db *gorm.DB) *gorm.DB {
+		if q.UserID != "" {
+			db = db.Where("user_id = ?", q.UserID)
+		} else {
+			db = db.Where("module = ? AND resource_id = ?", q.Module, q.ResourceID)
+		}
+		return db.Where("id > ?", afterID)
+	}, idAsc, 0, limit)
+}
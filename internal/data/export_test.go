@@ -0,0 +1,60 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportRepo_ListExportComments(t *testing.T) {
+	data := newTestShardedData(t, 4)
+	repo, exports := newTestCommentRepo(t, data), NewExportRepo(data)
+	ctx := context.Background()
+	r1, r2 := resourcesOnDistinctShards(data.shards)
+
+	save := func(resourceID, userID string, c *biz.Comment) *biz.Comment {
+		c.Module, c.ResourceID, c.UserID = 1, resourceID, userID
+		saved, err := repo.Save(ctx, c)
+		if err != nil {
+			t.Fatalf("save comment: %v", err)
+		}
+		return saved
+	}
+	old := time.Now().Add(-48 * time.Hour)
+	root := save(r1, "u1", &biz.Comment{Content: "root", CreateGmt: old})
+	reply := save(r1, "u2", &biz.Comment{Content: "reply", ParentCommentID: root.ID, RootCommentID: root.ID, Level: 1, CreateGmt: old})
+	hidden := save(r1, "u1", &biz.Comment{Content: "hidden", Hidden: true, CreateGmt: old})
+	other := save(r2, "u1", &biz.Comment{Content: "other"})
+
+	// r1 的评论已归档
+	moved, err := NewArchiveRepo(data).ArchiveResource(ctx, &biz.InactiveResource{Module: 1, ResourceID: r1}, time.Now().Add(-24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), moved)
+
+	exportAll := func(q *biz.ExportQuery) []int64 {
+		var ids []int64
+		var afterID int64
+		for {
+			batch, err := exports.ListExportComments(ctx, q, afterID, 2)
+			assert.NoError(t, err)
+			for _, c := range batch {
+				ids = append(ids, c.ID)
+			}
+			if len(batch) < 2 {
+				return ids
+			}
+			afterID = batch[len(batch)-1].ID
+		}
+	}
+
+	t.Run("按资源导出包括归档和隐藏的评论", func(t *testing.T) {
+		assert.Equal(t, []int64{root.ID, reply.ID, hidden.ID}, exportAll(&biz.ExportQuery{Module: 1, ResourceID: r1}))
+	})
+
+	t.Run("按用户跨分片导出", func(t *testing.T) {
+		assert.Equal(t, []int64{root.ID, hidden.ID, other.ID}, exportAll(&biz.ExportQuery{UserID: "u1"}))
+	})
+}
@@ -0,0 +1,133 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewFingerprintStore 根据配置创建近期评论指纹存储，默认存储在进程内存
+func NewFingerprintStore(c *conf.Data, data *Data) biz.FingerprintStore {
+	dc := c.GetDuplicate()
+	switch dc.GetStore() {
+	case "redis":
+		if data.rdb == nil {
+			log.Fatal(nil, "duplicate store redis requires redis config.")
+			return nil
+		}
+		log.Info(nil, "use redis fingerprint store.")
+		return &redisFingerprintStore{data: data}
+	default:
+		log.Info(nil, "use memory fingerprint store.")
+		return newMemoryFingerprintStore()
+	}
+}
+
+// memoryFingerprintStore 进程内指纹存储，适用于单实例部署
+type memoryFingerprintStore struct {
+	mu           sync.Mutex
+	fingerprints map[string][]*biz.ContentFingerprint
+	lastSweep    time.Time
+}
+
+func newMemoryFingerprintStore() *memoryFingerprintStore {
+	return &memoryFingerprintStore{fingerprints: make(map[string][]*biz.ContentFingerprint)}
+}
+
+func (s *memoryFingerprintStore) RecentFingerprints(ctx context.Context, userID string, since time.Time) ([]*biz.ContentFingerprint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var recent []*biz.ContentFingerprint
+	for _, fp := range s.fingerprints[userID] {
+		if fp.CreatedAt.After(since) {
+			recent = append(recent, fp)
+		}
+	}
+	return recent, nil
+}
+
+func (s *memoryFingerprintStore) AddFingerprint(ctx context.Context, userID string, fp *biz.ContentFingerprint, window time.Duration, maxRecent int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 清理窗口之外的指纹，并只保留最近 maxRecent 条
+	since := time.Now().Add(-window)
+	kept := make([]*biz.ContentFingerprint, 0, len(s.fingerprints[userID])+1)
+	for _, old := range s.fingerprints[userID] {
+		if old.CreatedAt.After(since) {
+			kept = append(kept, old)
+		}
+	}
+	kept = append(kept, fp)
+	if len(kept) > maxRecent {
+		kept = kept[len(kept)-maxRecent:]
+	}
+	s.fingerprints[userID] = kept
+
+	// 每个窗口清理一次其他用户已过期的指纹，避免长期不发言的用户占用内存
+	if time.Since(s.lastSweep) > window {
+		for id, fps := range s.fingerprints {
+			if len(fps) == 0 || !fps[len(fps)-1].CreatedAt.After(since) {
+				delete(s.fingerprints, id)
+			}
+		}
+		s.lastSweep = time.Now()
+	}
+	return nil
+}
+
+// redisFingerprintStore 基于 Redis 有序集合的指纹存储，分值为发布时间（毫秒）
+type redisFingerprintStore struct {
+	data *Data
+}
+
+func fingerprintRedisKey(userID string) string {
+	return fmt.Sprintf("comment:fingerprint:%s", userID)
+}
+
+func (s *redisFingerprintStore) RecentFingerprints(ctx context.Context, userID string, since time.Time) ([]*biz.ContentFingerprint, error) {
+	members, err := s.data.rdb.ZRangeByScore(ctx, fingerprintRedisKey(userID), &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(since.UnixMilli(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]*biz.ContentFingerprint, 0, len(members))
+	for _, member := range members {
+		var fp biz.ContentFingerprint
+		if err := json.Unmarshal([]byte(member), &fp); err != nil {
+			log.Error(ctx, "unmarshal fingerprint error.", "user_id", userID, "err", err)
+			continue
+		}
+		recent = append(recent, &fp)
+	}
+	return recent, nil
+}
+
+func (s *redisFingerprintStore) AddFingerprint(ctx context.Context, userID string, fp *biz.ContentFingerprint, window time.Duration, maxRecent int) error {
+	member, err := json.Marshal(fp)
+	if err != nil {
+		return err
+	}
+
+	key := fingerprintRedisKey(userID)
+	pipe := s.data.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(fp.CreatedAt.UnixMilli()), Member: member})
+	// 清理窗口之外的指纹，并只保留最近 maxRecent 条
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(time.Now().Add(-window).UnixMilli(), 10))
+	pipe.ZRemRangeByRank(ctx, key, 0, int64(-maxRecent-1))
+	pipe.Expire(ctx, key, window)
+	_, err = pipe.Exec(ctx)
+	return err
+}
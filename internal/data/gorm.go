@@ -3,17 +3,48 @@ package data
 import (
 	"comment/internal/conf"
 	"comment/pkg/log"
+	"fmt"
+
+	"github.com/glebarez/sqlite"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+// 支持的数据库驱动
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// dialector 按配置的驱动创建 gorm 方言，driver 为空时使用 mysql
+func dialector(c *conf.Data_Database) (gorm.Dialector, error) {
+	switch c.Driver {
+	case DriverMySQL, "":
+		return mysql.New(mysql.Config{
+			DSN: c.Source,
+		}), nil
+	case DriverPostgres:
+		return postgres.Open(c.Source), nil
+	case DriverSQLite:
+		// 纯 Go 实现，不依赖 cgo
+		return sqlite.Open(c.Source), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", c.Driver)
+	}
+}
+
 func NewDB(c *conf.Data_Database) (*gorm.DB, error) {
-	log.Info(nil, "init gorm db")
+	log.Info(nil, "init gorm db", "driver", c.Driver)
 	log.Debug(nil, "db config", "db", c)
 
-	db, err := gorm.Open(mysql.New(mysql.Config{
-		DSN: c.Source,
-	}))
+	d, err := dialector(c)
+	if err != nil {
+		log.Error(nil, "failed to create dialector", "error", err)
+		return nil, err
+	}
+	db, err := gorm.Open(d)
 	if err != nil {
 		log.Error(nil, "failed to connect database", "error", err)
 		return nil, err
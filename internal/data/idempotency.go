@@ -0,0 +1,248 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/pkg/log"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewIdempotencyRepo 创建幂等键仓储，配置了 Redis 时存储在 Redis，Redis 调用失败时回退到数据库；未配置 Redis 时存储在数据库
+func NewIdempotencyRepo(data *Data) biz.IdempotencyRepo {
+	if data.rdb != nil {
+		log.Info(nil, "use redis idempotency repo with database fallback.")
+		return &fallbackIdempotencyRepo{redis: &redisIdempotencyRepo{data: data}, db: &idempotencyRepo{data: data}}
+	}
+	log.Info(nil, "use database idempotency repo.")
+	return &idempotencyRepo{data: data}
+}
+
+// fallbackIdempotencyRepo 优先使用 Redis，Redis 调用失败时改用数据库。
+// 回退期间写入数据库的幂等键在过期前仍会被查询，Redis 恢复后同一幂等键的重试不会重复创建评论；
+// 回退状态只在本实例内记录，Redis 故障前写入 Redis 的幂等键在故障期间不可见
+type fallbackIdempotencyRepo struct {
+	redis biz.IdempotencyRepo
+	db    biz.IdempotencyRepo
+
+	mu sync.Mutex
+	// fallbackUntil 回退期间写入数据库的幂等键最晚的过期时间
+	fallbackUntil time.Time
+}
+
+// fallingBack 是否还有回退期间写入数据库且未过期的幂等键
+func (r *fallbackIdempotencyRepo) fallingBack() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return time.Now().Before(r.fallbackUntil)
+}
+
+func (r *fallbackIdempotencyRepo) fallBackUntil(expire time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if expire.After(r.fallbackUntil) {
+		r.fallbackUntil = expire
+	}
+}
+
+func (r *fallbackIdempotencyRepo) GetIdempotency(ctx context.Context, userID, key string) (*biz.Idempotency, error) {
+	record, err := r.redis.GetIdempotency(ctx, userID, key)
+	if err != nil {
+		log.Warn(ctx, "get idempotency key from redis error, fall back to database.", "err", err)
+		return r.db.GetIdempotency(ctx, userID, key)
+	}
+	if record == nil && r.fallingBack() {
+		return r.db.GetIdempotency(ctx, userID, key)
+	}
+	return record, nil
+}
+
+func (r *fallbackIdempotencyRepo) ReserveIdempotency(ctx context.Context, record *biz.Idempotency) (bool, error) {
+	if r.fallingBack() {
+		existing, err := r.db.GetIdempotency(ctx, record.UserID, record.Key)
+		if err != nil {
+			return false, err
+		}
+		if existing != nil {
+			return false, nil
+		}
+	}
+
+	reserved, err := r.redis.ReserveIdempotency(ctx, record)
+	if err != nil {
+		log.Warn(ctx, "reserve idempotency key in redis error, fall back to database.", "err", err)
+		r.fallBackUntil(record.ExpireGmt)
+		return r.db.ReserveIdempotency(ctx, record)
+	}
+	return reserved, nil
+}
+
+func (r *fallbackIdempotencyRepo) ReleaseIdempotency(ctx context.Context, userID, key string, commentID int64) error {
+	err := r.redis.ReleaseIdempotency(ctx, userID, key, commentID)
+	if err != nil {
+		log.Warn(ctx, "release idempotency key in redis error, fall back to database.", "err", err)
+	}
+	if err != nil || r.fallingBack() {
+		return r.db.ReleaseIdempotency(ctx, userID, key, commentID)
+	}
+	return nil
+}
+
+func (r *fallbackIdempotencyRepo) TakeOverIdempotency(ctx context.Context, record *biz.Idempotency, staleCommentID int64, reservedBefore time.Time) (bool, error) {
+	if r.fallingBack() {
+		taken, err := r.db.TakeOverIdempotency(ctx, record, staleCommentID, reservedBefore)
+		if err != nil || taken {
+			return taken, err
+		}
+	}
+
+	taken, err := r.redis.TakeOverIdempotency(ctx, record, staleCommentID, reservedBefore)
+	if err != nil {
+		log.Warn(ctx, "take over idempotency key in redis error, fall back to database.", "err", err)
+		return r.db.TakeOverIdempotency(ctx, record, staleCommentID, reservedBefore)
+	}
+	return taken, nil
+}
+
+// idempotencyRepo 基于 comment_idempotency 表的幂等键仓储
+type idempotencyRepo struct {
+	data *Data
+}
+
+func (r *idempotencyRepo) GetIdempotency(ctx context.Context, userID, key string) (*biz.Idempotency, error) {
+	var record biz.Idempotency
+	err := r.data.DB(ctx).
+		Where("user_id = ? AND idem_key = ? AND expire_gmt > ?", userID, key, time.Now().UTC()).
+		First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// ReserveIdempotency 先清理已过期的同名幂等键，再依赖唯一索引占用
+func (r *idempotencyRepo) ReserveIdempotency(ctx context.Context, record *biz.Idempotency) (bool, error) {
+	db := r.data.DB(ctx)
+	if err := db.Where("user_id = ? AND idem_key = ? AND expire_gmt <= ?", record.UserID, record.Key, time.Now().UTC()).
+		Delete(&biz.Idempotency{}).Error; err != nil {
+		return false, err
+	}
+
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseIdempotency 仅删除为 commentID 占用的记录，避免误删过期后被其他请求重新占用的幂等键
+func (r *idempotencyRepo) ReleaseIdempotency(ctx context.Context, userID, key string, commentID int64) error {
+	return r.data.DB(ctx).
+		Where("user_id = ? AND idem_key = ? AND comment_id = ?", userID, key, commentID).
+		Delete(&biz.Idempotency{}).Error
+}
+
+// TakeOverIdempotency 以 comment_id 和 create_gmt 为条件更新记录，并发接管时只有一个请求能更新成功
+func (r *idempotencyRepo) TakeOverIdempotency(ctx context.Context, record *biz.Idempotency, staleCommentID int64, reservedBefore time.Time) (bool, error) {
+	result := r.data.DB(ctx).Model(&biz.Idempotency{}).
+		Where("user_id = ? AND idem_key = ? AND comment_id = ? AND create_gmt < ? AND expire_gmt > ?",
+			record.UserID, record.Key, staleCommentID, reservedBefore, time.Now().UTC()).
+		Updates(map[string]interface{}{
+			"fingerprint": record.Fingerprint,
+			"comment_id":  record.CommentID,
+			"expire_gmt":  record.ExpireGmt,
+			"create_gmt":  record.CreateGmt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// redisIdempotencyRepo 基于 Redis 的幂等键仓储，记录以 JSON 存储并由 Redis 负责过期
+type redisIdempotencyRepo struct {
+	data *Data
+}
+
+func idempotencyRedisKey(userID, key string) string {
+	return fmt.Sprintf("comment:idempotency:%s:%s", userID, key)
+}
+
+func (r *redisIdempotencyRepo) GetIdempotency(ctx context.Context, userID, key string) (*biz.Idempotency, error) {
+	value, err := r.data.rdb.Get(ctx, idempotencyRedisKey(userID, key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var record biz.Idempotency
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *redisIdempotencyRepo) ReserveIdempotency(ctx context.Context, record *biz.Idempotency) (bool, error) {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return false, err
+	}
+	return r.data.rdb.SetNX(ctx, idempotencyRedisKey(record.UserID, record.Key), value, time.Until(record.ExpireGmt)).Result()
+}
+
+// ReleaseIdempotency 仅删除为 commentID 占用的记录，避免误删过期后被其他请求重新占用的幂等键
+func (r *redisIdempotencyRepo) ReleaseIdempotency(ctx context.Context, userID, key string, commentID int64) error {
+	record, err := r.GetIdempotency(ctx, userID, key)
+	if err != nil || record == nil || record.CommentID != commentID {
+		return err
+	}
+	return r.data.rdb.Del(ctx, idempotencyRedisKey(userID, key)).Err()
+}
+
+// takeOverIdempotencyScript 记录仍为读取时的值才替换，避免覆盖其他请求接管后的记录
+var takeOverIdempotencyScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+	return 1
+end
+return 0
+`)
+
+func (r *redisIdempotencyRepo) TakeOverIdempotency(ctx context.Context, record *biz.Idempotency, staleCommentID int64, reservedBefore time.Time) (bool, error) {
+	redisKey := idempotencyRedisKey(record.UserID, record.Key)
+	current, err := r.data.rdb.Get(ctx, redisKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var existing biz.Idempotency
+	if err := json.Unmarshal([]byte(current), &existing); err != nil {
+		return false, err
+	}
+	if existing.CommentID != staleCommentID || !existing.CreateGmt.Before(reservedBefore) {
+		return false, nil
+	}
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return false, err
+	}
+	taken, err := takeOverIdempotencyScript.Run(ctx, r.data.rdb, []string{redisKey}, current, value, time.Until(record.ExpireGmt).Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return taken == 1, nil
+}
@@ -0,0 +1,80 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyRepo_RedisFallback(t *testing.T) {
+	data := newTestData(t)
+	if err := data.db.AutoMigrate(&biz.Idempotency{}); err != nil {
+		t.Fatalf("migrate idempotency: %v", err)
+	}
+	// 不可用的 Redis，每次调用都返回连接错误
+	data.rdb = redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	t.Cleanup(func() { data.rdb.Close() })
+	repo := NewIdempotencyRepo(data)
+	ctx := context.Background()
+
+	record := &biz.Idempotency{UserID: "u1", Key: "k1", Fingerprint: "f1", CommentID: 1, ExpireGmt: time.Now().Add(time.Hour).UTC()}
+	reserved, err := repo.ReserveIdempotency(ctx, record)
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+
+	// 回退期间重复占用同一幂等键失败，并能查到首次的记录
+	reserved, err = repo.ReserveIdempotency(ctx, &biz.Idempotency{UserID: "u1", Key: "k1", Fingerprint: "f1", CommentID: 2, ExpireGmt: time.Now().Add(time.Hour).UTC()})
+	assert.NoError(t, err)
+	assert.False(t, reserved)
+	got, err := repo.GetIdempotency(ctx, "u1", "k1")
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, int64(1), got.CommentID)
+	}
+
+	assert.NoError(t, repo.ReleaseIdempotency(ctx, "u1", "k1", 1))
+	got, err = repo.GetIdempotency(ctx, "u1", "k1")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestIdempotencyRepo_TakeOver(t *testing.T) {
+	data := newTestData(t)
+	if err := data.db.AutoMigrate(&biz.Idempotency{}); err != nil {
+		t.Fatalf("migrate idempotency: %v", err)
+	}
+	repo := NewIdempotencyRepo(data)
+	ctx := context.Background()
+
+	reservedAt := time.Now().Add(-time.Hour).UTC()
+	reserved, err := repo.ReserveIdempotency(ctx, &biz.Idempotency{UserID: "u1", Key: "k1", Fingerprint: "f1", CommentID: 1,
+		ExpireGmt: time.Now().Add(time.Hour).UTC(), CreateGmt: reservedAt})
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+
+	next := &biz.Idempotency{UserID: "u1", Key: "k1", Fingerprint: "f1", CommentID: 2, ExpireGmt: time.Now().Add(time.Hour).UTC(), CreateGmt: time.Now().UTC()}
+
+	// 占用未超时时不能接管
+	taken, err := repo.TakeOverIdempotency(ctx, next, 1, reservedAt.Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.False(t, taken)
+
+	taken, err = repo.TakeOverIdempotency(ctx, next, 1, time.Now().Add(-time.Minute).UTC())
+	assert.NoError(t, err)
+	assert.True(t, taken)
+	got, err := repo.GetIdempotency(ctx, "u1", "k1")
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, int64(2), got.CommentID)
+	}
+
+	// 已被接管的记录不能再按旧的评论ID接管
+	taken, err = repo.TakeOverIdempotency(ctx, &biz.Idempotency{UserID: "u1", Key: "k1", Fingerprint: "f1", CommentID: 3,
+		ExpireGmt: time.Now().Add(time.Hour).UTC(), CreateGmt: time.Now().UTC()}, 1, time.Now().UTC())
+	assert.NoError(t, err)
+	assert.False(t, taken)
+}
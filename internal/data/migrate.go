@@ -0,0 +1,319 @@
+package data
+
+import (
+	"comment/pkg/log"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrationFS 按数据库方言分目录存放的迁移脚本，文件名格式为 <版本号>_<名称>.up.sql / .down.sql
+//
+//go:embed migrations
+var migrationFS embed.FS
+
+// SchemaMigration 已执行的迁移记录
+type SchemaMigration struct {
+	Version    int64     `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name       string    `gorm:"column:name;type:varchar(255);not null"`
+	AppliedGmt time.Time `gorm:"column:applied_gmt;not null"`
+}
+
+func (m *SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migration 一个版本的迁移脚本
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus 迁移的执行状态，AppliedGmt 为空表示尚未执行
+type MigrationStatus struct {
+	*Migration
+	AppliedGmt *time.Time
+}
+
+// Migrator 按版本号顺序执行迁移脚本，并在 schema_migrations 表中记录已执行的版本
+type Migrator struct {
+	db         *gorm.DB
+	shards     *commentShards
+	migrations []*Migration
+}
+
+// NewMigrator 加载当前数据库方言的迁移脚本
+func NewMigrator(data *Data) (*Migrator, error) {
+	dialect := data.db.Dialector.Name()
+	migrations, err := loadMigrations(migrationFS, path.Join("migrations", dialect))
+	if err != nil {
+		return nil, fmt.Errorf("load %s migrations: %w", dialect, err)
+	}
+	log.Info(nil, "load migrations successful.", "dialect", dialect, "count", len(migrations))
+	return &Migrator{db: data.db, shards: data.shards, migrations: migrations}, nil
+}
+
+// loadMigrations 读取目录下的迁移脚本，每个版本必须同时有 up 和 down 脚本
+func loadMigrations(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		file := entry.Name()
+		base, direction, ok := strings.Cut(strings.TrimSuffix(file, ".sql"), ".")
+		if entry.IsDir() || !strings.HasSuffix(file, ".sql") || !ok {
+			return nil, fmt.Errorf("invalid migration file name %q", file)
+		}
+		versionText, name, _ := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(versionText, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", file)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, file))
+		if err != nil {
+			return nil, err
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if m.Name != name {
+			return nil, fmt.Errorf("migration %d has different names %q and %q", version, m.Name, name)
+		}
+		switch direction {
+		case "up":
+			m.Up = string(content)
+		case "down":
+			m.Down = string(content)
+		default:
+			return nil, fmt.Errorf("invalid migration direction in %q", file)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down scripts", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up 按版本号升序执行所有未执行的迁移，返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		log.Info(ctx, "apply migration.", "version", migration.Version, "name", migration.Name)
+		err := m.run(ctx, migration.Up, func(tx *gorm.DB) error {
+			return tx.Create(&SchemaMigration{Version: migration.Version, Name: migration.Name, AppliedGmt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Down 回滚最近执行的一个迁移，没有已执行的迁移时返回 nil
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		log.Info(ctx, "revert migration.", "version", migration.Version, "name", migration.Name)
+		err := m.run(ctx, migration.Down, func(tx *gorm.DB) error {
+			return tx.Where("version = ?", migration.Version).Delete(&SchemaMigration{}).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("revert migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		return migration, nil
+	}
+	return nil, nil
+}
+
+// Status 返回所有迁移的执行状态，按版本号升序
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*MigrationStatus, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = &MigrationStatus{Migration: migration}
+		if record, ok := applied[migration.Version]; ok {
+			statuses[i].AppliedGmt = &record.AppliedGmt
+		}
+	}
+	return statuses, nil
+}
+
+// CreateCommentTables 以 comment 表为模板创建缺少的分片表和归档表，返回本次创建的表；未分片时只创建归档表。
+// 已创建的表由使用 {comment_table} 占位符的迁移同步结构变更
+func (m *Migrator) CreateCommentTables(ctx context.Context) ([]string, error) {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(commentTable) {
+		return nil, fmt.Errorf("template table %s does not exist, run migrations first", commentTable)
+	}
+
+	var created []string
+	for _, table := range append(m.shards.tables(), archiveTable) {
+		if table == commentTable || db.Migrator().HasTable(table) {
+			continue
+		}
+		stmts, err := cloneTableStatements(db, commentTable, table)
+		if err != nil {
+			return created, err
+		}
+		for _, stmt := range stmts {
+			if err := db.Exec(stmt).Error; err != nil {
+				return created, fmt.Errorf("create table %s: %w", table, err)
+			}
+		}
+		log.Info(ctx, "create comment table.", "table", table)
+		created = append(created, table)
+	}
+	return created, nil
+}
+
+var (
+	sqliteCreateTable = regexp.MustCompile("(?is)^\\s*create\\s+table\\s+(?:if\\s+not\\s+exists\\s+)?[\"`]?\\w+[\"`]?")
+	sqliteCreateIndex = regexp.MustCompile("(?is)^\\s*create\\s+(unique\\s+)?index\\s+(?:if\\s+not\\s+exists\\s+)?[\"`]?(\\w+)[\"`]?\\s+on\\s+[\"`]?\\w+[\"`]?")
+)
+
+// cloneTableStatements 生成按模板表结构（含索引）创建新表的语句
+func cloneTableStatements(db *gorm.DB, template, table string) ([]string, error) {
+	switch db.Dialector.Name() {
+	case DriverMySQL:
+		return []string{fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` LIKE `%s`", table, template)}, nil
+	case DriverPostgres:
+		return []string{fmt.Sprintf(`CREATE TABLE IF NOT EXISTS "%s" (LIKE "%s" INCLUDING ALL)`, table, template)}, nil
+	case DriverSQLite:
+		// SQLite 没有 CREATE TABLE ... LIKE，改写模板表的建表和建索引语句，索引名在库内唯一，加上表名前缀
+		var schemas []struct {
+			Type string
+			SQL  string
+		}
+		if err := db.Raw("SELECT type, sql FROM sqlite_master WHERE tbl_name = ? AND sql IS NOT NULL ORDER BY CASE type WHEN 'table' THEN 0 ELSE 1 END",
+			template).Scan(&schemas).Error; err != nil {
+			return nil, err
+		}
+		stmts := make([]string, 0, len(schemas))
+		for _, s := range schemas {
+			if s.Type == "table" {
+				stmts = append(stmts, sqliteCreateTable.ReplaceAllString(s.SQL, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS "%s"`, table)))
+			} else {
+				stmts = append(stmts, sqliteCreateIndex.ReplaceAllString(s.SQL, fmt.Sprintf(`CREATE ${1}INDEX IF NOT EXISTS "%s_${2}" ON "%s"`, table, table)))
+			}
+		}
+		return stmts, nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", db.Dialector.Name())
+	}
+}
+
+// applied 创建 schema_migrations 表并查询已执行的迁移
+func (m *Migrator) applied(ctx context.Context) (map[int64]*SchemaMigration, error) {
+	db := m.db.WithContext(ctx)
+	if err := db.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, err
+	}
+
+	var records []*SchemaMigration
+	if err := db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]*SchemaMigration, len(records))
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+	return applied, nil
+}
+
+// run 在事务中逐条执行脚本中的语句，再通过 record 更新迁移记录；
+// MySQL 的 DDL 会隐式提交，语句执行到一半失败时需要人工处理后再重试
+func (m *Migrator) run(ctx context.Context, script string, record func(tx *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range splitStatements(script) {
+			for _, s := range m.expandCommentTables(tx, stmt) {
+				if err := tx.Exec(s).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return record(tx)
+	})
+}
+
+// commentTablePlaceholder 迁移语句中的评论表占位符，语句对 comment 表和已创建的分片表、归档表各执行一次
+const commentTablePlaceholder = "{comment_table}"
+
+// expandCommentTables 将包含占位符的语句展开为每张已存在的评论表各一条，使变更 comment 表结构的迁移同步到分片表和归档表
+func (m *Migrator) expandCommentTables(tx *gorm.DB, stmt string) []string {
+	if !strings.Contains(stmt, commentTablePlaceholder) {
+		return []string{stmt}
+	}
+	stmts := []string{strings.ReplaceAll(stmt, commentTablePlaceholder, commentTable)}
+	for _, table := range append(m.shards.tables(), archiveTable) {
+		if table != commentTable && tx.Migrator().HasTable(table) {
+			stmts = append(stmts, strings.ReplaceAll(stmt, commentTablePlaceholder, table))
+		}
+	}
+	return stmts
+}
+
+// splitStatements 按行尾的分号拆分脚本，驱动默认不支持一次执行多条语句
+func splitStatements(script string) []string {
+	var stmts []string
+	var b strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(b.String()), ";"))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
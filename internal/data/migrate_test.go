@@ -0,0 +1,201 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrator(t *testing.T) {
+	db, err := NewDB(&conf.Data_Database{
+		Driver:       DriverSQLite,
+		Source:       filepath.Join(t.TempDir(), "migrate.db") + "?_pragma=busy_timeout(5000)",
+		MaxOpenConns: 1,
+	})
+	if err != nil {
+		t.Fatalf("new sqlite db: %v", err)
+	}
+	data := &Data{db: db}
+	m, err := NewMigrator(data)
+	if err != nil {
+		t.Fatalf("new migrator: %v", err)
+	}
+	ctx := context.Background()
+
+	t.Run("执行全部迁移", func(t *testing.T) {
+		done, err := m.Up(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, done, len(m.migrations))
+
+		assert.True(t, db.Migrator().HasIndex(&biz.Comment{}, "idx_module_resource"))
+
+		// 迁移生成的表结构与模型一致
+		c := &biz.Comment{Module: 1, ResourceID: "r1", UserID: "u1", Content: "hi"}
+		assert.NoError(t, db.Create(c).Error)
+		assert.NoError(t, db.Create(&CommentLike{CommentID: c.ID, UserID: "u2"}).Error)
+		assert.NoError(t, db.First(&biz.Comment{}, c.ID).Error)
+
+		// 服务用到的每张表都由迁移创建，且能写入模型的所有列
+		models := []interface{}{
+			&biz.Mention{}, &CommentEvent{}, &biz.WebhookSubscription{}, &biz.WebhookDelivery{},
+			&biz.Idempotency{}, &biz.Report{}, &biz.UserBlock{}, &biz.ResourceSetting{},
+			&biz.Attachment{}, &biz.BulkDeleteJob{}, &ArchivedResource{},
+		}
+		for _, model := range models {
+			assert.True(t, db.Migrator().HasTable(model), "%T", model)
+			assert.NoError(t, db.Create(model).Error, "%T", model)
+		}
+	})
+
+	t.Run("重复执行不再应用已执行的迁移", func(t *testing.T) {
+		done, err := m.Up(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, done)
+	})
+
+	t.Run("回滚最近一个迁移", func(t *testing.T) {
+		_, err := m.CreateCommentTables(ctx)
+		assert.NoError(t, err)
+
+		reverted, err := m.Down(ctx)
+		assert.NoError(t, err)
+		last := m.migrations[len(m.migrations)-1]
+		assert.Equal(t, last, reverted)
+
+		statuses, err := m.Status(ctx)
+		assert.NoError(t, err)
+		for _, s := range statuses {
+			assert.Equal(t, s.Version != last.Version, s.AppliedGmt != nil, s.Version)
+		}
+	})
+
+	t.Run("变更评论表结构的迁移同步到已创建的评论表", func(t *testing.T) {
+		// 回滚到增加 path 列的迁移为止
+		reverted := 1
+		for {
+			migration, err := m.Down(ctx)
+			if !assert.NoError(t, err) || !assert.NotNil(t, migration) {
+				return
+			}
+			reverted++
+			if migration.Name == "add_comment_path" {
+				break
+			}
+		}
+		assert.False(t, db.Migrator().HasColumn(&biz.Comment{}, "path"))
+		assert.False(t, db.Migrator().HasColumn(archiveTable, "path"))
+
+		done, err := m.Up(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, done, reverted)
+		assert.True(t, db.Migrator().HasColumn(archiveTable, "path"))
+
+		_, err = m.Down(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("全部回滚后没有可回滚的迁移", func(t *testing.T) {
+		for range m.migrations[1:] {
+			_, err := m.Down(ctx)
+			assert.NoError(t, err)
+		}
+		assert.False(t, db.Migrator().HasTable(&biz.Comment{}))
+
+		reverted, err := m.Down(ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, reverted)
+	})
+}
+
+// baselineCommentDDL 引入迁移之前按 README 建好的评论表（SQLite 语法）
+const baselineCommentDDL = `create table comment
+(
+  id          integer primary key autoincrement,
+  module      tinyint                              not null,
+  resource_id varchar(32)                          not null,
+  root_id     varchar(32)                          not null,
+  parent_id   varchar(32)                          not null,
+  level       int          default 0               not null,
+  user_id     varchar(32)                          not null,
+  username    varchar(24)                          not null,
+  avatar      varchar(255)                         not null,
+  content     text                                 not null,
+  like_count  int          default 0               not null,
+  reply_count int          default 0               not null,
+  create_gmt  datetime     default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime     default CURRENT_TIMESTAMP not null
+)`
+
+func TestMigrator_UpgradeBaseline(t *testing.T) {
+	db, err := NewDB(&conf.Data_Database{
+		Driver:       DriverSQLite,
+		Source:       filepath.Join(t.TempDir(), "baseline.db") + "?_pragma=busy_timeout(5000)",
+		MaxOpenConns: 1,
+	})
+	if err != nil {
+		t.Fatalf("new sqlite db: %v", err)
+	}
+	assert.NoError(t, db.Exec(baselineCommentDDL).Error)
+	assert.NoError(t, db.Exec("insert into comment (module, resource_id, root_id, parent_id, user_id, username, avatar, content) values (1, 'r1', 0, 0, 'u1', 'n1', '', 'old')").Error)
+
+	m, err := NewMigrator(&Data{db: db})
+	if err != nil {
+		t.Fatalf("new migrator: %v", err)
+	}
+	done, err := m.Up(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, done, len(m.migrations))
+
+	// 初始版本之后新增的列和索引都由迁移补齐
+	for _, column := range []string{"path", "content_html", "content_text", "flagged", "hidden", "deleted", "moderation_status"} {
+		assert.True(t, db.Migrator().HasColumn(&biz.Comment{}, column), column)
+	}
+	for _, index := range []string{"idx_module_resource", "idx_user_create", "idx_path"} {
+		assert.True(t, db.Migrator().HasIndex(&biz.Comment{}, index), index)
+	}
+
+	// 评论ID由生成器分配，迁移后不再自增
+	var ddl string
+	assert.NoError(t, db.Raw("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'comment'").Scan(&ddl).Error)
+	assert.NotContains(t, strings.ToLower(ddl), "autoincrement")
+
+	// 历史评论保留，新评论可以写入模型的所有列
+	var old biz.Comment
+	assert.NoError(t, db.Where("content = ?", "old").First(&old).Error)
+	assert.Equal(t, "", old.Path)
+	assert.False(t, old.Hidden)
+	c := &biz.Comment{ID: 1 << 20, Module: 1, ResourceID: "r1", UserID: "u2", Content: "new", ContentHTML: "<p>new</p>", Path: "/1048576/"}
+	assert.NoError(t, db.Create(c).Error)
+	assert.NoError(t, db.First(&biz.Comment{}, c.ID).Error)
+}
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"m/0002_b.up.sql":   {Data: []byte("b")},
+		"m/0002_b.down.sql": {Data: []byte("b")},
+		"m/0001_a.up.sql":   {Data: []byte("a")},
+		"m/0001_a.down.sql": {Data: []byte("a")},
+	}
+	migrations, err := loadMigrations(fsys, "m")
+	assert.NoError(t, err)
+	if assert.Len(t, migrations, 2) {
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "b", migrations[1].Name)
+	}
+
+	// 缺少 down 脚本
+	delete(fsys, "m/0002_b.down.sql")
+	_, err = loadMigrations(fsys, "m")
+	assert.Error(t, err)
+}
+
+func TestSplitStatements(t *testing.T) {
+	stmts := splitStatements("-- comment\ncreate table a\n(\n  id int\n);\n\ncreate index i on a (id);\n")
+	assert.Equal(t, []string{"create table a\n(\n  id int\n)", "create index i on a (id)"}, stmts)
+}
@@ -0,0 +1,18 @@
+create table if not exists comment
+(
+  id          bigint auto_increment
+        primary key,
+  module      tinyint                            not null comment '0：视频，1：文章',
+  resource_id varchar(32)                        not null,
+  root_id     varchar(32)                        not null comment '根评论',
+  parent_id   varchar(32)                        not null,
+  level       int      default 0                 not null,
+  user_id     varchar(32)                        not null,
+  username    varchar(24)                        not null,
+  avatar      varchar(255)                       not null comment '头像 url',
+  content     text                               not null,
+  like_count  int      default 0                 not null,
+  reply_count int      default 0                 not null,
+  create_gmt  datetime default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP
+);
@@ -0,0 +1,9 @@
+create table if not exists comment_like
+(
+  id          bigint auto_increment
+        primary key,
+  comment_id  bigint                             not null,
+  user_id     varchar(32)                        not null,
+  create_time datetime default CURRENT_TIMESTAMP not null,
+  unique index idx_comment_user (comment_id, user_id)
+);
@@ -0,0 +1,4 @@
+alter table comment
+  drop index idx_module_resource,
+  drop index idx_root_id,
+  drop index idx_parent_id;
@@ -0,0 +1,4 @@
+alter table comment
+  add index idx_module_resource (module, resource_id, level, like_count),
+  add index idx_root_id (root_id),
+  add index idx_parent_id (parent_id);
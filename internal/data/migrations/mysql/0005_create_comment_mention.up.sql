@@ -0,0 +1,13 @@
+create table if not exists comment_mention
+(
+  id          bigint auto_increment
+        primary key,
+  comment_id  bigint                             not null,
+  user_id     varchar(32)                        not null comment '被提及用户',
+  name        varchar(32)                        not null comment '@ 之后的原始文本',
+  span_offset int                                not null,
+  span_length int                                not null,
+  create_gmt  datetime default CURRENT_TIMESTAMP not null,
+  index idx_mention_comment (comment_id),
+  index idx_mention_user_create (user_id, create_gmt)
+);
@@ -0,0 +1,15 @@
+create table if not exists comment_event_outbox
+(
+  id             bigint auto_increment
+        primary key,
+  event_type     varchar(32)                        not null,
+  comment_id     bigint                             not null,
+  payload        text                               not null comment '事件 JSON',
+  status         tinyint  default 0                 not null comment '0：待投递，1：已投递，2：不再重试',
+  attempts       int      default 0                 not null,
+  last_error     varchar(255) default ''            not null,
+  next_retry_gmt datetime                           not null,
+  create_gmt     datetime default CURRENT_TIMESTAMP not null,
+  update_gmt     datetime default CURRENT_TIMESTAMP not null,
+  index idx_status_retry (status, next_retry_gmt)
+);
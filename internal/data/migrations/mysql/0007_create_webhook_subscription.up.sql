@@ -0,0 +1,16 @@
+create table if not exists webhook_subscription
+(
+  id          bigint auto_increment
+        primary key,
+  name        varchar(64)                        not null,
+  module      tinyint  default 0                 not null comment '0：所有模块',
+  event_types varchar(255) default ''            not null comment '逗号分隔，为空表示所有类型',
+  url         varchar(512)                       not null,
+  secret      varchar(128)                       not null,
+  enabled     tinyint(1) default 1               not null,
+  source      varchar(16)                        not null comment 'config / admin',
+  create_gmt  datetime default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime default CURRENT_TIMESTAMP not null,
+  unique index uk_name (name),
+  index idx_module (module)
+);
@@ -0,0 +1,19 @@
+create table if not exists webhook_delivery
+(
+  id               bigint auto_increment
+        primary key,
+  subscription_id  bigint                             not null,
+  event_id         bigint                             not null,
+  event_type       varchar(32)                        not null,
+  module           tinyint                            not null,
+  payload          text                               not null,
+  status           tinyint  default 0                 not null comment '0：待投递，1：成功，2：死信',
+  attempts         int      default 0                 not null,
+  last_status_code int      default 0                 not null,
+  last_error       varchar(255) default ''            not null,
+  next_retry_gmt   datetime                           not null,
+  create_gmt       datetime default CURRENT_TIMESTAMP not null,
+  update_gmt       datetime default CURRENT_TIMESTAMP not null,
+  unique index uk_subscription_event (subscription_id, event_id),
+  index idx_delivery_status_retry (status, next_retry_gmt)
+);
@@ -0,0 +1,13 @@
+create table if not exists comment_idempotency
+(
+  id          bigint auto_increment
+        primary key,
+  user_id     varchar(32)                        not null,
+  idem_key    varchar(128)                       not null,
+  fingerprint char(64)                           not null comment '请求内容 sha256',
+  comment_id  bigint   default 0                 not null comment '预先分配的评论ID，评论未写入表示处理中',
+  expire_gmt  datetime                           not null,
+  create_gmt  datetime default CURRENT_TIMESTAMP not null,
+  unique index uk_user_key (user_id, idem_key),
+  index idx_expire (expire_gmt)
+);
@@ -0,0 +1,14 @@
+create table if not exists comment_report
+(
+  id         bigint auto_increment
+        primary key,
+  comment_id bigint                             not null,
+  user_id    varchar(32)                        not null comment '举报用户',
+  reason     tinyint                            not null comment '1：垃圾广告，2：辱骂攻击，3：骚扰，4：仇恨言论，5：色情低俗，6：违法违规，7：其他',
+  detail     varchar(500) default ''            not null,
+  status     tinyint  default 0                 not null comment '0：待处理，1：举报成立，2：举报驳回',
+  create_gmt datetime default CURRENT_TIMESTAMP not null,
+  update_gmt datetime default CURRENT_TIMESTAMP not null,
+  unique index uk_comment_user (comment_id, user_id),
+  index idx_status_comment (status, comment_id)
+);
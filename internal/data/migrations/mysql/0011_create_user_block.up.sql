@@ -0,0 +1,10 @@
+create table if not exists user_block
+(
+  id              bigint auto_increment
+        primary key,
+  user_id         varchar(32)                        not null comment '发起拉黑的用户',
+  blocked_user_id varchar(32)                        not null comment '被拉黑的用户',
+  create_gmt      datetime default CURRENT_TIMESTAMP not null,
+  unique index uk_user_blocked (user_id, blocked_user_id),
+  index idx_blocked (blocked_user_id)
+);
@@ -0,0 +1,13 @@
+create table if not exists comment_resource_setting
+(
+  id                  bigint auto_increment
+        primary key,
+  module              tinyint                            not null,
+  resource_id         varchar(32)                        not null,
+  status              tinyint  default 0                 not null comment '0：开放，1：关闭，2：只读',
+  max_reply_depth     int      default 0                 not null comment '最大回复层级，0 表示不限制',
+  moderation_required tinyint(1) default 0               not null comment '新评论是否需要审核',
+  create_gmt          datetime default CURRENT_TIMESTAMP not null,
+  update_gmt          datetime default CURRENT_TIMESTAMP not null,
+  unique index uk_module_resource (module, resource_id)
+);
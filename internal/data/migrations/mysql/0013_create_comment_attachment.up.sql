@@ -0,0 +1,17 @@
+create table if not exists comment_attachment
+(
+  id            bigint auto_increment
+        primary key,
+  comment_id    bigint                             not null,
+  type          tinyint                            not null comment '1：图片，2：链接预览，3：表情贴纸',
+  url           varchar(1024)                      not null,
+  width         int      default 0                 not null,
+  height        int      default 0                 not null,
+  title         varchar(200)  default ''           not null,
+  description   varchar(500)  default ''           not null,
+  thumbnail_url varchar(1024) default ''           not null,
+  sticker_id    varchar(64)   default ''           not null,
+  sort          int      default 0                 not null comment '附件在评论中的顺序',
+  create_gmt    datetime default CURRENT_TIMESTAMP not null,
+  index idx_attachment_comment (comment_id)
+);
@@ -0,0 +1 @@
+drop table if exists comment_bulk_delete_job;
@@ -0,0 +1,17 @@
+create table if not exists comment_bulk_delete_job
+(
+  id          bigint auto_increment
+        primary key,
+  user_id     varchar(32)  default ''                not null comment '按用户删除时的用户ID',
+  module      tinyint      default 0                 not null comment '按资源删除时的业务模块',
+  resource_id varchar(32)  default ''                not null comment '按资源删除时的资源ID',
+  mode        tinyint      default 0                 not null comment '0：物理删除，1：软删除',
+  status      tinyint      default 0                 not null comment '0：等待执行，1：执行中，2：完成，3：失败',
+  total       int          default 0                 not null comment '创建时匹配的评论数',
+  processed   int          default 0                 not null comment '已处理的匹配评论数',
+  affected    int          default 0                 not null comment '实际删除的评论数',
+  error       varchar(255) default ''                not null,
+  create_gmt  datetime     default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime     default CURRENT_TIMESTAMP not null comment '执行中任务的心跳',
+  index idx_status_update (status, update_gmt)
+);
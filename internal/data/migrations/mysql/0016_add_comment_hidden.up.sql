@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column hidden tinyint(1) default 0 not null comment '因举报成立或批量软删除被隐藏' after flagged;
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column moderation_status tinyint default 0 not null comment '0：审核通过，1：待审核，2：审核拒绝' after hidden;
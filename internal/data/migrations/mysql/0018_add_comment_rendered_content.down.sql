@@ -0,0 +1,3 @@
+alter table {comment_table}
+  drop column content_html,
+  drop column content_text;
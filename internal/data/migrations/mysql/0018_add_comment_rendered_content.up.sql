@@ -0,0 +1,3 @@
+alter table {comment_table}
+  add column content_html text not null comment '渲染后的安全 HTML' after content,
+  add column content_text text not null comment '去除标记后的纯文本' after content_html;
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add fulltext index ft_content (content) with parser ngram;
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add index idx_user_create (user_id, create_gmt);
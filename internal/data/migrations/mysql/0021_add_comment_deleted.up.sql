@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column deleted tinyint(1) default 0 not null comment '被管理员批量软删除' after hidden;
@@ -0,0 +1,3 @@
+alter table {comment_table}
+  drop index idx_path,
+  drop column path;
@@ -0,0 +1,3 @@
+alter table {comment_table}
+  add column path varchar(700) character set ascii default '' not null comment '物化路径，如 /1/5/9/，为空表示尚未回填' after parent_id,
+  add index idx_path (path);
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  modify column id bigint not null;
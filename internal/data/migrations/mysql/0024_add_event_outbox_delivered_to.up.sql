@@ -0,0 +1,2 @@
+alter table comment_event_outbox
+  add column delivered_to varchar(255) default '' not null comment '已投递成功的发布者，逗号分隔' after last_error;
@@ -0,0 +1,8 @@
+create table if not exists comment_like
+(
+  id          bigserial primary key,
+  comment_id  bigint                              not null,
+  user_id     varchar(32)                         not null,
+  create_time timestamp default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists idx_comment_user on comment_like (comment_id, user_id);
@@ -0,0 +1,3 @@
+create index if not exists idx_module_resource on comment (module, resource_id, level, like_count);
+create index if not exists idx_root_id on comment (root_id);
+create index if not exists idx_parent_id on comment (parent_id);
@@ -0,0 +1,8 @@
+create table if not exists comment_archived_resource
+(
+  module           smallint                            not null,
+  resource_id      varchar(32)                         not null,
+  last_comment_gmt timestamp                           not null,
+  archived_gmt     timestamp default CURRENT_TIMESTAMP not null,
+  primary key (module, resource_id)
+);
@@ -0,0 +1,12 @@
+create table if not exists comment_mention
+(
+  id          bigserial primary key,
+  comment_id  bigint                              not null,
+  user_id     varchar(32)                         not null,
+  name        varchar(32)                         not null,
+  span_offset int                                 not null,
+  span_length int                                 not null,
+  create_gmt  timestamp default CURRENT_TIMESTAMP not null
+);
+create index if not exists idx_mention_comment on comment_mention (comment_id);
+create index if not exists idx_mention_user_create on comment_mention (user_id, create_gmt);
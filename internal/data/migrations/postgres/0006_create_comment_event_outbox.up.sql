@@ -0,0 +1,14 @@
+create table if not exists comment_event_outbox
+(
+  id             bigserial primary key,
+  event_type     varchar(32)                         not null,
+  comment_id     bigint                              not null,
+  payload        text                                not null,
+  status         smallint     default 0              not null,
+  attempts       int          default 0              not null,
+  last_error     varchar(255) default ''             not null,
+  next_retry_gmt timestamp                           not null,
+  create_gmt     timestamp default CURRENT_TIMESTAMP not null,
+  update_gmt     timestamp default CURRENT_TIMESTAMP not null
+);
+create index if not exists idx_status_retry on comment_event_outbox (status, next_retry_gmt);
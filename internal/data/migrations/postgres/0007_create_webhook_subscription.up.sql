@@ -0,0 +1,15 @@
+create table if not exists webhook_subscription
+(
+  id          bigserial primary key,
+  name        varchar(64)                         not null,
+  module      smallint     default 0              not null,
+  event_types varchar(255) default ''             not null,
+  url         varchar(512)                        not null,
+  secret      varchar(128)                        not null,
+  enabled     boolean      default true           not null,
+  source      varchar(16)                         not null,
+  create_gmt  timestamp default CURRENT_TIMESTAMP not null,
+  update_gmt  timestamp default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_name on webhook_subscription (name);
+create index if not exists idx_module on webhook_subscription (module);
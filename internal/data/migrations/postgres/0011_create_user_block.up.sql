@@ -0,0 +1,9 @@
+create table if not exists user_block
+(
+  id              bigserial primary key,
+  user_id         varchar(32)                         not null,
+  blocked_user_id varchar(32)                         not null,
+  create_gmt      timestamp default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_user_blocked on user_block (user_id, blocked_user_id);
+create index if not exists idx_blocked on user_block (blocked_user_id);
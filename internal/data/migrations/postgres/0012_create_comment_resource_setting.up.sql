@@ -0,0 +1,12 @@
+create table if not exists comment_resource_setting
+(
+  id                  bigserial primary key,
+  module              smallint                            not null,
+  resource_id         varchar(32)                         not null,
+  status              smallint  default 0                 not null,
+  max_reply_depth     int       default 0                 not null,
+  moderation_required boolean   default false             not null,
+  create_gmt          timestamp default CURRENT_TIMESTAMP not null,
+  update_gmt          timestamp default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_module_resource on comment_resource_setting (module, resource_id);
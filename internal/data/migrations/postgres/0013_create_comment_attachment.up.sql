@@ -0,0 +1,16 @@
+create table if not exists comment_attachment
+(
+  id            bigserial primary key,
+  comment_id    bigint                              not null,
+  type          smallint                            not null,
+  url           varchar(1024)                       not null,
+  width         int           default 0             not null,
+  height        int           default 0             not null,
+  title         varchar(200)  default ''            not null,
+  description   varchar(500)  default ''            not null,
+  thumbnail_url varchar(1024) default ''            not null,
+  sticker_id    varchar(64)   default ''            not null,
+  sort          int           default 0             not null,
+  create_gmt    timestamp default CURRENT_TIMESTAMP not null
+);
+create index if not exists idx_attachment_comment on comment_attachment (comment_id);
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  drop column if exists flagged;
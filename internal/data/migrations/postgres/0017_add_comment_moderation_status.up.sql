@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column if not exists moderation_status smallint default 0 not null;
@@ -0,0 +1,3 @@
+alter table {comment_table}
+  drop column if exists content_html,
+  drop column if exists content_text;
@@ -0,0 +1,3 @@
+alter table {comment_table}
+  add column if not exists content_html text default '' not null,
+  add column if not exists content_text text default '' not null;
@@ -0,0 +1 @@
+-- 全文索引只在 MySQL 上创建，该数据库没有对应的索引类型
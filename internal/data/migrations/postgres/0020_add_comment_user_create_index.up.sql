@@ -0,0 +1 @@
+create index if not exists idx_user_create on comment (user_id, create_gmt);
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column if not exists deleted boolean default false not null;
@@ -0,0 +1,3 @@
+drop index if exists idx_path;
+alter table {comment_table}
+  drop column if exists path;
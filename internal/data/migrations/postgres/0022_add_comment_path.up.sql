@@ -0,0 +1,3 @@
+alter table {comment_table}
+  add column if not exists path varchar(700) default '' not null;
+create index if not exists idx_path on comment (path varchar_pattern_ops);
@@ -0,0 +1,4 @@
+create sequence if not exists comment_id_seq owned by comment.id;
+select setval('comment_id_seq', (select coalesce(max(id), 0) + 1 from comment), false);
+alter table {comment_table}
+  alter column id set default nextval('comment_id_seq');
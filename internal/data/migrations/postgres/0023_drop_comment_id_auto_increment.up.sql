@@ -0,0 +1,4 @@
+-- 分片表和归档表复制了 comment 表的默认值，先去掉所有表的默认值再删除序列
+alter table {comment_table}
+  alter column id drop default;
+drop sequence if exists comment_id_seq;
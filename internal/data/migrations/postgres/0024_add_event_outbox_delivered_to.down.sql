@@ -0,0 +1,2 @@
+alter table comment_event_outbox
+  drop column if exists delivered_to;
@@ -0,0 +1,2 @@
+alter table comment_event_outbox
+  add column if not exists delivered_to varchar(255) default '' not null;
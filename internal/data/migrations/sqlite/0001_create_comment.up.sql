@@ -0,0 +1,17 @@
+create table if not exists comment
+(
+  id          integer primary key autoincrement,
+  module      tinyint                              not null,
+  resource_id varchar(32)                          not null,
+  root_id     varchar(32)                          not null,
+  parent_id   varchar(32)                          not null,
+  level       int          default 0               not null,
+  user_id     varchar(32)                          not null,
+  username    varchar(24)                          not null,
+  avatar      varchar(255)                         not null,
+  content     text                                 not null,
+  like_count  int          default 0               not null,
+  reply_count int          default 0               not null,
+  create_gmt  datetime     default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime     default CURRENT_TIMESTAMP not null
+);
@@ -0,0 +1,3 @@
+drop index if exists idx_module_resource;
+drop index if exists idx_root_id;
+drop index if exists idx_parent_id;
@@ -0,0 +1,8 @@
+create table if not exists comment_archived_resource
+(
+  module           tinyint                            not null,
+  resource_id      varchar(32)                        not null,
+  last_comment_gmt datetime                           not null,
+  archived_gmt     datetime default CURRENT_TIMESTAMP not null,
+  primary key (module, resource_id)
+);
@@ -0,0 +1,14 @@
+create table if not exists comment_event_outbox
+(
+  id             integer primary key autoincrement,
+  event_type     varchar(32)                        not null,
+  comment_id     bigint                             not null,
+  payload        text                               not null,
+  status         tinyint  default 0                 not null,
+  attempts       int      default 0                 not null,
+  last_error     varchar(255) default ''            not null,
+  next_retry_gmt datetime                           not null,
+  create_gmt     datetime default CURRENT_TIMESTAMP not null,
+  update_gmt     datetime default CURRENT_TIMESTAMP not null
+);
+create index if not exists idx_status_retry on comment_event_outbox (status, next_retry_gmt);
@@ -0,0 +1,15 @@
+create table if not exists webhook_subscription
+(
+  id          integer primary key autoincrement,
+  name        varchar(64)                        not null,
+  module      tinyint  default 0                 not null,
+  event_types varchar(255) default ''            not null,
+  url         varchar(512)                       not null,
+  secret      varchar(128)                       not null,
+  enabled     tinyint(1) default 1               not null,
+  source      varchar(16)                        not null,
+  create_gmt  datetime default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_name on webhook_subscription (name);
+create index if not exists idx_module on webhook_subscription (module);
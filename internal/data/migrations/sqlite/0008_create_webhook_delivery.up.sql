@@ -0,0 +1,18 @@
+create table if not exists webhook_delivery
+(
+  id               integer primary key autoincrement,
+  subscription_id  bigint                             not null,
+  event_id         bigint                             not null,
+  event_type       varchar(32)                        not null,
+  module           tinyint                            not null,
+  payload          text                               not null,
+  status           tinyint  default 0                 not null,
+  attempts         int      default 0                 not null,
+  last_status_code int      default 0                 not null,
+  last_error       varchar(255) default ''            not null,
+  next_retry_gmt   datetime                           not null,
+  create_gmt       datetime default CURRENT_TIMESTAMP not null,
+  update_gmt       datetime default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_subscription_event on webhook_delivery (subscription_id, event_id);
+create index if not exists idx_delivery_status_retry on webhook_delivery (status, next_retry_gmt);
@@ -0,0 +1,12 @@
+create table if not exists comment_idempotency
+(
+  id          integer primary key autoincrement,
+  user_id     varchar(32)                        not null,
+  idem_key    varchar(128)                       not null,
+  fingerprint char(64)                           not null,
+  comment_id  bigint   default 0                 not null,
+  expire_gmt  datetime                           not null,
+  create_gmt  datetime default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_user_key on comment_idempotency (user_id, idem_key);
+create index if not exists idx_expire on comment_idempotency (expire_gmt);
@@ -0,0 +1,13 @@
+create table if not exists comment_report
+(
+  id         integer primary key autoincrement,
+  comment_id bigint                             not null,
+  user_id    varchar(32)                        not null,
+  reason     tinyint                            not null,
+  detail     varchar(500) default ''            not null,
+  status     tinyint  default 0                 not null,
+  create_gmt datetime default CURRENT_TIMESTAMP not null,
+  update_gmt datetime default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_comment_user on comment_report (comment_id, user_id);
+create index if not exists idx_status_comment on comment_report (status, comment_id);
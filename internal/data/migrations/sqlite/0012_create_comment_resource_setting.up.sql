@@ -0,0 +1,12 @@
+create table if not exists comment_resource_setting
+(
+  id                  integer primary key autoincrement,
+  module              tinyint                            not null,
+  resource_id         varchar(32)                        not null,
+  status              tinyint  default 0                 not null,
+  max_reply_depth     int      default 0                 not null,
+  moderation_required tinyint(1) default 0               not null,
+  create_gmt          datetime default CURRENT_TIMESTAMP not null,
+  update_gmt          datetime default CURRENT_TIMESTAMP not null
+);
+create unique index if not exists uk_module_resource on comment_resource_setting (module, resource_id);
@@ -0,0 +1,16 @@
+create table if not exists comment_bulk_delete_job
+(
+  id          integer primary key autoincrement,
+  user_id     varchar(32)  default ''                not null,
+  module      tinyint      default 0                 not null,
+  resource_id varchar(32)  default ''                not null,
+  mode        tinyint      default 0                 not null,
+  status      tinyint      default 0                 not null,
+  total       int          default 0                 not null,
+  processed   int          default 0                 not null,
+  affected    int          default 0                 not null,
+  error       varchar(255) default ''                not null,
+  create_gmt  datetime     default CURRENT_TIMESTAMP not null,
+  update_gmt  datetime     default CURRENT_TIMESTAMP not null
+);
+create index if not exists idx_status_update on comment_bulk_delete_job (status, update_gmt);
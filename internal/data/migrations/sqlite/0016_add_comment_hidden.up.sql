@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column hidden tinyint(1) default 0 not null;
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column moderation_status tinyint default 0 not null;
@@ -0,0 +1,4 @@
+alter table {comment_table}
+  drop column content_html;
+alter table {comment_table}
+  drop column content_text;
@@ -0,0 +1,4 @@
+alter table {comment_table}
+  add column content_html text default '' not null;
+alter table {comment_table}
+  add column content_text text default '' not null;
@@ -0,0 +1,2 @@
+alter table {comment_table}
+  add column deleted tinyint(1) default 0 not null;
@@ -0,0 +1,5 @@
+drop index if exists idx_path;
+-- 分片表和归档表上复制的索引名带表名前缀
+drop index if exists "{comment_table}_idx_path";
+alter table {comment_table}
+  drop column path;
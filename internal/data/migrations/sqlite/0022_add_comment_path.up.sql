@@ -0,0 +1,3 @@
+alter table {comment_table}
+  add column path varchar(700) default '' not null;
+create index if not exists idx_path on comment (path);
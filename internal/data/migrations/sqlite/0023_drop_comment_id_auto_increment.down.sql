@@ -0,0 +1,37 @@
+-- SQLite 不能修改列定义，重建 comment 表；分片表和归档表由 migrate up 在迁移后以 comment 表为模板创建
+create table comment_rebuild
+(
+  id                integer      primary key autoincrement,
+  module            tinyint                              not null,
+  resource_id       varchar(32)                          not null,
+  root_id           varchar(32)                          not null,
+  parent_id         varchar(32)                          not null,
+  level             int          default 0               not null,
+  user_id           varchar(32)                          not null,
+  username          varchar(24)                          not null,
+  avatar            varchar(255)                         not null,
+  content           text                                 not null,
+  like_count        int          default 0               not null,
+  reply_count       int          default 0               not null,
+  create_gmt        datetime     default CURRENT_TIMESTAMP not null,
+  update_gmt        datetime     default CURRENT_TIMESTAMP not null,
+  flagged           tinyint(1)   default 0               not null,
+  hidden            tinyint(1)   default 0               not null,
+  moderation_status tinyint      default 0               not null,
+  content_html      text         default ''              not null,
+  content_text      text         default ''              not null,
+  deleted           tinyint(1)   default 0               not null,
+  path              varchar(700) default ''              not null
+);
+insert into comment_rebuild (id, module, resource_id, root_id, parent_id, level, user_id, username, avatar, content, like_count, reply_count,
+                             create_gmt, update_gmt, flagged, hidden, moderation_status, content_html, content_text, deleted, path)
+select id, module, resource_id, root_id, parent_id, level, user_id, username, avatar, content, like_count, reply_count,
+       create_gmt, update_gmt, flagged, hidden, moderation_status, content_html, content_text, deleted, path
+from comment;
+drop table comment;
+alter table comment_rebuild rename to comment;
+create index if not exists idx_module_resource on comment (module, resource_id, level, like_count);
+create index if not exists idx_root_id on comment (root_id);
+create index if not exists idx_parent_id on comment (parent_id);
+create index if not exists idx_user_create on comment (user_id, create_gmt);
+create index if not exists idx_path on comment (path);
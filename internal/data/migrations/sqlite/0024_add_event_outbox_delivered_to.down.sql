@@ -0,0 +1,2 @@
+alter table comment_event_outbox
+  drop column delivered_to;
@@ -0,0 +1,2 @@
+alter table comment_event_outbox
+  add column delivered_to varchar(255) default '' not null;
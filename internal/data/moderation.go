@@ -0,0 +1,43 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ModerateComment 设置评论的审核状态；状态未变化时不写入事件，重复审核通过不会重复推送
+func (r *commentRepo) ModerateComment(ctx context.Context, commentID int64, status int32) (*biz.Comment, error) {
+	var comment biz.Comment
+	err := r.data.transaction(ctx, func(tx *gorm.DB) error {
+		table, err := r.data.locateComment(tx, commentID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Table(table).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", commentID).First(&comment).Error; err != nil {
+			return err
+		}
+		if comment.ModerationStatus == status {
+			return nil
+		}
+
+		if err := tx.Table(table).Where("id = ?", commentID).Updates(map[string]interface{}{
+			"moderation_status": status,
+			"update_gmt":        time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+		comment.ModerationStatus = status
+		if status != biz.ModerationApproved {
+			return nil
+		}
+		return writeEvents(tx, biz.NewCommentEvent(biz.EventCommentApproved, &comment))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
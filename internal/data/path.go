@@ -0,0 +1,78 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"sort"
+)
+
+type commentPathRepo struct {
+	data *Data
+}
+
+// NewCommentPathRepo .
+func NewCommentPathRepo(data *Data) biz.CommentPathRepo {
+	return &commentPathRepo{
+		data: data,
+	}
+}
+
+// BackfillCommentPaths 为一批路径为空的评论补齐路径；父评论已被删除的历史孤儿回复挂到根评论下，根评论也不存在时作为根路径。
+// 按ID顺序从所有评论表和归档表中取一批，父评论和根评论与回复在同一张表中
+func (r *commentPathRepo) BackfillCommentPaths(ctx context.Context, afterID int64, limit int) (int64, error) {
+	db := r.data.DB(ctx)
+
+	var comments []*biz.Comment
+	tables := make(map[int64]string)
+	for _, table := range r.data.commentTables() {
+		var batch []*biz.Comment
+		if err := db.Table(table).Select("id", "root_id", "parent_id").Where("id > ? AND path = ?", afterID, "").
+			Order("id ASC").Limit(limit).Find(&batch).Error; err != nil {
+			return 0, err
+		}
+		for _, c := range batch {
+			tables[c.ID] = table
+		}
+		comments = append(comments, batch...)
+	}
+	if len(comments) == 0 {
+		return 0, nil
+	}
+	sort.Slice(comments, func(i, j int) bool { return comments[i].ID < comments[j].ID })
+	if len(comments) > limit {
+		comments = comments[:limit]
+	}
+
+	// 查询父评论和根评论的路径，同一批中先回填的评论直接使用计算结果
+	refIDs := make(map[string][]int64)
+	for _, c := range comments {
+		if c.ParentCommentID > 0 {
+			table := tables[c.ID]
+			refIDs[table] = append(refIDs[table], c.ParentCommentID, c.RootCommentID)
+		}
+	}
+	paths := make(map[int64]string, len(comments)+len(refIDs))
+	for table, ids := range refIDs {
+		var refs []*biz.Comment
+		if err := db.Table(table).Select("id", "path").Where("id IN ?", ids).Find(&refs).Error; err != nil {
+			return 0, err
+		}
+		for _, ref := range refs {
+			paths[ref.ID] = ref.Path
+		}
+	}
+
+	for _, c := range comments {
+		parentPath, ok := paths[c.ParentCommentID]
+		if !ok && c.ParentCommentID > 0 {
+			parentPath = paths[c.RootCommentID]
+		}
+		path := biz.CommentPath(parentPath, c.ID)
+		if err := db.Table(tables[c.ID]).Where("id = ? AND path = ?", c.ID, "").UpdateColumn("path", path).Error; err != nil {
+			return 0, err
+		}
+		paths[c.ID] = path
+	}
+
+	return comments[len(comments)-1].ID, nil
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"bytes"
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// NewPublisher 根据配置创建事件发布者，默认使用进程内发布
+func NewPublisher(c *conf.Data) biz.Publisher {
+	ec := c.GetEvent()
+	switch ec.GetPublisher() {
+	case "webhook":
+		timeout := 3 * time.Second
+		if ec.WebhookTimeout != nil && ec.WebhookTimeout.AsDuration() > 0 {
+			timeout = ec.WebhookTimeout.AsDuration()
+		}
+		log.Info(nil, "use webhook publisher.", "url", ec.WebhookUrl)
+		return NewWebhookPublisher(ec.WebhookUrl, timeout)
+	case "memory", "":
+		log.Info(nil, "use memory publisher.")
+		return NewMemoryPublisher()
+	default:
+		log.Fatal(nil, "event publisher error.", "publisher", ec.GetPublisher())
+		return nil
+	}
+}
+
+// MemoryPublisher 进程内事件发布者，将事件同步分发给所有订阅者
+type MemoryPublisher struct {
+	mu       sync.RWMutex
+	handlers []func(ctx context.Context, e *biz.Event) error
+}
+
+// NewMemoryPublisher .
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Subscribe 注册事件处理函数
+func (p *MemoryPublisher) Subscribe(handler func(ctx context.Context, e *biz.Event) error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = append(p.handlers, handler)
+}
+
+// Publish 依次调用所有订阅者，任一订阅者失败则整个事件稍后重新投递
+func (p *MemoryPublisher) Publish(ctx context.Context, e *biz.Event) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, handler := range p.handlers {
+		if err := handler(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WebhookPublisher 通过 HTTP POST 将事件以 JSON 推送到指定地址
+type WebhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookPublisher .
+func NewWebhookPublisher(url string, timeout time.Duration) *WebhookPublisher {
+	return &WebhookPublisher{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Publish 推送事件，非 2xx 响应视为失败
+func (p *WebhookPublisher) Publish(ctx context.Context, e *biz.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Comment-Event", string(e.Type))
+	req.Header.Set("X-Comment-Event-ID", fmt.Sprint(e.ID))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package data
+
+import (
+	"comment/internal/conf"
+	"comment/pkg/log"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func NewRedis(c *conf.Data_Redis) *redis.Client {
+	log.Info(nil, "init redis client")
+	log.Debug(nil, "redis config", "redis", c)
+
+	network := c.Network
+	if network == "" {
+		network = "tcp"
+	}
+	return redis.NewClient(&redis.Options{
+		Network:      network,
+		Addr:         c.Addr,
+		ReadTimeout:  c.ReadTimeout.AsDuration(),
+		WriteTimeout: c.WriteTimeout.AsDuration(),
+	})
+}
@@ -0,0 +1,174 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultHealthCheckInterval = 5 * time.Second
+	healthCheckTimeout         = time.Second
+)
+
+// replica 只读从库
+type replica struct {
+	index  int
+	db     *gorm.DB
+	weight int
+	// current 平滑加权轮询的当前权重
+	current int
+	healthy bool
+}
+
+// replicaPool 按权重轮询选取健康的从库，后台定期检查从库是否可用
+type replicaPool struct {
+	mu       sync.Mutex
+	replicas []*replica
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// newReplicaPool 连接所有从库并启动健康检查，运行中不可用的从库暂停轮询，恢复后重新加入
+func newReplicaPool(c *conf.Data_Database) (*replicaPool, error) {
+	p := &replicaPool{
+		interval: c.GetHealthCheckInterval().AsDuration(),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if p.interval <= 0 {
+		p.interval = defaultHealthCheckInterval
+	}
+
+	for i, rc := range c.Replicas {
+		// 从库沿用主库的驱动和连接池配置
+		dc := proto.Clone(c).(*conf.Data_Database)
+		dc.Source, dc.Replicas = rc.Source, nil
+		db, err := NewDB(dc)
+		if err != nil {
+			p.closeReplicas()
+			return nil, err
+		}
+		weight := int(rc.Weight)
+		if weight <= 0 {
+			weight = 1
+		}
+		p.replicas = append(p.replicas, &replica{index: i, db: db, weight: weight})
+	}
+	p.check(context.Background())
+
+	go p.run()
+	return p, nil
+}
+
+// pick 平滑加权轮询选取一个健康的从库，没有健康的从库时返回 nil
+func (p *replicaPool) pick() *gorm.DB {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var best *replica
+	total := 0
+	for _, r := range p.replicas {
+		if !r.healthy {
+			continue
+		}
+		r.current += r.weight
+		total += r.weight
+		if best == nil || r.current > best.current {
+			best = r
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	best.current -= total
+	return best.db
+}
+
+// run 定期检查从库健康状态，直到 close
+func (p *replicaPool) run() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.check(context.Background())
+		}
+	}
+}
+
+// check ping 所有从库并更新健康状态，状态变化时记录日志
+func (p *replicaPool) check(ctx context.Context) {
+	for _, r := range p.replicas {
+		healthy := ping(ctx, r.db) == nil
+
+		p.mu.Lock()
+		changed := healthy != r.healthy
+		r.healthy = healthy
+		if changed {
+			// 重新加入轮询时从零开始累计，避免积压的权重集中打到该从库
+			r.current = 0
+		}
+		p.mu.Unlock()
+
+		if changed && healthy {
+			log.Info(ctx, "replica is healthy.", "replica", r.index)
+		} else if changed {
+			log.Warn(ctx, "replica is unhealthy, reads fall back to other replicas or primary.", "replica", r.index)
+		}
+	}
+}
+
+// ping 检查数据库连接是否可用
+func ping(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// close 停止健康检查并关闭所有从库连接
+func (p *replicaPool) close() {
+	close(p.stop)
+	<-p.done
+	p.closeReplicas()
+}
+
+// closeReplicas 关闭所有从库连接
+func (p *replicaPool) closeReplicas() {
+	for _, r := range p.replicas {
+		if sqlDB, err := r.db.DB(); err == nil {
+			if err := sqlDB.Close(); err != nil {
+				log.Error(nil, "close replica error.", "replica", r.index, "err", err)
+			}
+		}
+	}
+}
+
+// ReadDB 返回只读查询使用的连接：事务中或上下文要求读主库时使用主库，
+// 否则按权重轮询选取健康的从库，未配置从库或没有健康的从库时回退主库
+func (d *Data) ReadDB(ctx context.Context) *gorm.DB {
+	if d.replicas == nil || biz.UsePrimary(ctx) {
+		return d.DB(ctx)
+	}
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return d.DB(ctx)
+	}
+	if db := d.replicas.pick(); db != nil {
+		return db.WithContext(ctx)
+	}
+	return d.DB(ctx)
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// newTestReplicaData 创建带两个 SQLite 从库的 Data，从库权重分别为 2 和 1
+func newTestReplicaData(t *testing.T) *Data {
+	t.Helper()
+	dir := t.TempDir()
+	c := &conf.Data_Database{
+		Driver:       DriverSQLite,
+		Source:       filepath.Join(dir, "primary.db"),
+		MaxOpenConns: 1,
+		Replicas: []*conf.Data_Database_Replica{
+			{Source: filepath.Join(dir, "replica0.db"), Weight: 2},
+			{Source: filepath.Join(dir, "replica1.db")},
+		},
+	}
+	db, err := NewDB(c)
+	if err != nil {
+		t.Fatalf("new sqlite db: %v", err)
+	}
+	replicas, err := newReplicaPool(c)
+	if err != nil {
+		t.Fatalf("new replica pool: %v", err)
+	}
+	t.Cleanup(replicas.close)
+	return &Data{db: db, replicas: replicas}
+}
+
+// servedBy 返回处理查询的从库序号，主库返回 -1
+func servedBy(d *Data, db *gorm.DB) int {
+	for _, r := range d.replicas.replicas {
+		if db.ConnPool == r.db.ConnPool {
+			return r.index
+		}
+	}
+	return -1
+}
+
+func TestData_ReadDB(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("按权重轮询从库", func(t *testing.T) {
+		d := newTestReplicaData(t)
+		counts := map[int]int{}
+		for i := 0; i < 6; i++ {
+			counts[servedBy(d, d.ReadDB(ctx))]++
+		}
+		assert.Equal(t, map[int]int{0: 4, 1: 2}, counts)
+	})
+
+	t.Run("要求读主库或处于事务中时使用主库", func(t *testing.T) {
+		d := newTestReplicaData(t)
+		assert.Equal(t, -1, servedBy(d, d.ReadDB(biz.WithPrimary(ctx))))
+
+		err := NewTxnManager(d).Txn(ctx, func(ctx context.Context) error {
+			assert.Equal(t, -1, servedBy(d, d.ReadDB(ctx)))
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("不可用的从库暂停轮询，全部不可用时回退主库", func(t *testing.T) {
+		d := newTestReplicaData(t)
+		sqlDB, _ := d.replicas.replicas[0].db.DB()
+		sqlDB.Close()
+		d.replicas.check(ctx)
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, 1, servedBy(d, d.ReadDB(ctx)))
+		}
+
+		sqlDB, _ = d.replicas.replicas[1].db.DB()
+		sqlDB.Close()
+		d.replicas.check(ctx)
+		assert.Equal(t, -1, servedBy(d, d.ReadDB(ctx)))
+	})
+}
@@ -0,0 +1,126 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// NewCommentSearcher 根据配置创建评论搜索引擎，未配置时 mysql 驱动使用 FULLTEXT 索引，其他驱动使用 LIKE 匹配
+func NewCommentSearcher(c *conf.Data, data *Data) biz.CommentSearcher {
+	engine := c.GetSearch().GetEngine()
+	if engine == "" {
+		engine = "like"
+		if driver := c.GetDatabase().GetDriver(); driver == DriverMySQL || driver == "" {
+			engine = "mysql"
+		}
+	}
+
+	switch engine {
+	case "mysql":
+		log.Info(nil, "use mysql fulltext comment searcher.")
+		return &mysqlSearcher{data: data}
+	case "like":
+		log.Info(nil, "use like comment searcher.")
+		return &likeSearcher{data: data}
+	default:
+		log.Fatal(nil, "search engine error.", "engine", engine)
+		return nil
+	}
+}
+
+// mysqlSearcher 基于 comment.content 上 ngram 分词的 FULLTEXT 索引搜索评论
+type mysqlSearcher struct {
+	data *Data
+}
+
+// fulltextMatch 自然语言模式匹配，不解析布尔运算符，避免用户输入影响查询语义
+const fulltextMatch = "MATCH(content) AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+func (s *mysqlSearcher) Search(ctx context.Context, q *biz.SearchQuery) ([]*biz.Comment, int64, error) {
+	match := func(db *gorm.DB) *gorm.DB {
+		return db.Where(fulltextMatch, q.Keyword).Scopes(searchFilters(q))
+	}
+	return search(s.data, s.data.ReadDB(ctx), q, match, relevanceDesc, withRelevance(fulltextMatch, q.Keyword))
+}
+
+// withRelevance 把相关度表达式选为 relevance 列，各表按相关度排序，跨表归并时按 relevanceDesc 比较
+func withRelevance(expr string, args ...any) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Select("*, "+expr+" AS relevance", args...)
+	}
+}
+
+// likeSearcher 使用 LIKE 子串匹配搜索评论，不依赖专用索引，适用于所有数据库驱动
+type likeSearcher struct {
+	data *Data
+}
+
+// likeEscaper 转义 LIKE 通配符；转义符使用 '!'，避免反斜杠在各数据库字符串字面量中的含义不同
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+func (s *likeSearcher) Search(ctx context.Context, q *biz.SearchQuery) ([]*biz.Comment, int64, error) {
+	match := func(db *gorm.DB) *gorm.DB {
+		return db.Where("content LIKE ? ESCAPE '!'", "%"+likeEscaper.Replace(q.Keyword)+"%").Scopes(searchFilters(q))
+	}
+	return search(s.data, s.data.ReadDB(ctx), q, match, createDesc)
+}
+
+// searchFilters 搜索的公共过滤条件，软删除的评论即使指定 include_hidden 也不返回
+func searchFilters(q *biz.SearchQuery) func(db *gorm.DB) *gorm.DB {
+	return func(query *gorm.DB) *gorm.DB {
+		query = query.Where("deleted = ?", false)
+		if q.Module > 0 {
+			query = query.Where("module = ?", q.Module)
+		}
+		if q.ResourceID != "" {
+			query = query.Where("resource_id = ?", q.ResourceID)
+		}
+		if q.UserID != "" {
+			query = query.Where("user_id = ?", q.UserID)
+		}
+		if !q.StartTime.IsZero() {
+			query = query.Where("create_gmt >= ?", q.StartTime)
+		}
+		if !q.EndTime.IsZero() {
+			query = query.Where("create_gmt < ?", q.EndTime)
+		}
+		if !q.IncludeHidden {
+			query = query.Where("hidden = ? AND moderation_status = ?", false, biz.ModerationApproved)
+		}
+		return query
+	}
+}
+
+// search 统计总数并按 order 分页查询，scopes 为查询附加的列；
+// 指定资源时只查询资源所在的表，否则在所有评论表和归档表中查询，各表的结果按 order 归并
+func search(d *Data, db *gorm.DB, q *biz.SearchQuery, match func(db *gorm.DB) *gorm.DB, order commentOrder, scopes ...func(db *gorm.DB) *gorm.DB) ([]*biz.Comment, int64, error) {
+	tables := d.commentTables()
+	if q.Module > 0 && q.ResourceID != "" {
+		var err error
+		if tables, err = d.resourceTables(db, q.Module, q.ResourceID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	total, err := d.countComments(db, tables, match)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	offset := (q.Page - 1) * q.PageSize
+	comments, err := d.findComments(db, tables, func(db *gorm.DB) *gorm.DB {
+		return match(db).Scopes(scopes...).Scopes(withRelations)
+	}, order, int(offset), int(q.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	return comments, total, nil
+}
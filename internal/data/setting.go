@@ -0,0 +1,41 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type settingRepo struct {
+	data *Data
+}
+
+// NewSettingRepo .
+func NewSettingRepo(data *Data) biz.SettingRepo {
+	return &settingRepo{
+		data: data,
+	}
+}
+
+func (r *settingRepo) GetResourceSetting(ctx context.Context, module int32, resourceID string) (*biz.ResourceSetting, error) {
+	var setting biz.ResourceSetting
+	err := r.data.DB(ctx).Where("module = ? AND resource_id = ?", module, resourceID).First(&setting).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &setting, nil
+}
+
+// SaveResourceSetting 按 (module, resource_id) 唯一索引插入或覆盖设置
+func (r *settingRepo) SaveResourceSetting(ctx context.Context, setting *biz.ResourceSetting) error {
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "module"}, {Name: "resource_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "max_reply_depth", "moderation_required", "update_gmt"}),
+	}).Create(setting).Error
+}
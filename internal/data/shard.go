@@ -0,0 +1,227 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"errors"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// commentTable 未分片时的评论表，分片时作为分片表的建表模板
+const commentTable = "comment"
+
+// commentShards 评论表分片，按 (module, resource_id) 哈希把同一资源的评论路由到同一张分片表，
+// 回复、子树、点赞等按资源的操作都在单表内完成；提及、附件、点赞等子表不分片，依赖 biz.IDGenerator 生成的评论ID全局唯一
+type commentShards struct {
+	count int
+}
+
+func newCommentShards(c *conf.Data_Sharding) *commentShards {
+	return &commentShards{count: int(c.GetShards())}
+}
+
+// sharded 是否分片，nil 表示不分片
+func (s *commentShards) sharded() bool {
+	return s != nil && s.count > 1
+}
+
+// table 资源的评论所在的表
+func (s *commentShards) table(module int32, resourceID string) string {
+	if !s.sharded() {
+		return commentTable
+	}
+	return s.bucketTable(biz.ResourceBucket(module, resourceID))
+}
+
+// tableOfID 评论所在的表，评论ID的低位是资源分桶，与 table 的路由一致
+func (s *commentShards) tableOfID(id int64) string {
+	if !s.sharded() {
+		return commentTable
+	}
+	return s.bucketTable(biz.IDBucket(id))
+}
+
+// bucketTable 资源分桶所在的分片表
+func (s *commentShards) bucketTable(bucket int64) string {
+	return shardTable(int(bucket % int64(s.count)))
+}
+
+// tables 所有评论表，跨分片查询时依次访问
+func (s *commentShards) tables() []string {
+	if !s.sharded() {
+		return []string{commentTable}
+	}
+	tables := make([]string, s.count)
+	for i := range tables {
+		tables[i] = shardTable(i)
+	}
+	return tables
+}
+
+func shardTable(i int) string {
+	return fmt.Sprintf("%s_%d", commentTable, i)
+}
+
+// locateComment 按ID查找评论所在的表：先查评论ID路由到的表，不存在时查归档表；评论不存在时返回 gorm.ErrRecordNotFound
+func (d *Data) locateComment(db *gorm.DB, id int64) (string, error) {
+	for _, table := range []string{d.shards.tableOfID(id), archiveTable} {
+		var ids []int64
+		if err := db.Table(table).Where("id = ?", id).Limit(1).Pluck("id", &ids).Error; err != nil {
+			return "", err
+		}
+		if len(ids) > 0 {
+			return table, nil
+		}
+	}
+	return "", gorm.ErrRecordNotFound
+}
+
+// commentOrder 跨分片或归档表归并评论时的排序，与各表查询的 order 一致
+type commentOrder struct {
+	order string
+	less  func(a, b *biz.Comment) bool
+}
+
+var (
+	// createDesc 按创建时间降序，时间相同按ID降序
+	createDesc = commentOrder{
+		order: "create_gmt DESC, id DESC",
+		less: func(a, b *biz.Comment) bool {
+			if !a.CreateGmt.Equal(b.CreateGmt) {
+				return a.CreateGmt.After(b.CreateGmt)
+			}
+			return a.ID > b.ID
+		},
+	}
+	// likeDesc 按点赞数降序，点赞数相同按创建时间降序
+	likeDesc = commentOrder{
+		order: "like_count DESC, create_gmt DESC, id DESC",
+		less: func(a, b *biz.Comment) bool {
+			if a.LikeCount != b.LikeCount {
+				return a.LikeCount > b.LikeCount
+			}
+			return createDesc.less(a, b)
+		},
+	}
+	// createAsc 按创建时间升序，时间相同按ID升序
+	createAsc = commentOrder{
+		order: "create_gmt ASC, id ASC",
+		less: func(a, b *biz.Comment) bool {
+			if !a.CreateGmt.Equal(b.CreateGmt) {
+				return a.CreateGmt.Before(b.CreateGmt)
+			}
+			return a.ID < b.ID
+		},
+	}
+	// relevanceDesc 按搜索相关度降序，相关度相同按创建时间降序；查询需选出 relevance 列
+	relevanceDesc = commentOrder{
+		order: "relevance DESC, create_gmt DESC, id DESC",
+		less: func(a, b *biz.Comment) bool {
+			if a.Relevance != b.Relevance {
+				return a.Relevance > b.Relevance
+			}
+			return createDesc.less(a, b)
+		},
+	}
+	// idAsc 按ID升序，用于按ID游标分批读取
+	idAsc = commentOrder{
+		order: "id ASC",
+		less: func(a, b *biz.Comment) bool {
+			return a.ID < b.ID
+		},
+	}
+)
+
+// findComments 在 tables 上执行 query，按 order 排序后跳过 offset 条返回至多 limit 条；
+// 只有一张表时直接分页查询，否则每张表取前 offset+limit 条再按 order 归并
+func (d *Data) findComments(db *gorm.DB, tables []string, query func(db *gorm.DB) *gorm.DB, order commentOrder, offset, limit int) ([]*biz.Comment, error) {
+	if len(tables) == 1 {
+		var comments []*biz.Comment
+		err := query(db.Table(tables[0])).Order(order.order).Offset(offset).Limit(limit).Find(&comments).Error
+		return comments, err
+	}
+
+	var merged []*biz.Comment
+	for _, table := range tables {
+		var comments []*biz.Comment
+		if err := query(db.Table(table)).Order(order.order).Limit(offset + limit).Find(&comments).Error; err != nil {
+			return nil, err
+		}
+		merged = append(merged, comments...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return order.less(merged[i], merged[j]) })
+	if offset >= len(merged) {
+		return nil, nil
+	}
+	merged = merged[offset:]
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged, nil
+}
+
+// countComments 统计 tables 中满足 query 的评论数
+func (d *Data) countComments(db *gorm.DB, tables []string, query func(db *gorm.DB) *gorm.DB) (int64, error) {
+	var total int64
+	for _, table := range tables {
+		var count int64
+		if err := query(db.Table(table)).Count(&count).Error; err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// listCommentsByIDs 按ID查询评论，不保证顺序：先在评论ID路由到的表中查询，不存在的再查归档表
+func (d *Data) listCommentsByIDs(db *gorm.DB, ids []int64, scopes ...func(db *gorm.DB) *gorm.DB) ([]*biz.Comment, error) {
+	byTable := make(map[string][]int64)
+	for _, id := range ids {
+		table := d.shards.tableOfID(id)
+		byTable[table] = append(byTable[table], id)
+	}
+
+	var all []*biz.Comment
+	found := make(map[int64]bool, len(ids))
+	for table, tableIDs := range byTable {
+		var comments []*biz.Comment
+		if err := db.Table(table).Scopes(scopes...).Where("id IN ?", tableIDs).Find(&comments).Error; err != nil {
+			return nil, err
+		}
+		for _, c := range comments {
+			found[c.ID] = true
+		}
+		all = append(all, comments...)
+	}
+
+	var missing []int64
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return all, nil
+	}
+	var archived []*biz.Comment
+	if err := db.Table(archiveTable).Scopes(scopes...).Where("id IN ?", missing).Find(&archived).Error; err != nil {
+		return nil, err
+	}
+	return append(all, archived...), nil
+}
+
+// ignoreNotFound 评论不存在时视为成功
+func ignoreNotFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
+
+// withRelations 预加载提及和附件
+func withRelations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Mentions").Preload("Attachments", orderAttachments)
+}
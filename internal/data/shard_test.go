@@ -0,0 +1,145 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestShardedData 创建评论表分为 shards 张分片表的 SQLite Data
+func newTestShardedData(t *testing.T, shards int) *Data {
+	t.Helper()
+	data := newTestData(t)
+	data.shards = &commentShards{count: shards}
+	m := &Migrator{db: data.db, shards: data.shards}
+	if _, err := m.CreateCommentTables(context.Background()); err != nil {
+		t.Fatalf("create shards: %v", err)
+	}
+	return data
+}
+
+// resourcesOnDistinctShards 返回落在不同分片表上的两个资源
+func resourcesOnDistinctShards(shards *commentShards) (string, string) {
+	first := "r0"
+	for i := 1; ; i++ {
+		if r := fmt.Sprintf("r%d", i); shards.table(1, r) != shards.table(1, first) {
+			return first, r
+		}
+	}
+}
+
+func TestCommentShards_Table(t *testing.T) {
+	var unsharded *commentShards
+	assert.Equal(t, "comment", unsharded.table(1, "r1"))
+	assert.Equal(t, []string{"comment"}, (&commentShards{count: 1}).tables())
+
+	shards := &commentShards{count: 4}
+	assert.Equal(t, shards.table(1, "r1"), shards.table(1, "r1"))
+	assert.Contains(t, shards.tables(), shards.table(2, "r1"))
+	assert.Len(t, shards.tables(), 4)
+}
+
+func TestMigrator_CreateCommentTables(t *testing.T) {
+	data := newTestShardedData(t, 3)
+	for _, table := range data.commentTables() {
+		assert.True(t, data.db.Migrator().HasTable(table), table)
+		assert.True(t, data.db.Migrator().HasIndex(table, table+"_idx_path"), table)
+	}
+
+	// 已存在的分片表不重复创建
+	created, err := (&Migrator{db: data.db, shards: data.shards}).CreateCommentTables(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, created)
+}
+
+func TestCommentRepo_Sharded(t *testing.T) {
+	data := newTestShardedData(t, 3)
+	repo := newTestCommentRepo(t, data)
+	ctx := context.Background()
+	r1, r2 := resourcesOnDistinctShards(data.shards)
+
+	save := func(c *biz.Comment) *biz.Comment {
+		t.Helper()
+		c.Module = 1
+		saved, err := repo.Save(ctx, c)
+		if err != nil {
+			t.Fatalf("save comment: %v", err)
+		}
+		return saved
+	}
+	root1 := save(&biz.Comment{ResourceID: r1, UserID: "u1", Content: "a", CreateGmt: time.Now().Add(-3 * time.Minute)})
+	root2 := save(&biz.Comment{ResourceID: r2, UserID: "u1", Content: "b", CreateGmt: time.Now().Add(-2 * time.Minute)})
+	reply := save(&biz.Comment{ResourceID: r1, UserID: "u1", Content: "c", ParentCommentID: root1.ID, RootCommentID: root1.ID, Level: 1, CreateGmt: time.Now().Add(-time.Minute)})
+
+	t.Run("评论写入资源所在的分片表，ID全局唯一", func(t *testing.T) {
+		assert.NotEqual(t, root1.ID, root2.ID)
+		var count int64
+		data.db.Table(data.shards.table(1, r1)).Count(&count)
+		assert.Equal(t, int64(2), count)
+		data.db.Table(data.shards.table(1, r2)).Count(&count)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("评论ID路由到资源所在的分片表", func(t *testing.T) {
+		assert.Equal(t, data.shards.table(1, r1), data.shards.tableOfID(root1.ID))
+		assert.Equal(t, data.shards.table(1, r1), data.shards.tableOfID(reply.ID))
+		assert.Equal(t, data.shards.table(1, r2), data.shards.tableOfID(root2.ID))
+
+		table, err := data.locateComment(data.db, reply.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, data.shards.table(1, r1), table)
+	})
+
+	t.Run("按资源查询路由到分片表", func(t *testing.T) {
+		roots, err := repo.ListRootComments(ctx, 1, r1, 1, 10, 1, nil)
+		assert.NoError(t, err)
+		if assert.Len(t, roots, 1) {
+			assert.Equal(t, root1.ID, roots[0].ID)
+			assert.Equal(t, int64(1), roots[0].ReplyCount)
+		}
+
+		replies, err := repo.ListReplyComments(ctx, 1, r1, []int64{root1.ID}, 10, 1, nil)
+		assert.NoError(t, err)
+		assert.Len(t, replies, 1)
+	})
+
+	t.Run("按ID查询定位分片表", func(t *testing.T) {
+		got, err := repo.Get(ctx, root2.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, r2, got.ResourceID)
+
+		count, err := repo.LikeComment(ctx, root2.ID, "u2")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		byIDs, err := repo.ListByIDs(ctx, []int64{root1.ID, root2.ID})
+		assert.NoError(t, err)
+		assert.Len(t, byIDs, 2)
+	})
+
+	t.Run("用户评论历史跨分片归并", func(t *testing.T) {
+		page, err := repo.ListUserComments(ctx, &biz.UserCommentQuery{UserID: "u1", Limit: 2})
+		assert.NoError(t, err)
+		if assert.Len(t, page, 2) {
+			assert.Equal(t, []int64{reply.ID, root2.ID}, []int64{page[0].ID, page[1].ID})
+		}
+
+		next, err := repo.ListUserComments(ctx, &biz.UserCommentQuery{UserID: "u1", Limit: 2, After: &biz.CommentCursor{CreateGmt: page[1].CreateGmt, ID: page[1].ID}})
+		assert.NoError(t, err)
+		if assert.Len(t, next, 1) {
+			assert.Equal(t, root1.ID, next[0].ID)
+		}
+	})
+
+	t.Run("删除子树在分片表内完成", func(t *testing.T) {
+		assert.NoError(t, repo.DeleteBatch(ctx, root1.ID))
+		_, err := repo.Get(ctx, reply.ID)
+		assert.Error(t, err)
+		_, err = repo.Get(ctx, root2.ID)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,46 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey 上下文中保存事务的键
+type txKey struct{}
+
+type txnManager struct {
+	data *Data
+}
+
+// NewTxnManager .
+func NewTxnManager(data *Data) biz.TxnManager {
+	return &txnManager{
+		data: data,
+	}
+}
+
+// Txn 开启事务执行 fn，事务保存在传给 fn 的上下文中，仓储方法通过该上下文加入同一事务；
+// 上下文中已有事务时直接加入，由最外层的 Txn 提交或回滚
+func (m *txnManager) Txn(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.data.transaction(ctx, func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// DB 返回上下文中的事务，没有事务时返回普通连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return d.db.WithContext(ctx)
+}
+
+// transaction 在事务中执行 fn：上下文中已有事务时加入该事务，否则开启新事务，fn 返回错误或 panic 时回滚
+func (d *Data) transaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(tx.WithContext(ctx))
+	}
+	return d.db.WithContext(ctx).Transaction(fn)
+}
@@ -0,0 +1,99 @@
+package data
+
+import (
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"comment/pkg/log"
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// NewWatchBroker 根据配置创建评论变更广播后端，默认仅在本实例内广播
+func NewWatchBroker(c *conf.Data, data *Data) biz.WatchBroker {
+	wc := c.GetWatch()
+	switch wc.GetBackend() {
+	case "redis":
+		if data.rdb == nil {
+			log.Fatal(nil, "watch backend redis requires redis config.")
+			return nil
+		}
+		channel := wc.GetChannel()
+		if channel == "" {
+			channel = "comment:watch"
+		}
+		log.Info(nil, "use redis watch broker.", "channel", channel)
+		return &redisWatchBroker{data: data, channel: channel}
+	case "memory", "":
+		log.Info(nil, "use memory watch broker.")
+		return newMemoryWatchBroker()
+	default:
+		log.Fatal(nil, "watch backend error.", "backend", wc.GetBackend())
+		return nil
+	}
+}
+
+// memoryWatchBroker 进程内广播，适用于单实例部署
+type memoryWatchBroker struct {
+	mu       sync.RWMutex
+	handlers []func(change *biz.CommentChange)
+}
+
+func newMemoryWatchBroker() *memoryWatchBroker {
+	return &memoryWatchBroker{}
+}
+
+func (b *memoryWatchBroker) Publish(ctx context.Context, change *biz.CommentChange) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, handler := range b.handlers {
+		handler(change)
+	}
+	return nil
+}
+
+func (b *memoryWatchBroker) Subscribe(ctx context.Context, handler func(change *biz.CommentChange)) error {
+	b.mu.Lock()
+	b.handlers = append(b.handlers, handler)
+	b.mu.Unlock()
+
+	<-ctx.Done()
+	return nil
+}
+
+// redisWatchBroker 通过 Redis pub/sub 在实例间广播，每个实例都会收到全部变更再各自分发
+type redisWatchBroker struct {
+	data    *Data
+	channel string
+}
+
+func (b *redisWatchBroker) Publish(ctx context.Context, change *biz.CommentChange) error {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	return b.data.rdb.Publish(ctx, b.channel, payload).Err()
+}
+
+func (b *redisWatchBroker) Subscribe(ctx context.Context, handler func(change *biz.CommentChange)) error {
+	sub := b.data.rdb.Subscribe(ctx, b.channel)
+	defer sub.Close()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var change biz.CommentChange
+			if err := json.Unmarshal([]byte(msg.Payload), &change); err != nil {
+				log.Error(ctx, "unmarshal comment change error.", "err", err)
+				continue
+			}
+			handler(&change)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"bytes"
+	"comment/internal/biz"
+	"comment/internal/conf"
+	"context"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookRepo struct {
+	data *Data
+}
+
+// NewWebhookRepo .
+func NewWebhookRepo(data *Data) biz.WebhookRepo {
+	return &webhookRepo{
+		data: data,
+	}
+}
+
+func (r *webhookRepo) CreateSubscription(ctx context.Context, s *biz.WebhookSubscription) (*biz.WebhookSubscription, error) {
+	if err := r.data.DB(ctx).Create(s).Error; err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpsertSubscription 按名称创建或更新订阅
+func (r *webhookRepo) UpsertSubscription(ctx context.Context, s *biz.WebhookSubscription) error {
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"module", "event_types", "url", "secret", "enabled", "source", "update_gmt"}),
+	}).Create(s).Error
+}
+
+// DeleteSubscription 删除订阅，并将其待投递记录转入死信
+func (r *webhookRepo) DeleteSubscription(ctx context.Context, id int64) error {
+	return r.data.transaction(ctx, func(tx *gorm.DB) error {
+		if err := tx.Model(&biz.WebhookDelivery{}).
+			Where("subscription_id = ? AND status = ?", id, biz.WebhookDeliveryPending).
+			Updates(map[string]interface{}{
+				"status":     biz.WebhookDeliveryDead,
+				"last_error": "subscription deleted",
+				"update_gmt": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", id).Delete(&biz.WebhookSubscription{}).Error
+	})
+}
+
+func (r *webhookRepo) ListSubscriptions(ctx context.Context, module int32) ([]*biz.WebhookSubscription, error) {
+	var subscriptions []*biz.WebhookSubscription
+	query := r.data.DB(ctx).Model(&biz.WebhookSubscription{})
+	if module > 0 {
+		query = query.Where("module = ?", module)
+	}
+	if err := query.Order("id ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// ListSubscriptionsForModule 获取关注指定模块的已启用订阅
+func (r *webhookRepo) ListSubscriptionsForModule(ctx context.Context, module int32) ([]*biz.WebhookSubscription, error) {
+	var subscriptions []*biz.WebhookSubscription
+	err := r.data.DB(ctx).
+		Where("module IN ? AND enabled = ?", []int32{0, module}, true).
+		Order("id ASC").Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *webhookRepo) GetSubscription(ctx context.Context, id int64) (*biz.WebhookSubscription, error) {
+	var s biz.WebhookSubscription
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&s).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// CreateDeliveries 创建投递记录，事件重复投递时依赖唯一索引忽略已存在的记录
+func (r *webhookRepo) CreateDeliveries(ctx context.Context, deliveries []*biz.WebhookDelivery) error {
+	return r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+func (r *webhookRepo) ListDueDeliveries(ctx context.Context, limit int) ([]*biz.WebhookDelivery, error) {
+	var deliveries []*biz.WebhookDelivery
+	err := r.data.DB(ctx).
+		Where("status = ? AND next_retry_gmt <= ?", biz.WebhookDeliveryPending, time.Now()).
+		Order("id ASC").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *webhookRepo) GetDelivery(ctx context.Context, id int64) (*biz.WebhookDelivery, error) {
+	var d biz.WebhookDelivery
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&d).Error; err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r *webhookRepo) UpdateDelivery(ctx context.Context, d *biz.WebhookDelivery) error {
+	return r.data.DB(ctx).Model(&biz.WebhookDelivery{}).Where("id = ?", d.ID).
+		Updates(map[string]interface{}{
+			"status":           d.Status,
+			"attempts":         d.Attempts,
+			"last_status_code": d.LastStatusCode,
+			"last_error":       d.LastError,
+			"next_retry_gmt":   d.NextRetryGmt,
+			"update_gmt":       time.Now(),
+		}).Error
+}
+
+// ListDeliveries 查询投递记录，按创建时间降序
+func (r *webhookRepo) ListDeliveries(ctx context.Context, filter *biz.WebhookDeliveryFilter) ([]*biz.WebhookDelivery, error) {
+	var deliveries []*biz.WebhookDelivery
+
+	// 计算偏移量
+	offset := (filter.Page - 1) * filter.PageSize
+
+	query := r.data.DB(ctx).Model(&biz.WebhookDelivery{})
+	if filter.SubscriptionID > 0 {
+		query = query.Where("subscription_id = ?", filter.SubscriptionID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	err := query.Order("id DESC").Limit(int(filter.PageSize)).Offset(int(offset)).Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+type webhookClient struct {
+	client *http.Client
+}
+
+// NewWebhookClient .
+func NewWebhookClient(c *conf.Data) biz.WebhookClient {
+	timeout := 3 * time.Second
+	if wc := c.GetWebhook(); wc != nil && wc.Timeout != nil && wc.Timeout.AsDuration() > 0 {
+		timeout = wc.Timeout.AsDuration()
+	}
+	return &webhookClient{client: &http.Client{Timeout: timeout}}
+}
+
+// Post 发送 POST 请求，返回响应状态码
+func (c *webhookClient) Post(ctx context.Context, url string, header map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"comment/internal/biz"
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// ReadPrimaryHeader 请求头为 true 时该请求的查询走主库，客户端写入后立即读取时携带，避免读到从库复制延迟前的数据
+const ReadPrimaryHeader = "X-Read-Primary"
+
+// ReadPrimary 创建读主库中间件，HTTP 与 gRPC 请求都从请求头（gRPC 为 metadata）读取 X-Read-Primary
+func ReadPrimary() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if primary, _ := strconv.ParseBool(tr.RequestHeader().Get(ReadPrimaryHeader)); primary {
+					ctx = biz.WithPrimary(ctx)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
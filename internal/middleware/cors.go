@@ -46,6 +46,8 @@ var DefaultCORSOptions = CORSOptions{
 		"X-Requested-With",
 		"Accept",
 		"X-CSRF-Token",
+		"Idempotency-Key",
+		ReadPrimaryHeader,
 	},
 	AllowCredentials: true,
 	MaxAge:           86400,
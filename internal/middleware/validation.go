@@ -12,8 +12,44 @@ type validator interface {
 	Validate() error
 }
 
+// ModuleValidator 按业务模块规则校验请求参数，模块未注册、内容过长或层级过深时返回错误
+type ModuleValidator interface {
+	ValidateModule(module int32, content string, level int32) error
+}
+
+// 携带业务模块、评论内容和评论层级的请求，生成的请求结构体通过 Get 方法满足这些接口
+type (
+	moduleRequest interface {
+		GetModule() int32
+	}
+	contentRequest interface {
+		GetContent() string
+	}
+	levelRequest interface {
+		GetLevel() int32
+	}
+)
+
+// ValidationOption 校验中间件选项
+type ValidationOption func(*validationOptions)
+
+type validationOptions struct {
+	modules ModuleValidator
+}
+
+// WithModules 按业务模块规则校验携带 module 字段的请求
+func WithModules(modules ModuleValidator) ValidationOption {
+	return func(o *validationOptions) {
+		o.modules = modules
+	}
+}
+
 // Validation 是一个中间件，用于自动校验请求参数
-func Validation() middleware.Middleware {
+func Validation(opts ...ValidationOption) middleware.Middleware {
+	o := &validationOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			// 检查请求参数是否实现了 validator 接口
@@ -25,8 +61,31 @@ func Validation() middleware.Middleware {
 					return nil, errors.BadRequest("INVALID_ARGUMENT", err.Error())
 				}
 			}
+			// 按业务模块规则校验，module 为 0 表示不按模块过滤的请求，不做校验
+			if o.modules != nil {
+				if err := validateModule(o.modules, req); err != nil {
+					return nil, err
+				}
+			}
 			// 校验通过或请求参数没有实现 validator 接口，则继续执行下一个处理函数
 			return handler(ctx, req)
 		}
 	}
 }
+
+// validateModule 提取请求中的业务模块、评论内容和评论层级，按模块规则校验
+func validateModule(modules ModuleValidator, req interface{}) error {
+	m, ok := req.(moduleRequest)
+	if !ok || m.GetModule() == 0 {
+		return nil
+	}
+	var content string
+	if c, ok := req.(contentRequest); ok {
+		content = c.GetContent()
+	}
+	var level int32
+	if l, ok := req.(levelRequest); ok {
+		level = l.GetLevel()
+	}
+	return modules.ValidateModule(m.GetModule(), content, level)
+}
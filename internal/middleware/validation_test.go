@@ -245,3 +245,63 @@ func TestValidationMiddlewareWithRPC(t *testing.T) {
 		assert.False(t, called, "Next handler should not be called when validation fails")
 	})
 }
+
+// moduleRequestStub 携带业务模块、评论内容和评论层级的请求
+type moduleRequestStub struct {
+	Module  int32
+	Content string
+	Level   int32
+}
+
+func (r *moduleRequestStub) GetModule() int32   { return r.Module }
+func (r *moduleRequestStub) GetContent() string { return r.Content }
+func (r *moduleRequestStub) GetLevel() int32    { return r.Level }
+
+// moduleValidatorStub 只允许模块 1，内容不超过 5 个字节，层级不超过 2
+type moduleValidatorStub struct{}
+
+func (moduleValidatorStub) ValidateModule(module int32, content string, level int32) error {
+	if module != 1 {
+		return kratoserrors.BadRequest("UNKNOWN_MODULE", "unknown module.")
+	}
+	if len(content) > 5 {
+		return kratoserrors.BadRequest("CONTENT_TOO_LONG", "content too long.")
+	}
+	if level > 2 {
+		return kratoserrors.BadRequest("REPLY_DEPTH_EXCEEDED", "reply depth exceeded.")
+	}
+	return nil
+}
+
+func TestValidationMiddlewareWithModules(t *testing.T) {
+	handler := middleware.Handler(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &MockReply{Success: true}, nil
+	})
+	handlerWithMw := Validation(WithModules(moduleValidatorStub{}))(handler)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		req        interface{}
+		wantReason string
+	}{
+		{name: "校验通过", req: &moduleRequestStub{Module: 1, Content: "hi", Level: 1}},
+		{name: "模块为0不校验", req: &moduleRequestStub{Module: 0, Content: "too long content"}},
+		{name: "未携带模块的请求不校验", req: &validRequest{Field: "valid"}},
+		{name: "未注册的模块", req: &moduleRequestStub{Module: 2}, wantReason: "UNKNOWN_MODULE"},
+		{name: "内容过长", req: &moduleRequestStub{Module: 1, Content: "too long"}, wantReason: "CONTENT_TOO_LONG"},
+		{name: "层级过深", req: &moduleRequestStub{Module: 1, Level: 3}, wantReason: "REPLY_DEPTH_EXCEEDED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply, err := handlerWithMw(ctx, tt.req)
+			if tt.wantReason == "" {
+				assert.NoError(t, err)
+				assert.NotNil(t, reply)
+				return
+			}
+			assert.Nil(t, reply)
+			assert.Equal(t, tt.wantReason, kratoserrors.Reason(err))
+		})
+	}
+}
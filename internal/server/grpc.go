@@ -2,6 +2,7 @@ package server
 
 import (
 	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
 	"comment/internal/conf"
 	"comment/internal/middleware"
 	"comment/internal/service"
@@ -15,15 +16,17 @@ import (
 //
 //	c - 服务器配置，包含 gRPC 相关设置
 //	comment - 评论服务实现实例
+//	modules - 业务模块注册表，用于按模块规则校验请求
 //
 // 返回：
 //
 //	配置好的 gRPC 服务器实例
-func NewGRPCServer(c *conf.Server, comment *service.CommentService) *grpc.Server {
+func NewGRPCServer(c *conf.Server, comment *service.CommentService, modules *biz.ModuleRegistry) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
-			middleware.Validation(),
+			middleware.ReadPrimary(),
+			middleware.Validation(middleware.WithModules(modules)),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -2,6 +2,7 @@ package server
 
 import (
 	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
 	"comment/internal/conf"
 	"comment/internal/middleware"
 	"comment/internal/service"
@@ -11,13 +12,16 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, comment *service.CommentService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, comment *service.CommentService, modules *biz.ModuleRegistry, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			middleware.CORS(),
-			middleware.Validation(),
+			middleware.ReadPrimary(),
+			middleware.Validation(middleware.WithModules(modules)),
 		),
+		// SSE 订阅是长连接，在路由超时之前拦截
+		http.Filter(comment.WatchFilter),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
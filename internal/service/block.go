@@ -0,0 +1,39 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	v1 "comment/api/comment/v1"
+)
+
+// BlockUser 实现拉黑用户接口
+// ctx - 请求上下文
+// in - 拉黑请求参数
+// 返回 - 操作结果和可能的错误
+func (s *CommentService) BlockUser(ctx context.Context, in *v1.BlockUserRequest) (*v1.BlockUserResponse, error) {
+	log.Info(ctx, "block user")
+	log.Debug(ctx, "BlockUser", "user_id", in.UserId, "blocked_user_id", in.BlockedUserId)
+
+	if err := s.uc.BlockUser(ctx, in.UserId, in.BlockedUserId); err != nil {
+		log.Error(ctx, "block user failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "block user successful.")
+	return &v1.BlockUserResponse{Success: true}, nil
+}
+
+// UnblockUser 实现取消拉黑用户接口
+func (s *CommentService) UnblockUser(ctx context.Context, in *v1.UnblockUserRequest) (*v1.BlockUserResponse, error) {
+	log.Info(ctx, "unblock user")
+	log.Debug(ctx, "UnblockUser", "user_id", in.UserId, "blocked_user_id", in.BlockedUserId)
+
+	if err := s.uc.UnblockUser(ctx, in.UserId, in.BlockedUserId); err != nil {
+		log.Error(ctx, "unblock user failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "unblock user successful.")
+	return &v1.BlockUserResponse{Success: true}, nil
+}
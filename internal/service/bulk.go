@@ -0,0 +1,67 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BulkDeleteComments 实现按用户或资源批量删除评论接口
+// ctx - 请求上下文
+// in - 批量删除请求参数
+// 返回 - 创建的批量删除任务和可能的错误
+func (s *CommentService) BulkDeleteComments(ctx context.Context, in *v1.BulkDeleteCommentsRequest) (*v1.BulkDeleteJob, error) {
+	log.Info(ctx, "bulk delete comments")
+	log.Debug(ctx, "BulkDeleteComments", "user_id", in.UserId, "module", in.Module, "resource_id", in.ResourceId, "mode", in.Mode)
+
+	job, err := s.bulk.SubmitBulkDelete(ctx, &biz.BulkDeleteJob{
+		UserID:     in.UserId,
+		Module:     in.Module,
+		ResourceID: in.ResourceId,
+		Mode:       int32(in.Mode),
+	})
+	if err != nil {
+		log.Error(ctx, "bulk delete comments failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "bulk delete comments successful.")
+	return s.convertToAPIBulkDeleteJob(job), nil
+}
+
+// GetBulkDeleteJob 实现查询批量删除任务进度接口
+func (s *CommentService) GetBulkDeleteJob(ctx context.Context, in *v1.GetBulkDeleteJobRequest) (*v1.BulkDeleteJob, error) {
+	log.Info(ctx, "get bulk delete job")
+	log.Debug(ctx, "GetBulkDeleteJob", "id", in.Id)
+
+	job, err := s.bulk.GetBulkDeleteJob(ctx, in.Id)
+	if err != nil {
+		log.Error(ctx, "get bulk delete job failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "get bulk delete job successful.")
+	return s.convertToAPIBulkDeleteJob(job), nil
+}
+
+// convertToAPIBulkDeleteJob 将biz.BulkDeleteJob转换为v1.BulkDeleteJob
+func (s *CommentService) convertToAPIBulkDeleteJob(job *biz.BulkDeleteJob) *v1.BulkDeleteJob {
+	return &v1.BulkDeleteJob{
+		Id:         job.ID,
+		UserId:     job.UserID,
+		Module:     job.Module,
+		ResourceId: job.ResourceID,
+		Mode:       v1.BulkDeleteJob_Mode(job.Mode),
+		Status:     v1.BulkDeleteJob_Status(job.Status),
+		Total:      job.Total,
+		Processed:  job.Processed,
+		Affected:   job.Affected,
+		Error:      job.Error,
+		CreateTime: timestamppb.New(job.CreateGmt),
+		UpdateTime: timestamppb.New(job.UpdateGmt),
+	}
+}
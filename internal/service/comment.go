@@ -3,23 +3,37 @@ package service
 import (
 	"comment/pkg/log"
 	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"time"
 
 	v1 "comment/api/comment/v1"
 	"comment/internal/biz"
 )
 
+// IdempotencyKeyHeader 创建评论时传递幂等键的请求头
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLen 幂等键最大长度，与 CreateCommentRequest.idempotency_key 的校验规则一致
+const maxIdempotencyKeyLen = 128
+
 // CommentService is a comment service.
 type CommentService struct {
 	v1.UnimplementedCommentServiceServer
 
-	uc *biz.CommentUsecase
+	uc      *biz.CommentUsecase
+	webhook *biz.WebhookUsecase
+	hub     *biz.WatchHub
+	search  *biz.SearchUsecase
+	bulk    *biz.BulkDeleteUsecase
+	export  *biz.ExportUsecase
 }
 
 // NewCommentService new a comment service.
-func NewCommentService(uc *biz.CommentUsecase) *CommentService {
-	return &CommentService{uc: uc}
+func NewCommentService(uc *biz.CommentUsecase, webhook *biz.WebhookUsecase, hub *biz.WatchHub, search *biz.SearchUsecase, bulk *biz.BulkDeleteUsecase, export *biz.ExportUsecase) *CommentService {
+	return &CommentService{uc: uc, webhook: webhook, hub: hub, search: search, bulk: bulk, export: export}
 }
 
 // CreateComment 实现评论创建接口
@@ -39,6 +53,7 @@ func (s *CommentService) CreateComment(ctx context.Context, in *v1.CreateComment
 		Username:        in.Username,
 		Avatar:          in.Avatar,
 		Content:         in.Content,
+		Attachments:     s.convertToBizAttachments(in.Attachments),
 		Level:           in.Level,
 		LikeCount:       0,
 		ReplyCount:      0,
@@ -46,8 +61,20 @@ func (s *CommentService) CreateComment(ctx context.Context, in *v1.CreateComment
 		UpdateGmt:       time.Now().UTC(),
 	}
 
+	// 幂等键优先取请求字段，其次取 Idempotency-Key 请求头或 gRPC metadata
+	idempotencyKey := in.IdempotencyKey
+	if idempotencyKey == "" {
+		if tr, ok := transport.FromServerContext(ctx); ok {
+			idempotencyKey = tr.RequestHeader().Get(IdempotencyKeyHeader)
+		}
+	}
+	idempotencyKey = strings.TrimSpace(idempotencyKey)
+	if len(idempotencyKey) > maxIdempotencyKeyLen {
+		return nil, errors.BadRequest("INVALID_ARGUMENT", "idempotency key too long.")
+	}
+
 	// 调用业务层创建评论
-	createdComment, err := s.uc.CreateComment(ctx, comment)
+	createdComment, err := s.uc.CreateComment(ctx, comment, idempotencyKey)
 	if err != nil {
 		log.Error(ctx, "create comment failed.", "error", err)
 		return nil, err
@@ -64,7 +91,7 @@ func (s *CommentService) CreateComment(ctx context.Context, in *v1.CreateComment
 // 返回 - 评论信息树和可能的错误
 func (s *CommentService) GetComment(ctx context.Context, in *v1.GetCommentRequest) (*v1.CommentTree, error) {
 	log.Info(ctx, "get comment")
-	log.Debug(ctx, "GetComment", "module", in.Module, "resource_id", in.ResourceId, "max_depth", in.MaxDepth)
+	log.Debug(ctx, "GetComment", "module", in.Module, "resource_id", in.ResourceId, "max_depth", in.MaxDepth, "viewer_id", in.ViewerId)
 
 	// 设置默认值
 	page := in.GetPage()
@@ -77,8 +104,14 @@ func (s *CommentService) GetComment(ctx context.Context, in *v1.GetCommentReques
 		pageSize = 10
 	}
 
+	// 未指定排序时由业务层使用模块的默认排序
+	sortType := biz.SortUnspecified
+	if in.SortType != nil {
+		sortType = int32(in.GetSortType())
+	}
+
 	// 调用业务层获取评论
-	comments, err := s.uc.GetComments(ctx, in.Module, in.ResourceId, in.MaxDepth, page, pageSize, int32(in.GetSortType()))
+	comments, err := s.uc.GetComments(ctx, in.Module, in.ResourceId, in.MaxDepth, page, pageSize, sortType, in.ViewerId)
 	if err != nil {
 		log.Error(ctx, "get comments failed.", "error", err)
 		return nil, err
@@ -109,19 +142,89 @@ func (s *CommentService) convertToAPIComment(comment *biz.Comment) *v1.Comment {
 	}
 
 	return &v1.Comment{
-		Module:        comment.Module,
-		ResourceId:    comment.ResourceID,
-		CommentId:     comment.ID,
-		UserId:        comment.UserID,
-		Username:      comment.Username,
-		Avatar:        comment.Avatar,
-		Content:       comment.Content,
-		Level:         comment.Level,
-		LikeCount:     comment.LikeCount,
-		ReplyCount:    comment.ReplyCount,
-		ReplyComments: replyComments,
-		CreateTime:    timestamppb.New(comment.CreateGmt),
+		Module:           comment.Module,
+		ResourceId:       comment.ResourceID,
+		CommentId:        comment.ID,
+		UserId:           comment.UserID,
+		Username:         comment.Username,
+		Avatar:           comment.Avatar,
+		Content:          comment.Content,
+		ContentHtml:      comment.ContentHTML,
+		ContentText:      comment.ContentText,
+		Level:            comment.Level,
+		LikeCount:        comment.LikeCount,
+		ReplyCount:       comment.ReplyCount,
+		ReplyComments:    replyComments,
+		CreateTime:       timestamppb.New(comment.CreateGmt),
+		Mentions:         s.convertToAPIMentions(comment.Mentions),
+		Attachments:      s.convertToAPIAttachments(comment.Attachments),
+		Flagged:          comment.Flagged,
+		Hidden:           comment.Hidden,
+		ModerationStatus: v1.Comment_ModerationStatus(comment.ModerationStatus),
+	}
+}
+
+// convertToAPIMentions 将biz.Mention转换为v1.Mention
+func (s *CommentService) convertToAPIMentions(mentions []*biz.Mention) []*v1.Mention {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	apiMentions := make([]*v1.Mention, len(mentions))
+	for i, mention := range mentions {
+		apiMentions[i] = &v1.Mention{
+			UserId: mention.UserID,
+			Name:   mention.Name,
+			Offset: mention.Offset,
+			Length: mention.Length,
+		}
 	}
+	return apiMentions
+}
+
+// convertToAPIAttachments 将biz.Attachment转换为v1.Attachment
+func (s *CommentService) convertToAPIAttachments(attachments []*biz.Attachment) []*v1.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	apiAttachments := make([]*v1.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		apiAttachments[i] = &v1.Attachment{
+			Type:         v1.Attachment_Type(attachment.Type),
+			Url:          attachment.URL,
+			Width:        attachment.Width,
+			Height:       attachment.Height,
+			Title:        attachment.Title,
+			Description:  attachment.Description,
+			ThumbnailUrl: attachment.ThumbnailURL,
+			StickerId:    attachment.StickerID,
+		}
+	}
+	return apiAttachments
+}
+
+// convertToBizAttachments 将v1.Attachment转换为biz.Attachment
+func (s *CommentService) convertToBizAttachments(attachments []*v1.Attachment) []*biz.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	bizAttachments := make([]*biz.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		bizAttachments[i] = &biz.Attachment{
+			Type:         int32(attachment.Type),
+			URL:          attachment.Url,
+			Width:        attachment.Width,
+			Height:       attachment.Height,
+			Title:        attachment.Title,
+			Description:  attachment.Description,
+			ThumbnailURL: attachment.ThumbnailUrl,
+			StickerID:    attachment.StickerId,
+			Sort:         int32(i),
+		}
+	}
+	return bizAttachments
 }
 
 // DeleteComment 实现删除评论接口
@@ -197,3 +300,42 @@ func (s *CommentService) UnlikeComment(ctx context.Context, in *v1.UnlikeComment
 		LikeCount: likeCount,
 	}, nil
 }
+
+// ListMentions 实现获取提及某用户的评论接口
+// ctx - 请求上下文
+// in - 获取提及请求参数
+// 返回 - 提及该用户的评论列表和可能的错误
+func (s *CommentService) ListMentions(ctx context.Context, in *v1.ListMentionsRequest) (*v1.ListMentionsResponse, error) {
+	log.Info(ctx, "list mentions")
+	log.Debug(ctx, "ListMentions", "user_id", in.UserId, "page", in.Page, "page_size", in.PageSize)
+
+	// 设置默认值
+	page := in.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+
+	pageSize := in.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	// 调用业务层获取提及列表
+	comments, err := s.uc.ListMentions(ctx, in.UserId, page, pageSize)
+	if err != nil {
+		log.Error(ctx, "list mentions failed.", "error", err)
+		return nil, err
+	}
+
+	// 转换为API响应格式
+	apiComments := make([]*v1.Comment, len(comments))
+	for i, comment := range comments {
+		apiComments[i] = s.convertToAPIComment(comment)
+	}
+
+	// 返回 API 响应
+	log.Info(ctx, "list mentions successful.")
+	return &v1.ListMentionsResponse{
+		Comments: apiComments,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"comment/pkg/log"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ExportComments 实现导出评论接口（gRPC 服务端流），每批评论编码后作为一条消息发送
+// in - 导出请求参数
+// stream - 导出数据流
+// 返回 - 导出结束时的错误
+func (s *CommentService) ExportComments(in *v1.ExportCommentsRequest, stream v1.CommentService_ExportCommentsServer) error {
+	ctx := stream.Context()
+	log.Info(ctx, "export comments")
+	log.Debug(ctx, "ExportComments", "user_id", in.UserId, "module", in.Module, "resource_id", in.ResourceId, "format", in.Format)
+
+	// 流式接口不经过校验中间件，需要手动校验
+	if err := in.Validate(); err != nil {
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+
+	format := biz.ExportJSONL
+	if in.Format == v1.ExportCommentsRequest_CSV {
+		format = biz.ExportCSV
+	}
+	exported, err := s.export.ExportComments(ctx, &biz.ExportQuery{
+		UserID:     in.UserId,
+		Module:     in.Module,
+		ResourceID: in.ResourceId,
+	}, format, func(chunk []byte) error {
+		return stream.Send(&v1.ExportCommentsChunk{Data: chunk})
+	})
+	if err != nil {
+		log.Error(ctx, "export comments failed.", "error", err)
+		return err
+	}
+
+	log.Info(ctx, "export comments successful.", "comments", exported)
+	return nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	v1 "comment/api/comment/v1"
+)
+
+// ApproveComment 实现审核通过评论接口
+func (s *CommentService) ApproveComment(ctx context.Context, in *v1.ModerateCommentRequest) (*v1.Comment, error) {
+	log.Info(ctx, "approve comment")
+	log.Debug(ctx, "ApproveComment", "comment_id", in.CommentId)
+
+	comment, err := s.uc.ApproveComment(ctx, in.CommentId)
+	if err != nil {
+		log.Error(ctx, "approve comment failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "approve comment successful.")
+	return s.convertToAPIComment(comment), nil
+}
+
+// RejectComment 实现审核拒绝评论接口
+func (s *CommentService) RejectComment(ctx context.Context, in *v1.ModerateCommentRequest) (*v1.Comment, error) {
+	log.Info(ctx, "reject comment")
+	log.Debug(ctx, "RejectComment", "comment_id", in.CommentId)
+
+	comment, err := s.uc.RejectComment(ctx, in.CommentId)
+	if err != nil {
+		log.Error(ctx, "reject comment failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "reject comment successful.")
+	return s.convertToAPIComment(comment), nil
+}
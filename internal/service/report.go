@@ -0,0 +1,130 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+	"sort"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReportComment 实现举报评论接口
+// ctx - 请求上下文
+// in - 举报请求参数
+// 返回 - 该评论当前的举报数、隐藏状态和可能的错误
+func (s *CommentService) ReportComment(ctx context.Context, in *v1.ReportCommentRequest) (*v1.ReportCommentResponse, error) {
+	log.Info(ctx, "report comment")
+	log.Debug(ctx, "ReportComment", "comment_id", in.CommentId, "user_id", in.UserId, "reason", in.Reason)
+
+	reportCount, hidden, err := s.uc.ReportComment(ctx, &biz.Report{
+		CommentID: in.CommentId,
+		UserID:    in.UserId,
+		Reason:    int32(in.Reason),
+		Detail:    in.Detail,
+		Status:    biz.ReportPending,
+	})
+	if err != nil {
+		log.Error(ctx, "report comment failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "report comment successful.")
+	return &v1.ReportCommentResponse{
+		Success:     true,
+		ReportCount: reportCount,
+		Hidden:      hidden,
+	}, nil
+}
+
+// ListReportedComments 实现按评论聚合查询举报接口
+func (s *CommentService) ListReportedComments(ctx context.Context, in *v1.ListReportedCommentsRequest) (*v1.ListReportedCommentsResponse, error) {
+	log.Info(ctx, "list reported comments")
+	log.Debug(ctx, "ListReportedComments", "module", in.Module, "status", in.Status, "page", in.Page, "page_size", in.PageSize)
+
+	// 设置默认值
+	page := in.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+
+	pageSize := in.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reported, err := s.uc.ListReportedComments(ctx, &biz.ReportFilter{
+		Module:   in.Module,
+		Status:   int32(in.Status),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		log.Error(ctx, "list reported comments failed.", "error", err)
+		return nil, err
+	}
+
+	apiReported := make([]*v1.ReportedComment, len(reported))
+	for i, rc := range reported {
+		apiReported[i] = s.convertToAPIReportedComment(rc)
+	}
+
+	log.Info(ctx, "list reported comments successful.")
+	return &v1.ListReportedCommentsResponse{
+		ReportedComments: apiReported,
+	}, nil
+}
+
+// ResolveReports 实现处理举报接口
+func (s *CommentService) ResolveReports(ctx context.Context, in *v1.ResolveReportsRequest) (*v1.ResolveReportsResponse, error) {
+	log.Info(ctx, "resolve reports")
+	log.Debug(ctx, "ResolveReports", "comment_id", in.CommentId, "action", in.Action)
+
+	resolved, err := s.uc.ResolveReports(ctx, in.CommentId, biz.ReportAction(in.Action))
+	if err != nil {
+		log.Error(ctx, "resolve reports failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "resolve reports successful.")
+	return &v1.ResolveReportsResponse{
+		ResolvedCount: resolved,
+	}, nil
+}
+
+// convertToAPIReportedComment 将biz.ReportedComment转换为v1.ReportedComment
+func (s *CommentService) convertToAPIReportedComment(rc *biz.ReportedComment) *v1.ReportedComment {
+	reported := &v1.ReportedComment{
+		ReportCount:    rc.ReportCount,
+		LastReportTime: timestamppb.New(rc.LastReportGmt),
+	}
+	if rc.Comment != nil {
+		reported.Comment = s.convertToAPIComment(rc.Comment)
+	}
+	// 按原因枚举值排序输出，保证结果稳定
+	reasons := make([]int32, 0, len(rc.ReasonCounts))
+	for reason := range rc.ReasonCounts {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+	for _, reason := range reasons {
+		reported.Reasons = append(reported.Reasons, &v1.ReportedComment_ReasonCount{
+			Reason: v1.Report_Reason(reason),
+			Count:  rc.ReasonCounts[reason],
+		})
+	}
+	for _, report := range rc.RecentReports {
+		reported.RecentReports = append(reported.RecentReports, &v1.Report{
+			Id:         report.ID,
+			CommentId:  report.CommentID,
+			UserId:     report.UserID,
+			Reason:     v1.Report_Reason(report.Reason),
+			Detail:     report.Detail,
+			Status:     v1.Report_Status(report.Status),
+			CreateTime: timestamppb.New(report.CreateGmt),
+		})
+	}
+	return reported
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+)
+
+// SearchComments 实现搜索评论接口
+// ctx - 请求上下文
+// in - 搜索请求参数
+// 返回 - 匹配的评论列表和可能的错误
+func (s *CommentService) SearchComments(ctx context.Context, in *v1.SearchCommentsRequest) (*v1.SearchCommentsResponse, error) {
+	log.Info(ctx, "search comments")
+	log.Debug(ctx, "SearchComments", "keyword", in.Keyword, "module", in.Module, "resource_id", in.ResourceId, "user_id", in.UserId,
+		"include_hidden", in.IncludeHidden, "page", in.Page, "page_size", in.PageSize)
+
+	// 设置默认值
+	page := in.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+
+	pageSize := in.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	q := &biz.SearchQuery{
+		Keyword:       in.Keyword,
+		Module:        in.Module,
+		ResourceID:    in.ResourceId,
+		UserID:        in.UserId,
+		IncludeHidden: in.IncludeHidden,
+		Page:          page,
+		PageSize:      pageSize,
+	}
+	if in.StartTime != nil {
+		q.StartTime = in.StartTime.AsTime()
+	}
+	if in.EndTime != nil {
+		q.EndTime = in.EndTime.AsTime()
+	}
+
+	comments, total, err := s.search.SearchComments(ctx, q)
+	if err != nil {
+		log.Error(ctx, "search comments failed.", "error", err)
+		return nil, err
+	}
+
+	// 转换为API响应格式
+	apiComments := make([]*v1.Comment, len(comments))
+	for i, comment := range comments {
+		apiComments[i] = s.convertToAPIComment(comment)
+	}
+
+	log.Info(ctx, "search comments successful.")
+	return &v1.SearchCommentsResponse{
+		Comments: apiComments,
+		Total:    total,
+	}, nil
+}
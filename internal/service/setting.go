@@ -0,0 +1,69 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetResourceCommentSettings 实现设置资源评论接口
+// ctx - 请求上下文
+// in - 资源评论设置参数
+// 返回 - 保存后的资源评论设置和可能的错误
+func (s *CommentService) SetResourceCommentSettings(ctx context.Context, in *v1.SetResourceCommentSettingsRequest) (*v1.ResourceCommentSettings, error) {
+	log.Info(ctx, "set resource comment settings")
+	log.Debug(ctx, "SetResourceCommentSettings", "module", in.Module, "resource_id", in.ResourceId, "status", in.Status,
+		"max_reply_depth", in.MaxReplyDepth, "moderation_required", in.ModerationRequired)
+
+	setting, err := s.uc.SetResourceSetting(ctx, &biz.ResourceSetting{
+		Module:             in.Module,
+		ResourceID:         in.ResourceId,
+		Status:             int32(in.Status),
+		MaxReplyDepth:      in.MaxReplyDepth,
+		ModerationRequired: in.ModerationRequired,
+	})
+	if err != nil {
+		log.Error(ctx, "set resource comment settings failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "set resource comment settings successful.")
+	return convertToAPIResourceSettings(setting), nil
+}
+
+// GetResourceCommentSettings 实现获取资源评论设置接口
+// ctx - 请求上下文
+// in - 获取资源评论设置参数
+// 返回 - 资源评论设置和可能的错误
+func (s *CommentService) GetResourceCommentSettings(ctx context.Context, in *v1.GetResourceCommentSettingsRequest) (*v1.ResourceCommentSettings, error) {
+	log.Info(ctx, "get resource comment settings")
+	log.Debug(ctx, "GetResourceCommentSettings", "module", in.Module, "resource_id", in.ResourceId)
+
+	setting, err := s.uc.GetResourceSetting(ctx, in.Module, in.ResourceId)
+	if err != nil {
+		log.Error(ctx, "get resource comment settings failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "get resource comment settings successful.")
+	return convertToAPIResourceSettings(setting), nil
+}
+
+// convertToAPIResourceSettings 将biz.ResourceSetting转换为v1.ResourceCommentSettings
+func convertToAPIResourceSettings(setting *biz.ResourceSetting) *v1.ResourceCommentSettings {
+	settings := &v1.ResourceCommentSettings{
+		Module:             setting.Module,
+		ResourceId:         setting.ResourceID,
+		Status:             v1.ResourceCommentSettings_Status(setting.Status),
+		MaxReplyDepth:      setting.MaxReplyDepth,
+		ModerationRequired: setting.ModerationRequired,
+	}
+	if !setting.UpdateGmt.IsZero() {
+		settings.UpdateGmt = timestamppb.New(setting.UpdateGmt)
+	}
+	return settings
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+)
+
+// ListUserComments 实现获取用户评论历史接口
+// ctx - 请求上下文
+// in - 用户评论历史请求参数
+// 返回 - 用户评论列表、下一页游标和可能的错误
+func (s *CommentService) ListUserComments(ctx context.Context, in *v1.ListUserCommentsRequest) (*v1.ListUserCommentsResponse, error) {
+	log.Info(ctx, "list user comments")
+	log.Debug(ctx, "ListUserComments", "user_id", in.UserId, "module", in.Module, "cursor", in.Cursor, "order", in.Order, "page_size", in.PageSize)
+
+	// 设置默认值
+	pageSize := in.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ascending := in.Order == v1.ListUserCommentsRequest_OLDEST_FIRST
+	userComments, next, err := s.uc.ListUserComments(ctx, in.UserId, in.Module, in.Cursor, ascending, pageSize)
+	if err != nil {
+		log.Error(ctx, "list user comments failed.", "error", err)
+		return nil, err
+	}
+
+	// 转换为API响应格式
+	apiComments := make([]*v1.UserComment, len(userComments))
+	for i, uc := range userComments {
+		apiComments[i] = &v1.UserComment{
+			Comment: s.convertToAPIComment(uc.Comment),
+			Parent:  s.convertToAPIParentSnippet(uc),
+		}
+	}
+
+	log.Info(ctx, "list user comments successful.")
+	return &v1.ListUserCommentsResponse{
+		Comments:   apiComments,
+		NextCursor: next,
+	}, nil
+}
+
+// convertToAPIParentSnippet 生成被回复评论的摘要，根评论返回 nil
+func (s *CommentService) convertToAPIParentSnippet(uc *biz.UserComment) *v1.ParentSnippet {
+	if uc.Comment.ParentCommentID <= 0 {
+		return nil
+	}
+	if uc.Parent == nil {
+		return &v1.ParentSnippet{
+			CommentId: uc.Comment.ParentCommentID,
+			Deleted:   true,
+		}
+	}
+	return &v1.ParentSnippet{
+		CommentId: uc.Parent.ID,
+		UserId:    uc.Parent.UserID,
+		Username:  uc.Parent.Username,
+		Content:   uc.ParentSnippet(),
+		Hidden:    !uc.Parent.Visible(),
+	}
+}
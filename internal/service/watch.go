@@ -0,0 +1,155 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchPath SSE 订阅地址
+const WatchPath = "/api/v1/comment/watch"
+
+// watchHeartbeat SSE 心跳间隔，防止连接被代理因空闲断开
+const watchHeartbeat = 15 * time.Second
+
+// WatchComments 实现订阅资源评论变更接口（gRPC 服务端流）
+// in - 订阅请求参数
+// stream - 变更推送流
+// 返回 - 连接结束时的错误
+func (s *CommentService) WatchComments(in *v1.WatchCommentsRequest, stream v1.CommentService_WatchCommentsServer) error {
+	ctx := stream.Context()
+	log.Info(ctx, "watch comments")
+	log.Debug(ctx, "WatchComments", "module", in.Module, "resource_id", in.ResourceId)
+
+	// 流式接口不经过校验中间件，需要手动校验
+	if err := in.Validate(); err != nil {
+		return errors.BadRequest("INVALID_ARGUMENT", err.Error())
+	}
+
+	changes, cancel := s.hub.Watch(in.Module, in.ResourceId)
+	defer cancel()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "watch comments finished.")
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(s.convertToAPIChange(change)); err != nil {
+				log.Error(ctx, "send comment change failed.", "error", err)
+				return err
+			}
+		}
+	}
+}
+
+// WatchFilter 拦截 SSE 订阅请求，其余请求交给后续处理。
+// SSE 是长连接，需要绕过路由上的请求超时，因此以 Filter 方式注册
+func (s *CommentService) WatchFilter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == WatchPath {
+			s.watchSSE(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// watchSSE 通过 Server-Sent Events 推送资源评论变更，
+// 每条变更以 event 为变更类型、data 为 CommentChange 的 JSON
+func (s *CommentService) watchSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log.Info(ctx, "watch comments by sse")
+
+	module, _ := strconv.ParseInt(r.URL.Query().Get("module"), 10, 32)
+	in := &v1.WatchCommentsRequest{
+		Module:     int32(module),
+		ResourceId: r.URL.Query().Get("resource_id"),
+	}
+	log.Debug(ctx, "WatchComments", "module", in.Module, "resource_id", in.ResourceId)
+	if err := in.Validate(); err != nil {
+		khttp.DefaultErrorEncoder(w, r, errors.BadRequest("INVALID_ARGUMENT", err.Error()))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		khttp.DefaultErrorEncoder(w, r, errors.InternalServer("STREAMING_UNSUPPORTED", "streaming unsupported."))
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	if origin := r.Header.Get("Origin"); origin != "" {
+		header.Set("Access-Control-Allow-Origin", origin)
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	changes, cancel := s.hub.Watch(in.Module, in.ResourceId)
+	defer cancel()
+
+	codec := encoding.GetCodec(json.Name)
+	heartbeat := time.NewTicker(watchHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "watch comments by sse finished.")
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case change, ok := <-changes:
+			if !ok {
+				return
+			}
+			reply := s.convertToAPIChange(change)
+			data, err := codec.Marshal(reply)
+			if err != nil {
+				log.Error(ctx, "marshal comment change failed.", "error", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", reply.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// convertToAPIChange 将业务层评论变更转换为 API 响应
+func (s *CommentService) convertToAPIChange(change *biz.CommentChange) *v1.CommentChange {
+	reply := &v1.CommentChange{
+		Type:              v1.CommentChange_Type(change.Type),
+		Module:            change.Module,
+		ResourceId:        change.ResourceID,
+		CommentId:         change.CommentID,
+		DeletedCommentIds: change.DeletedCommentIDs,
+		LikeCount:         change.LikeCount,
+		OccurTime:         timestamppb.New(change.OccurredAt),
+	}
+	if change.Comment != nil {
+		reply.Comment = s.convertToAPIComment(change.Comment)
+	}
+	return reply
+}
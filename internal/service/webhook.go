@@ -0,0 +1,163 @@
+package service
+
+import (
+	"comment/pkg/log"
+	"context"
+	"strings"
+
+	v1 "comment/api/comment/v1"
+	"comment/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateWebhookSubscription 实现创建 Webhook 订阅接口
+// ctx - 请求上下文
+// in - 创建订阅请求参数
+// 返回 - 创建的订阅信息和可能的错误
+func (s *CommentService) CreateWebhookSubscription(ctx context.Context, in *v1.CreateWebhookSubscriptionRequest) (*v1.WebhookSubscription, error) {
+	log.Info(ctx, "create webhook subscription")
+	log.Debug(ctx, "CreateWebhookSubscription", "name", in.Name, "module", in.Module, "event_types", in.EventTypes, "url", in.Url)
+
+	subscription, err := s.webhook.CreateSubscription(ctx, &biz.WebhookSubscription{
+		Name:       in.Name,
+		Module:     in.Module,
+		EventTypes: strings.Join(in.EventTypes, ","),
+		URL:        in.Url,
+		Secret:     in.Secret,
+	})
+	if err != nil {
+		log.Error(ctx, "create webhook subscription failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "create webhook subscription successful.")
+	return s.convertToAPISubscription(subscription), nil
+}
+
+// DeleteWebhookSubscription 实现删除 Webhook 订阅接口
+func (s *CommentService) DeleteWebhookSubscription(ctx context.Context, in *v1.DeleteWebhookSubscriptionRequest) (*v1.DeleteResponse, error) {
+	log.Info(ctx, "delete webhook subscription")
+	log.Debug(ctx, "DeleteWebhookSubscription", "id", in.Id)
+
+	if err := s.webhook.DeleteSubscription(ctx, in.Id); err != nil {
+		log.Error(ctx, "delete webhook subscription failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "delete webhook subscription successful.")
+	return &v1.DeleteResponse{
+		Success: true,
+	}, nil
+}
+
+// ListWebhookSubscriptions 实现获取 Webhook 订阅列表接口
+func (s *CommentService) ListWebhookSubscriptions(ctx context.Context, in *v1.ListWebhookSubscriptionsRequest) (*v1.ListWebhookSubscriptionsResponse, error) {
+	log.Info(ctx, "list webhook subscriptions")
+	log.Debug(ctx, "ListWebhookSubscriptions", "module", in.Module)
+
+	subscriptions, err := s.webhook.ListSubscriptions(ctx, in.Module)
+	if err != nil {
+		log.Error(ctx, "list webhook subscriptions failed.", "error", err)
+		return nil, err
+	}
+
+	apiSubscriptions := make([]*v1.WebhookSubscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		apiSubscriptions[i] = s.convertToAPISubscription(subscription)
+	}
+
+	log.Info(ctx, "list webhook subscriptions successful.")
+	return &v1.ListWebhookSubscriptionsResponse{
+		Subscriptions: apiSubscriptions,
+	}, nil
+}
+
+// ListWebhookDeliveries 实现查询 Webhook 投递记录接口
+func (s *CommentService) ListWebhookDeliveries(ctx context.Context, in *v1.ListWebhookDeliveriesRequest) (*v1.ListWebhookDeliveriesResponse, error) {
+	log.Info(ctx, "list webhook deliveries")
+	log.Debug(ctx, "ListWebhookDeliveries", "subscription_id", in.SubscriptionId, "status", in.Status, "page", in.Page, "page_size", in.PageSize)
+
+	// 设置默认值
+	page := in.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+
+	pageSize := in.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	filter := &biz.WebhookDeliveryFilter{
+		SubscriptionID: in.SubscriptionId,
+		Page:           page,
+		PageSize:       pageSize,
+	}
+	if in.Status != nil {
+		status := int32(in.GetStatus())
+		filter.Status = &status
+	}
+
+	deliveries, err := s.webhook.ListDeliveries(ctx, filter)
+	if err != nil {
+		log.Error(ctx, "list webhook deliveries failed.", "error", err)
+		return nil, err
+	}
+
+	apiDeliveries := make([]*v1.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		apiDeliveries[i] = s.convertToAPIDelivery(delivery)
+	}
+
+	log.Info(ctx, "list webhook deliveries successful.")
+	return &v1.ListWebhookDeliveriesResponse{
+		Deliveries: apiDeliveries,
+	}, nil
+}
+
+// RetryWebhookDelivery 实现重新投递 Webhook 接口
+func (s *CommentService) RetryWebhookDelivery(ctx context.Context, in *v1.RetryWebhookDeliveryRequest) (*v1.WebhookDelivery, error) {
+	log.Info(ctx, "retry webhook delivery")
+	log.Debug(ctx, "RetryWebhookDelivery", "id", in.Id)
+
+	delivery, err := s.webhook.RetryDelivery(ctx, in.Id)
+	if err != nil {
+		log.Error(ctx, "retry webhook delivery failed.", "error", err)
+		return nil, err
+	}
+
+	log.Info(ctx, "retry webhook delivery successful.")
+	return s.convertToAPIDelivery(delivery), nil
+}
+
+// convertToAPISubscription 将biz.WebhookSubscription转换为v1.WebhookSubscription，不返回签名密钥
+func (s *CommentService) convertToAPISubscription(subscription *biz.WebhookSubscription) *v1.WebhookSubscription {
+	return &v1.WebhookSubscription{
+		Id:         subscription.ID,
+		Name:       subscription.Name,
+		Module:     subscription.Module,
+		EventTypes: subscription.EventTypeList(),
+		Url:        subscription.URL,
+		Enabled:    subscription.Enabled,
+		Source:     subscription.Source,
+		CreateTime: timestamppb.New(subscription.CreateGmt),
+	}
+}
+
+// convertToAPIDelivery 将biz.WebhookDelivery转换为v1.WebhookDelivery
+func (s *CommentService) convertToAPIDelivery(delivery *biz.WebhookDelivery) *v1.WebhookDelivery {
+	return &v1.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Module:         delivery.Module,
+		Status:         v1.WebhookDelivery_Status(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		NextRetryTime:  timestamppb.New(delivery.NextRetryGmt),
+		CreateTime:     timestamppb.New(delivery.CreateGmt),
+	}
+}